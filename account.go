@@ -42,6 +42,7 @@ func (r Account) String() string {
 
 // Get account info
 func (s *AccountServiceOp) Get(ctx context.Context) (*Account, *Response, error) {
+	ctx = withOperation(ctx, "Account.Get")
 
 	path := "v2/account"
 
@@ -14,12 +14,16 @@ const (
 
 	//ActionCompleted is a completed action status
 	ActionCompleted = "completed"
+
+	// ActionErrored is a failed action status
+	ActionErrored = "errored"
 )
 
 // ActionsService handles communction with action related methods of the
 // BinaryLane API: https://api.binarylane.com.au/reference#actions
 type ActionsService interface {
 	List(context.Context, *ListOptions) ([]Action, *Response, error)
+	ListAll(context.Context) ([]Action, error)
 	Get(context.Context, int) (*Action, *Response, error)
 }
 
@@ -56,6 +60,7 @@ type Action struct {
 
 // List all actions
 func (s *ActionsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Action, *Response, error) {
+	ctx = withOperation(ctx, "Actions.List")
 	path := actionsBasePath
 	path, err := addOptions(path, opt)
 	if err != nil {
@@ -82,8 +87,24 @@ func (s *ActionsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Action
 	return root.Actions, resp, err
 }
 
+// ListAll lists all actions, fetching every page of results.
+func (s *ActionsServiceOp) ListAll(ctx context.Context) ([]Action, error) {
+	var list []Action
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := s.List(ctx, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
 // Get an action by ID.
 func (s *ActionsServiceOp) Get(ctx context.Context, id int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "Actions.Get")
 	if id < 1 {
 		return nil, nil, NewArgError("id", "cannot be less than 1")
 	}
@@ -35,6 +35,7 @@ func (r Balance) String() string {
 
 // Get balance info
 func (s *BalanceServiceOp) Get(ctx context.Context) (*Balance, *Response, error) {
+	ctx = withOperation(ctx, "Balance.Get")
 	path := "v2/customers/my/balance"
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
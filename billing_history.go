@@ -46,6 +46,7 @@ func (b BillingHistory) String() string {
 
 // List the Billing History for a customer
 func (s *BillingHistoryServiceOp) List(ctx context.Context, opt *ListOptions) (*BillingHistory, *Response, error) {
+	ctx = withOperation(ctx, "BillingHistory.List")
 	path, err := addOptions(billingHistoryBasePath, opt)
 	if err != nil {
 		return nil, nil, err
@@ -19,7 +19,7 @@ import (
 )
 
 const (
-	libraryVersion = "0.1.0"
+	libraryVersion = "0.2.0"
 	defaultBaseURL = "https://api.binarylane.com.au/"
 	userAgent      = "go-binarylane/" + libraryVersion
 	mediaType      = "application/json"
@@ -50,6 +50,7 @@ type Client struct {
 	Actions           ActionsService
 	Balance           BalanceService
 	BillingHistory    BillingHistoryService
+	Certificates      CertificatesService
 	Domains           DomainsService
 	Servers           ServersService
 	ServerActions     ServerActionsService
@@ -67,9 +68,30 @@ type Client struct {
 	Firewalls         FirewallsService
 	Projects          ProjectsService
 	VPCs              VPCsService
+	Volumes           VolumesService
+	VolumeActions     VolumeActionsService
 
 	// Optional function called after every successful request made to the API
 	onRequestCompleted RequestCompletionCallback
+
+	// Optional policy for retrying failed requests
+	retryPolicy *RetryPolicy
+
+	// Optional limiter for pacing requests against the API rate limit
+	rateLimiter *rateLimiter
+
+	// Whether responses are cached, in which case the rate limiter is
+	// applied by the cache to the requests it sends to the API
+	cached bool
+
+	// Optional hooks called around every attempt to send a request
+	interceptors []Interceptor
+
+	// Whether domain record requests are validated before they are sent
+	validateRecords bool
+
+	// Whether POST requests are given idempotency keys and retried
+	idempotencyKeys bool
 }
 
 // RequestCompletionCallback defines the type of the request callback function
@@ -99,6 +121,10 @@ type Response struct {
 	// Monitoring URI
 	Monitor string
 
+	// Attempts is the number of times the request was sent, including any
+	// retries made according to the client's RetryPolicy.
+	Attempts int
+
 	Rate
 }
 
@@ -112,6 +138,10 @@ type ErrorResponse struct {
 
 	// RequestID returned from the API, useful to contact support.
 	RequestID string `json:"request_id"`
+
+	// Errors lists validation failures by field name, if the API
+	// returned any.
+	Errors map[string][]string `json:"-"`
 }
 
 // Rate contains the rate limit for the current client.
@@ -179,6 +209,7 @@ func NewClient(httpClient *http.Client) *Client {
 	c.Actions = &ActionsServiceOp{client: c}
 	c.Balance = &BalanceServiceOp{client: c}
 	c.BillingHistory = &BillingHistoryServiceOp{client: c}
+	c.Certificates = &CertificatesServiceOp{client: c}
 	c.Domains = &DomainsServiceOp{client: c}
 	c.Servers = &ServersServiceOp{client: c}
 	c.ServerActions = &ServerActionsServiceOp{client: c}
@@ -196,6 +227,8 @@ func NewClient(httpClient *http.Client) *Client {
 	c.Snapshots = &SnapshotsServiceOp{client: c}
 	c.Tags = &TagsServiceOp{client: c}
 	c.VPCs = &VPCsServiceOp{client: c}
+	c.Volumes = &VolumesServiceOp{client: c}
+	c.VolumeActions = &VolumeActionsServiceOp{client: c}
 
 	return c
 }
@@ -238,8 +271,9 @@ func SetUserAgent(ua string) ClientOpt {
 
 // NewRequest creates an API request. A relative URL can be provided in urlStr, which will be resolved to the
 // BaseURL of the Client. Relative URLS should always be specified without a preceding slash. If specified, the
-// value pointed to by body is JSON encoded and included in as the request body.
-func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
+// value pointed to by body is JSON encoded and included in as the request body. The RequestOptions carried by ctx
+// are applied to the request, followed by opts.
+func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body interface{}, opts ...RequestOption) (*http.Request, error) {
 	u, err := c.BaseURL.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -261,7 +295,8 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 	req.Header.Add("Content-Type", mediaType)
 	req.Header.Add("Accept", mediaType)
 	req.Header.Add("User-Agent", c.UserAgent)
-	return req, nil
+	c.setIdempotencyKey(ctx, req)
+	return setOperation(ctx, applyRequestOptions(ctx, req, opts)), nil
 }
 
 // OnRequestCompleted sets the API request completion callback
@@ -287,30 +322,41 @@ func newResponse(r *http.Response) *Response {
 
 // populateRate parses the rate related headers and populates the response Rate.
 func (r *Response) populateRate() {
-	if limit := r.Header.Get(headerRateLimit); limit != "" {
-		r.Rate.Limit, _ = strconv.Atoi(limit)
+	r.Rate = parseRate(r.Header)
+}
+
+// parseRate parses the rate related headers.
+func parseRate(h http.Header) Rate {
+	var rate Rate
+	if limit := h.Get(headerRateLimit); limit != "" {
+		rate.Limit, _ = strconv.Atoi(limit)
 	}
-	if remaining := r.Header.Get(headerRateRemaining); remaining != "" {
-		r.Rate.Remaining, _ = strconv.Atoi(remaining)
+	if remaining := h.Get(headerRateRemaining); remaining != "" {
+		rate.Remaining, _ = strconv.Atoi(remaining)
 	}
-	if reset := r.Header.Get(headerRateReset); reset != "" {
+	if reset := h.Get(headerRateReset); reset != "" {
 		if v, _ := strconv.ParseInt(reset, 10, 64); v != 0 {
-			r.Rate.Reset = Timestamp{time.Unix(v, 0)}
+			rate.Reset = Timestamp{time.Unix(v, 0)}
 		}
 	}
+	return rate
 }
 
 // Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
-// the raw response will be written to v, without attempting to decode it.
+// the raw response will be written to v, without attempting to decode it. A timeout set on the request with
+// WithTimeout applies to the whole call, including any retries.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
-	resp, err := DoRequestWithClient(ctx, c.client, req)
+	if timeout, ok := requestTimeout(req); ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	resp, attempts, err := c.doWithRetry(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	if c.onRequestCompleted != nil {
-		c.onRequestCompleted(req, resp)
-	}
 
 	defer func() {
 		// Ensure the response body is fully read and closed
@@ -330,9 +376,12 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	}()
 
 	response := newResponse(resp)
-	c.ratemtx.Lock()
-	c.Rate = response.Rate
-	c.ratemtx.Unlock()
+	response.Attempts = attempts
+	if !fromCache(resp) {
+		c.ratemtx.Lock()
+		c.Rate = response.Rate
+		c.ratemtx.Unlock()
+	}
 
 	err = CheckResponse(resp)
 	if err != nil {
@@ -394,11 +443,64 @@ func CheckResponse(r *http.Response) error {
 		if err != nil {
 			errorResponse.Message = string(data)
 		}
+		// Validation failures are reported as problem details, which
+		// describe the error in title and detail rather than message.
+		problem := struct {
+			Title  string          `json:"title"`
+			Detail string          `json:"detail"`
+			Errors json.RawMessage `json:"errors"`
+		}{}
+		if json.Unmarshal(data, &problem) == nil {
+			if errorResponse.Message == "" {
+				errorResponse.Message = problem.Detail
+			}
+			if errorResponse.Message == "" {
+				errorResponse.Message = problem.Title
+			}
+			errorResponse.Errors = parseFieldErrors(problem.Errors)
+		}
 	}
 
 	return errorResponse
 }
 
+// parseFieldErrors decodes validation failures given either as an object
+// mapping field names to messages, or as a list of objects naming the field
+// and the problem with it.
+func parseFieldErrors(data json.RawMessage) map[string][]string {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var byField map[string][]string
+	if err := json.Unmarshal(data, &byField); err == nil && len(byField) > 0 {
+		return byField
+	}
+
+	var list []struct {
+		Field   string `json:"field"`
+		Message string `json:"message"`
+		Code    string `json:"code"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil
+	}
+	for _, e := range list {
+		if e.Field == "" {
+			continue
+		}
+		reason := e.Message
+		if reason == "" {
+			reason = e.Code
+		}
+		if byField == nil {
+			byField = make(map[string][]string)
+		}
+		byField[e.Field] = append(byField[e.Field], reason)
+	}
+	return byField
+}
+
 func (r Rate) String() string {
 	return Stringify(r)
 }
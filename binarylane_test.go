@@ -77,6 +77,7 @@ func testClientServices(t *testing.T, c *Client) {
 		"Actions",
 		"Balance",
 		"BillingHistory",
+		"Certificates",
 		"Domains",
 		"Servers",
 		"ServerActions",
@@ -89,6 +90,8 @@ func testClientServices(t *testing.T, c *Client) {
 		"FloatingIPs",
 		"FloatingIPActions",
 		"Tags",
+		"Volumes",
+		"VolumeActions",
 	}
 
 	cp := reflect.ValueOf(c)
@@ -297,6 +300,7 @@ func TestCheckResponse(t *testing.T) {
 	expected := &ErrorResponse{
 		Response: res,
 		Message:  "m",
+		Errors:   map[string][]string{"f": {"c"}},
 	}
 	if !reflect.DeepEqual(err, expected) {
 		t.Errorf("Error = %#v, expected %#v", err, expected)
@@ -0,0 +1,176 @@
+package binarylanetest
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+// action is an asynchronous operation. It is reported as in-progress until it
+// has been polled the configured number of times, at which point it completes
+// and its effect, if any, is applied.
+type action struct {
+	binarylane.Action
+
+	resource string
+	polls    int
+	fail     bool
+	complete func()
+}
+
+// FailActions arranges for actions of the given types, such as "power_on",
+// to finish with the errored status instead of completing. Their effects
+// are not applied. Calling FailActions with no types clears the list.
+func (s *Server) FailActions(types ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failTypes = make(map[string]bool)
+	for _, t := range types {
+		s.failTypes[t] = true
+	}
+}
+
+// CompleteActions finishes every in-progress action immediately.
+func (s *Server) CompleteActions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, a := range s.actions {
+		s.finish(a)
+	}
+}
+
+// startAction records a new in-progress action against a resource, which is
+// identified by its type and ID or, for floating IPs, its address. The
+// complete func is called when the action completes successfully.
+func (s *Server) startAction(actionType, resourceType, resource, region string, complete func()) *action {
+	resourceID, _ := strconv.Atoi(resource)
+	a := &action{
+		Action: binarylane.Action{
+			ID:           s.newID(),
+			Status:       binarylane.ActionInProgress,
+			Type:         actionType,
+			StartedAt:    &binarylane.Timestamp{Time: time.Now().UTC()},
+			ResourceID:   resourceID,
+			ResourceType: resourceType,
+			RegionSlug:   region,
+		},
+		resource: resourceType + ":" + resource,
+		polls:    s.actionPolls,
+		fail:     s.failTypes[actionType],
+		complete: complete,
+	}
+	if region != "" {
+		a.Region = &binarylane.Region{Slug: region}
+	}
+	s.actions[a.ID] = a
+
+	if a.polls == 0 {
+		s.finish(a)
+	}
+	return a
+}
+
+// poll returns the current state of an action, advancing its lifecycle.
+func (s *Server) poll(a *action) binarylane.Action {
+	if a.Status == binarylane.ActionInProgress {
+		if a.polls > 0 {
+			a.polls--
+		} else {
+			s.finish(a)
+		}
+	}
+	return a.Action
+}
+
+func (s *Server) finish(a *action) {
+	if a.Status != binarylane.ActionInProgress {
+		return
+	}
+
+	a.CompletedAt = &binarylane.Timestamp{Time: time.Now().UTC()}
+	if a.fail {
+		a.Status = binarylane.ActionErrored
+		return
+	}
+
+	a.Status = binarylane.ActionCompleted
+	if a.complete != nil {
+		a.complete()
+	}
+}
+
+// actionLinks returns the links to include when a request starts actions.
+func (s *Server) actionLinks(rel string, actions ...*action) *binarylane.Links {
+	links := &binarylane.Links{}
+	for _, a := range actions {
+		links.Actions = append(links.Actions, binarylane.LinkAction{
+			ID:   a.ID,
+			Rel:  rel,
+			HREF: s.URL + "/v2/actions/" + strconv.Itoa(a.ID),
+		})
+	}
+	return links
+}
+
+// listActions writes the actions matching filter, paginated.
+func (s *Server) listActions(w http.ResponseWriter, r *http.Request, filter func(*action) bool) {
+	var ids []int
+	for id, a := range s.actions {
+		if filter == nil || filter(a) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+
+	start, end, links, meta := s.page(r, len(ids))
+	list := make([]binarylane.Action, 0, end-start)
+	for _, id := range ids[start:end] {
+		list = append(list, s.actions[id].Action)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"actions": list, "links": links, "meta": meta})
+}
+
+// getAction writes a single action, if it matches filter.
+func (s *Server) getAction(w http.ResponseWriter, seg string, filter func(*action) bool) {
+	id, ok := parseID(w, seg)
+	if !ok {
+		return
+	}
+	a, ok := s.actions[id]
+	if !ok || (filter != nil && !filter(a)) {
+		notFound(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"action": s.poll(a)})
+}
+
+func (s *Server) handleActions(w http.ResponseWriter, r *http.Request, segs []string) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+
+	switch len(segs) {
+	case 0:
+		s.listActions(w, r, nil)
+	case 1:
+		s.getAction(w, segs[0], nil)
+	default:
+		notFound(w)
+	}
+}
+
+// forResource returns an action filter matching a single resource.
+func forResource(resourceType, resource string) func(*action) bool {
+	key := resourceType + ":" + resource
+	return func(a *action) bool {
+		return a.resource == key
+	}
+}
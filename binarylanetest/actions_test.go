@@ -0,0 +1,83 @@
+package binarylanetest
+
+import (
+	"testing"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+func TestActions_Lifecycle(t *testing.T) {
+	_, client := setup(t, WithActionPolls(2))
+
+	server, resp, err := client.Servers.Create(ctx, &binarylane.ServerCreateRequest{
+		Name:   "web",
+		Region: "syd",
+		Size:   "std-min",
+		Image:  binarylane.ServerCreateImage{Slug: "ubuntu-20.04"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Links.Actions) != 1 {
+		t.Fatalf("Expected one create action link, got %+v", resp.Links.Actions)
+	}
+	actionID := resp.Links.Actions[0].ID
+
+	for i, expected := range []string{binarylane.ActionInProgress, binarylane.ActionInProgress, binarylane.ActionCompleted} {
+		a, _, err := client.Actions.Get(ctx, actionID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.Status != expected {
+			t.Errorf("Poll %d returned status %q, expected %q", i, a.Status, expected)
+		}
+		if a.ResourceID != server.ID || a.ResourceType != "server" || a.Type != "create" {
+			t.Errorf("Actions.Get returned %+v", a)
+		}
+	}
+
+	server, _, err = client.Servers.Get(ctx, server.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.Status != "active" {
+		t.Errorf("Server status = %q after create completed, expected active", server.Status)
+	}
+}
+
+func TestActions_FailActions(t *testing.T) {
+	fake, client := setup(t, WithActionPolls(0))
+	server := createServer(t, client, "web")
+
+	fake.FailActions("power_off")
+	a, _, err := client.ServerActions.PowerOff(ctx, server.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Status != binarylane.ActionErrored {
+		t.Errorf("Action status = %q, expected %q", a.Status, binarylane.ActionErrored)
+	}
+
+	server, _, err = client.Servers.Get(ctx, server.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.Status != "active" {
+		t.Errorf("Server status = %q after failed power_off, expected active", server.Status)
+	}
+}
+
+func TestActions_CompleteActions(t *testing.T) {
+	fake, client := setup(t, WithActionPolls(10))
+	server := createServer(t, client, "web")
+
+	fake.CompleteActions()
+
+	actions, _, err := client.Servers.Actions(ctx, server.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 1 || actions[0].Status != binarylane.ActionCompleted {
+		t.Errorf("Servers.Actions returned %+v", actions)
+	}
+}
@@ -0,0 +1,529 @@
+package binarylanetest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf8"
+)
+
+// RecordEnv is the environment variable read by ModeFromEnv.
+const RecordEnv = "BINARYLANE_RECORD"
+
+const scrubbed = "REDACTED"
+
+// DefaultScrubHeaders are the request and response headers whose values a
+// Recorder replaces before saving a cassette.
+var DefaultScrubHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// DefaultScrubFields are the JSON fields and query parameters whose values
+// a Recorder replaces before saving a cassette. A name is scrubbed if it
+// contains any of them, ignoring case, so "password" also covers
+// "root_password".
+var DefaultScrubFields = []string{"password", "token", "secret", "private_key", "user_data", "api_key"}
+
+// Mode selects whether a Recorder records or replays requests.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette without contacting
+	// the API, and fails any request that does not match a recorded one.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to the API and saves them, with their
+	// responses, to the cassette when the Recorder is stopped.
+	ModeRecord
+)
+
+func (m Mode) String() string {
+	switch m {
+	case ModeReplay:
+		return "replay"
+	case ModeRecord:
+		return "record"
+	}
+	return "Mode(" + strconv.Itoa(int(m)) + ")"
+}
+
+// ModeFromEnv returns ModeRecord if the BINARYLANE_RECORD environment
+// variable is set to a true value such as "1", and ModeReplay otherwise, so
+// that a session can be recorded once against the API and replayed offline
+// in CI.
+func ModeFromEnv() Mode {
+	if record, _ := strconv.ParseBool(os.Getenv(RecordEnv)); record {
+		return ModeRecord
+	}
+	return ModeReplay
+}
+
+// Cassette is a recorded sequence of requests and responses.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a request and the response the API gave to it.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request in a cassette. The host is not recorded, so
+// a cassette recorded against the API can be replayed for a client with
+// any base URL.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response in a cassette.
+type RecordedResponse struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+
+	// BodyEncoding is "base64" if Body is base64 encoded, which is done for
+	// bodies that are not valid UTF-8 such as invoice PDFs.
+	BodyEncoding string `json:"body_encoding,omitempty"`
+}
+
+// LoadCassette reads a cassette from the JSON file at path.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c := &Cassette{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("binarylanetest: reading cassette %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// Save writes c as JSON to the file at path, creating its directory if
+// needed.
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// UnmatchedRequestError is returned by a replaying Recorder for a request
+// that matches no unplayed interaction in its cassette.
+type UnmatchedRequestError struct {
+	Method string
+	Path   string
+	Query  string
+	Body   string
+
+	// Replayed is the number of interactions that matched the request but
+	// had already been replayed, which suggests the code under test made
+	// more requests than were recorded.
+	Replayed int
+}
+
+func (e *UnmatchedRequestError) Error() string {
+	target := e.Path
+	if e.Query != "" {
+		target += "?" + e.Query
+	}
+	msg := fmt.Sprintf("binarylanetest: no recorded interaction matches %s %s", e.Method, target)
+	if e.Body != "" {
+		msg += " with body " + e.Body
+	}
+	if e.Replayed > 0 {
+		msg += fmt.Sprintf(" (%d matching interactions already replayed)", e.Replayed)
+	}
+	return msg
+}
+
+// Recorder is an http.RoundTripper that records requests and their
+// responses to a cassette file, or replays them from it.
+//
+// In ModeRecord requests are sent with the next transport and saved, with
+// secrets scrubbed, when Stop is called. In ModeReplay a request is matched
+// on its method, path, query parameters and body against the cassette's
+// interactions in order, each of which is replayed at most once, so a
+// repeated request such as an action being polled gets each recorded
+// response in turn. A request with no match fails with an
+// *UnmatchedRequestError, and is reported again by Stop.
+//
+//	rec, err := binarylanetest.NewRecorder("testdata/servers.json", binarylanetest.ModeFromEnv(), nil)
+//	client := binarylane.NewClient(&http.Client{Transport: rec})
+//	...
+//	err = rec.Stop()
+type Recorder struct {
+	// ScrubHeaders are the headers whose values are replaced before a
+	// cassette is saved.
+	ScrubHeaders []string
+
+	// ScrubFields are the JSON fields and query parameters whose values are
+	// replaced before a cassette is saved, and before a request is matched
+	// in replay.
+	ScrubFields []string
+
+	mode Mode
+	path string
+	next http.RoundTripper
+
+	mu        sync.Mutex
+	cassette  *Cassette
+	played    []bool
+	unmatched []error
+}
+
+// NewRecorder returns a Recorder for the cassette file at path. In
+// ModeReplay the cassette must already exist. In ModeRecord requests are
+// sent with next, or http.DefaultTransport if next is nil.
+func NewRecorder(path string, mode Mode, next http.RoundTripper) (*Recorder, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	r := &Recorder{
+		ScrubHeaders: DefaultScrubHeaders,
+		ScrubFields:  DefaultScrubFields,
+		mode:         mode,
+		path:         path,
+		next:         next,
+		cassette:     &Cassette{},
+	}
+
+	switch mode {
+	case ModeReplay:
+		c, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = c
+		r.played = make([]bool, len(c.Interactions))
+	case ModeRecord:
+	default:
+		return nil, fmt.Errorf("binarylanetest: unknown recorder mode %v", mode)
+	}
+	return r, nil
+}
+
+// NewTestRecorder returns a Recorder for the cassette file at path in the
+// mode given by ModeFromEnv, failing t if it cannot be created. The
+// recorder is stopped when the test finishes, failing t if the cassette
+// cannot be saved or any request was unmatched.
+func NewTestRecorder(t testing.TB, path string, next http.RoundTripper) *Recorder {
+	t.Helper()
+	r, err := NewRecorder(path, ModeFromEnv(), next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := r.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	return r
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if r.mode == ModeRecord {
+		return r.record(req, body)
+	}
+	return r.replay(req, body)
+}
+
+// Stop finishes the session. In ModeRecord it saves the cassette. In
+// ModeReplay it returns an error describing any requests that were not
+// matched.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mode == ModeRecord {
+		return r.cassette.Save(r.path)
+	}
+	if len(r.unmatched) == 0 {
+		return nil
+	}
+	msgs := make([]string, len(r.unmatched))
+	for i, err := range r.unmatched {
+		msgs[i] = err.Error()
+	}
+	return fmt.Errorf("binarylanetest: %d unmatched requests replaying %s:\n%s",
+		len(r.unmatched), r.path, strings.Join(msgs, "\n"))
+}
+
+// Unplayed returns the interactions in the cassette that have not been
+// replayed.
+func (r *Recorder) Unplayed() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unplayed []Interaction
+	for i, played := range r.played {
+		if !played {
+			unplayed = append(unplayed, r.cassette.Interactions[i])
+		}
+	}
+	return unplayed
+}
+
+// record sends req and adds it and its response to the cassette.
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	out := req.Clone(req.Context())
+	if body != nil {
+		out.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	resp, err := r.next.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	recorded := RecordedResponse{
+		Status: resp.StatusCode,
+		Header: r.scrubHeader(resp.Header),
+	}
+	if utf8.Valid(respBody) {
+		recorded.Body = r.scrubBody(respBody)
+	} else {
+		recorded.Body = base64.StdEncoding.EncodeToString(respBody)
+		recorded.BodyEncoding = "base64"
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			Path:   req.URL.Path,
+			Query:  r.scrubQuery(req.URL.RawQuery),
+			Header: r.scrubHeader(req.Header),
+			Body:   r.scrubBody(body),
+		},
+		Response: recorded,
+	})
+	return resp, nil
+}
+
+// replay returns the response of the first unplayed interaction that
+// matches req.
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	query := r.scrubQuery(req.URL.RawQuery)
+	scrubbedBody := r.scrubBody(body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	replayed := 0
+	for i, in := range r.cassette.Interactions {
+		if !r.matches(in.Request, req.Method, req.URL.Path, query, scrubbedBody) {
+			continue
+		}
+		if r.played[i] {
+			replayed++
+			continue
+		}
+		r.played[i] = true
+		return recordedResponse(req, in.Response)
+	}
+
+	err := &UnmatchedRequestError{
+		Method:   req.Method,
+		Path:     req.URL.Path,
+		Query:    query,
+		Body:     scrubbedBody,
+		Replayed: replayed,
+	}
+	r.unmatched = append(r.unmatched, err)
+	return nil, err
+}
+
+// matches reports whether a recorded request matches a request with the
+// given method, path, query and body, all scrubbed.
+func (r *Recorder) matches(rec RecordedRequest, method, path, query, body string) bool {
+	if rec.Method != method || rec.Path != path {
+		return false
+	}
+	recQuery, _ := url.ParseQuery(rec.Query)
+	reqQuery, _ := url.ParseQuery(query)
+	if !reflect.DeepEqual(recQuery, reqQuery) {
+		return false
+	}
+	return equalBodies(rec.Body, body)
+}
+
+// recordedResponse builds the response to req from a recorded response.
+func recordedResponse(req *http.Request, rec RecordedResponse) (*http.Response, error) {
+	body := []byte(rec.Body)
+	if rec.BodyEncoding == "base64" {
+		var err error
+		if body, err = base64.StdEncoding.DecodeString(rec.Body); err != nil {
+			return nil, fmt.Errorf("binarylanetest: decoding recorded response body: %w", err)
+		}
+	}
+
+	header := make(http.Header, len(rec.Header))
+	for k, v := range rec.Header {
+		header[k] = append([]string(nil), v...)
+	}
+	header.Del("Content-Length")
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", rec.Status, http.StatusText(rec.Status)),
+		StatusCode:    rec.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// readRequestBody reads and closes the body of req.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	defer req.Body.Close()
+	return ioutil.ReadAll(req.Body)
+}
+
+// sensitive reports whether a JSON field or query parameter is scrubbed.
+func (r *Recorder) sensitive(name string) bool {
+	name = strings.ToLower(name)
+	for _, field := range r.ScrubFields {
+		if strings.Contains(name, strings.ToLower(field)) {
+			return true
+		}
+	}
+	return false
+}
+
+// scrubHeader returns a copy of h with the values of ScrubHeaders replaced.
+func (r *Recorder) scrubHeader(h http.Header) http.Header {
+	if len(h) == 0 {
+		return nil
+	}
+	out := make(http.Header, len(h))
+	for k, v := range h {
+		out[k] = append([]string(nil), v...)
+	}
+	for _, name := range r.ScrubHeaders {
+		if values := out.Values(name); len(values) > 0 {
+			key := http.CanonicalHeaderKey(name)
+			out[key] = make([]string, len(values))
+			for i := range values {
+				out[key][i] = scrubbed
+			}
+		}
+	}
+	return out
+}
+
+// scrubQuery returns the query string raw with the values of sensitive
+// parameters replaced.
+func (r *Recorder) scrubQuery(raw string) string {
+	if raw == "" {
+		return ""
+	}
+	q, err := url.ParseQuery(raw)
+	if err != nil {
+		return raw
+	}
+	changed := false
+	for k, values := range q {
+		if r.sensitive(k) {
+			for i := range values {
+				values[i] = scrubbed
+			}
+			changed = true
+		}
+	}
+	if !changed {
+		return raw
+	}
+	return q.Encode()
+}
+
+// scrubBody returns body with the values of sensitive fields replaced if it
+// is JSON, in which case it is also reformatted with sorted keys.
+func (r *Recorder) scrubBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+	out, err := json.Marshal(r.scrubJSON(v))
+	if err != nil {
+		return string(body)
+	}
+	return string(out)
+}
+
+// scrubJSON replaces the values of sensitive fields in a decoded JSON
+// value.
+func (r *Recorder) scrubJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if r.sensitive(key) && value != nil {
+				v[key] = scrubbed
+			} else {
+				v[key] = r.scrubJSON(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = r.scrubJSON(value)
+		}
+	}
+	return v
+}
+
+// equalBodies reports whether two scrubbed bodies are equal, comparing JSON
+// bodies by value.
+func equalBodies(a, b string) bool {
+	if a == b {
+		return true
+	}
+	var va, vb interface{}
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
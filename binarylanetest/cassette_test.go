@@ -0,0 +1,281 @@
+package binarylanetest
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/binarylane/go-binarylane"
+	"golang.org/x/oauth2"
+)
+
+const testToken = "secret-api-token"
+
+// tempDir returns a directory that is removed when the test finishes.
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "binarylanetest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+// recorderClient returns a client that sends requests through rec with an
+// API token, for a base URL of baseURL.
+func recorderClient(t *testing.T, rec *Recorder, baseURL string) *binarylane.Client {
+	transport := &oauth2.Transport{
+		Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: testToken}),
+		Base:   rec,
+	}
+	client, err := binarylane.New(&http.Client{Transport: transport}, binarylane.SetBaseURL(baseURL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// recordSession records a session against the fake that creates a server
+// and polls its create action, returning the path of the cassette.
+func recordSession(t *testing.T) string {
+	fake, _ := setup(t, WithActionPolls(1))
+	path := filepath.Join(tempDir(t), "testdata", "session.json")
+	rec, err := NewRecorder(path, ModeRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := recorderClient(t, rec, fake.URL)
+
+	server, _, err := client.Servers.Create(ctx, &binarylane.ServerCreateRequest{
+		Name:     "web",
+		Region:   "syd",
+		Size:     "std-min",
+		Image:    binarylane.ServerCreateImage{Slug: "ubuntu-20.04"},
+		UserData: "#cloud-config\npassword: hunter2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	actions, _, err := client.Servers.Actions(ctx, server.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Actions.Get(ctx, actions[0].ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRecorder_record(t *testing.T) {
+	path := recordSession(t)
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{testToken, "hunter2"} {
+		if bytes.Contains(data, []byte(secret)) {
+			t.Errorf("Cassette contains %q", secret)
+		}
+	}
+
+	c, err := LoadCassette(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Interactions) != 4 {
+		t.Fatalf("Cassette has %d interactions, expected 4", len(c.Interactions))
+	}
+	create := c.Interactions[0]
+	if create.Request.Method != http.MethodPost || create.Request.Path != "/v2/servers" {
+		t.Errorf("First request = %s %s, expected POST /v2/servers", create.Request.Method, create.Request.Path)
+	}
+	if got := create.Request.Header.Get("Authorization"); got != scrubbed {
+		t.Errorf("Authorization = %q, expected it to be scrubbed", got)
+	}
+	if !strings.Contains(create.Request.Body, `"user_data":"REDACTED"`) {
+		t.Errorf("Request body = %s, expected user_data to be scrubbed", create.Request.Body)
+	}
+	if create.Response.Status != http.StatusAccepted || !strings.Contains(create.Response.Body, `"name":"web"`) {
+		t.Errorf("Response = %d %s", create.Response.Status, create.Response.Body)
+	}
+}
+
+func TestRecorder_replay(t *testing.T) {
+	path := recordSession(t)
+
+	rec, err := NewRecorder(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := recorderClient(t, rec, "http://replay.invalid/")
+
+	server, _, err := client.Servers.Create(ctx, &binarylane.ServerCreateRequest{
+		Name:     "web",
+		Region:   "syd",
+		Size:     "std-min",
+		Image:    binarylane.ServerCreateImage{Slug: "ubuntu-20.04"},
+		UserData: "#cloud-config\npassword: different",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.Name != "web" {
+		t.Errorf("Servers.Create returned %+v", server)
+	}
+	actions, _, err := client.Servers.Actions(ctx, server.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var statuses []string
+	for i := 0; i < 2; i++ {
+		action, _, err := client.Actions.Get(ctx, actions[0].ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		statuses = append(statuses, action.Status)
+	}
+	if statuses[0] != binarylane.ActionInProgress || statuses[1] != binarylane.ActionCompleted {
+		t.Errorf("Action statuses = %q, expected each recorded response in turn", statuses)
+	}
+
+	if len(rec.Unplayed()) != 0 {
+		t.Errorf("Unplayed = %+v, expected every interaction to be replayed", rec.Unplayed())
+	}
+	if err := rec.Stop(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRecorder_unmatched(t *testing.T) {
+	path := recordSession(t)
+
+	rec, err := NewRecorder(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := recorderClient(t, rec, "http://replay.invalid/")
+
+	_, _, err = client.Servers.Create(ctx, &binarylane.ServerCreateRequest{Name: "db", Region: "syd"})
+	var unmatched *UnmatchedRequestError
+	if !errors.As(err, &unmatched) {
+		t.Fatalf("Servers.Create returned %v, expected an *UnmatchedRequestError", err)
+	}
+	if unmatched.Method != http.MethodPost || unmatched.Path != "/v2/servers" {
+		t.Errorf("UnmatchedRequestError = %+v", unmatched)
+	}
+	if len(rec.Unplayed()) != 4 {
+		t.Errorf("Unplayed = %d interactions, expected 4", len(rec.Unplayed()))
+	}
+
+	if err := rec.Stop(); err == nil || !strings.Contains(err.Error(), "POST /v2/servers") {
+		t.Errorf("Stop() = %v, expected the unmatched request to be reported", err)
+	}
+}
+
+func TestRecorder_replayedTooOften(t *testing.T) {
+	path := recordSession(t)
+
+	rec, err := NewRecorder(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := recorderClient(t, rec, "http://replay.invalid/")
+
+	c, _ := LoadCassette(path)
+	actionPath := strings.TrimPrefix(c.Interactions[3].Request.Path, "/v2/actions/")
+	var lastErr error
+	for i := 0; i < 3; i++ {
+		req, _ := client.NewRequest(ctx, http.MethodGet, "v2/actions/"+actionPath, nil)
+		_, lastErr = client.Do(ctx, req, nil)
+	}
+	var unmatched *UnmatchedRequestError
+	if !errors.As(lastErr, &unmatched) || unmatched.Replayed != 2 {
+		t.Errorf("Third poll returned %v, expected an *UnmatchedRequestError after 2 replays", lastErr)
+	}
+}
+
+func TestRecorder_matching(t *testing.T) {
+	path := filepath.Join(tempDir(t), "cassette.json")
+	c := &Cassette{Interactions: []Interaction{
+		{
+			Request:  RecordedRequest{Method: http.MethodGet, Path: "/v2/servers", Query: "page=2&per_page=10"},
+			Response: RecordedResponse{Status: http.StatusOK, Body: `{"servers":[]}`},
+		},
+		{
+			Request:  RecordedRequest{Method: http.MethodPost, Path: "/v2/tags", Body: `{"name":"web"}`},
+			Response: RecordedResponse{Status: http.StatusCreated, Body: `{"tag":{"name":"web"}}`},
+		},
+		{
+			Request:  RecordedRequest{Method: http.MethodGet, Path: "/v2/invoices/1/pdf"},
+			Response: RecordedResponse{Status: http.StatusOK, Body: "JVBERi0x/w==", BodyEncoding: "base64"},
+		},
+	}}
+	if err := c.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	rec, err := NewRecorder(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpClient := &http.Client{Transport: rec}
+
+	resp, err := httpClient.Get("http://replay.invalid/v2/servers?per_page=10&page=2")
+	if err != nil {
+		t.Fatalf("GET with reordered query: %v", err)
+	}
+	resp.Body.Close()
+
+	resp, err = httpClient.Post("http://replay.invalid/v2/tags", "application/json", strings.NewReader("{\n  \"name\": \"web\"\n}\n"))
+	if err != nil {
+		t.Fatalf("POST with reformatted body: %v", err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("POST status = %d, expected %d", resp.StatusCode, http.StatusCreated)
+	}
+	resp.Body.Close()
+
+	resp, err = httpClient.Get("http://replay.invalid/v2/invoices/1/pdf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !bytes.Equal(body, []byte("%PDF-1\xff")) || resp.ContentLength != int64(len(body)) {
+		t.Errorf("PDF body = %q (length %d)", body, resp.ContentLength)
+	}
+
+	if _, err := httpClient.Post("http://replay.invalid/v2/tags", "application/json", strings.NewReader(`{"name":"db"}`)); err == nil {
+		t.Error("POST with a different body: expected an error")
+	}
+}
+
+func TestNewRecorder_missingCassette(t *testing.T) {
+	_, err := NewRecorder(filepath.Join(tempDir(t), "missing.json"), ModeReplay, nil)
+	if !os.IsNotExist(err) {
+		t.Errorf("NewRecorder() returned %v, expected the cassette not to exist", err)
+	}
+}
+
+func TestModeFromEnv(t *testing.T) {
+	defer os.Setenv(RecordEnv, os.Getenv(RecordEnv))
+
+	for value, expected := range map[string]Mode{"": ModeReplay, "0": ModeReplay, "1": ModeRecord, "true": ModeRecord} {
+		os.Setenv(RecordEnv, value)
+		if got := ModeFromEnv(); got != expected {
+			t.Errorf("ModeFromEnv() with %s=%q = %v, expected %v", RecordEnv, value, got, expected)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package binarylanetest
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+const defaultDomainTTL = 3600
+
+func (s *Server) handleDomains(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listDomains(w, r)
+		case http.MethodPost:
+			s.createDomain(w, r)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	name := segs[0]
+	domain, ok := s.domains[name]
+	if !ok {
+		notFound(w)
+		return
+	}
+
+	switch {
+	case len(segs) == 1:
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"domain": domain})
+		case http.MethodDelete:
+			delete(s.domains, name)
+			delete(s.records, name)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(w)
+		}
+	case segs[1] == "records" && len(segs) == 2:
+		switch r.Method {
+		case http.MethodGet:
+			s.listRecords(w, r, name)
+		case http.MethodPost:
+			s.createRecord(w, r, name)
+		default:
+			methodNotAllowed(w)
+		}
+	case segs[1] == "records" && len(segs) == 3:
+		id, ok := parseID(w, segs[2])
+		if !ok {
+			return
+		}
+		record, ok := s.records[name][id]
+		if !ok {
+			notFound(w)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"domain_record": record})
+		case http.MethodPut, http.MethodPatch:
+			req := new(binarylane.DomainRecordEditRequest)
+			if !decode(w, r, req) {
+				return
+			}
+			editRecord(record, req)
+			writeJSON(w, http.StatusOK, map[string]interface{}{"domain_record": record})
+		case http.MethodDelete:
+			delete(s.records[name], id)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(w)
+		}
+	default:
+		notFound(w)
+	}
+}
+
+func (s *Server) listDomains(w http.ResponseWriter, r *http.Request) {
+	var names []string
+	for name := range s.domains {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	start, end, links, meta := s.page(r, len(names))
+	list := make([]binarylane.Domain, 0, end-start)
+	for _, name := range names[start:end] {
+		list = append(list, *s.domains[name])
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"domains": list, "links": links, "meta": meta})
+}
+
+func (s *Server) createDomain(w http.ResponseWriter, r *http.Request) {
+	req := new(binarylane.DomainCreateRequest)
+	if !decode(w, r, req) {
+		return
+	}
+	if req.Name == "" {
+		unprocessable(w, "Name is required.")
+		return
+	}
+	if _, ok := s.domains[req.Name]; ok {
+		unprocessable(w, "Domain %s already exists.", req.Name)
+		return
+	}
+
+	domain := &binarylane.Domain{Name: req.Name, TTL: defaultDomainTTL}
+	s.domains[req.Name] = domain
+	s.records[req.Name] = make(map[int]*binarylane.DomainRecord)
+	if req.IPAddress != "" {
+		s.addRecord(req.Name, &binarylane.DomainRecord{Type: "A", Name: "@", Data: req.IPAddress, TTL: defaultDomainTTL})
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"domain": domain})
+}
+
+func (s *Server) listRecords(w http.ResponseWriter, r *http.Request, domain string) {
+	q := r.URL.Query()
+	recordType, name := q.Get("type"), q.Get("name")
+
+	var ids []int
+	for id, record := range s.records[domain] {
+		if recordType != "" && record.Type != recordType {
+			continue
+		}
+		if name != "" && record.Name != name {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	start, end, links, meta := s.page(r, len(ids))
+	list := make([]binarylane.DomainRecord, 0, end-start)
+	for _, id := range ids[start:end] {
+		list = append(list, *s.records[domain][id])
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"domain_records": list, "links": links, "meta": meta})
+}
+
+func (s *Server) createRecord(w http.ResponseWriter, r *http.Request, domain string) {
+	req := new(binarylane.DomainRecordEditRequest)
+	if !decode(w, r, req) {
+		return
+	}
+	if req.Type == "" || req.Name == "" {
+		unprocessable(w, "Type and name are required.")
+		return
+	}
+
+	record := &binarylane.DomainRecord{}
+	editRecord(record, req)
+	if record.TTL == 0 {
+		record.TTL = defaultDomainTTL
+	}
+	s.addRecord(domain, record)
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"domain_record": record})
+}
+
+func (s *Server) addRecord(domain string, record *binarylane.DomainRecord) {
+	record.ID = s.newID()
+	s.records[domain][record.ID] = record
+}
+
+// editRecord applies an edit request to a record. As with the API, string
+// fields and the TTL are only changed when they are set, while the numeric
+// fields are always replaced.
+func editRecord(record *binarylane.DomainRecord, req *binarylane.DomainRecordEditRequest) {
+	if req.Type != "" {
+		record.Type = req.Type
+	}
+	if req.Name != "" {
+		record.Name = req.Name
+	}
+	if req.Data != "" {
+		record.Data = req.Data
+	}
+	if req.TTL != 0 {
+		record.TTL = req.TTL
+	}
+	if req.Tag != "" {
+		record.Tag = req.Tag
+	}
+	record.Priority = req.Priority
+	record.Port = req.Port
+	record.Weight = req.Weight
+	record.Flags = req.Flags
+}
@@ -0,0 +1,120 @@
+package binarylanetest
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+func TestDomains_CreateWithRecords(t *testing.T) {
+	_, client := setup(t)
+
+	domain, _, err := client.Domains.Create(ctx, &binarylane.DomainCreateRequest{Name: "example.com", IPAddress: "192.0.2.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if domain.Name != "example.com" {
+		t.Errorf("Domains.Create returned %+v", domain)
+	}
+
+	_, resp, err := client.Domains.Create(ctx, &binarylane.DomainCreateRequest{Name: "example.com"})
+	if err == nil || resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Duplicate domain returned %v", err)
+	}
+
+	records, _, err := client.Domains.Records(ctx, "example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Type != "A" || records[0].Name != "@" || records[0].Data != "192.0.2.1" {
+		t.Errorf("Domains.Records returned %+v", records)
+	}
+}
+
+func TestDomains_RecordLifecycle(t *testing.T) {
+	_, client := setup(t)
+	if _, _, err := client.Domains.Create(ctx, &binarylane.DomainCreateRequest{Name: "example.com"}); err != nil {
+		t.Fatal(err)
+	}
+
+	mx, _, err := client.Domains.CreateRecord(ctx, "example.com", &binarylane.DomainRecordEditRequest{Type: "MX", Name: "@", Data: "mail.example.com.", Priority: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Domains.CreateRecord(ctx, "example.com", &binarylane.DomainRecordEditRequest{Type: "A", Name: "www", Data: "192.0.2.2"}); err != nil {
+		t.Fatal(err)
+	}
+
+	byType, _, err := client.Domains.RecordsByType(ctx, "example.com", "MX", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byType) != 1 || byType[0].ID != mx.ID {
+		t.Errorf("Domains.RecordsByType returned %+v", byType)
+	}
+	byName, _, err := client.Domains.RecordsByName(ctx, "example.com", "www", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byName) != 1 || byName[0].Data != "192.0.2.2" {
+		t.Errorf("Domains.RecordsByName returned %+v", byName)
+	}
+
+	edited, _, err := client.Domains.EditRecord(ctx, "example.com", mx.ID, &binarylane.DomainRecordEditRequest{Data: "mx.example.com.", Priority: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if edited.Type != "MX" || edited.Data != "mx.example.com." || edited.Priority != 20 {
+		t.Errorf("Domains.EditRecord returned %+v", edited)
+	}
+
+	if _, err := client.Domains.DeleteRecord(ctx, "example.com", mx.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Domains.Record(ctx, "example.com", mx.ID); err == nil {
+		t.Error("Expected error getting deleted record")
+	}
+
+	if _, err := client.Domains.Delete(ctx, "example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Domains.Records(ctx, "example.com", nil); err == nil {
+		t.Error("Expected error listing records of deleted domain")
+	}
+}
+
+func TestDomains_SyncZoneFile(t *testing.T) {
+	_, client := setup(t)
+
+	if _, _, err := client.Domains.Create(ctx, &binarylane.DomainCreateRequest{Name: "example.com", IPAddress: "192.0.2.1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	zone := "$ORIGIN example.com.\n$TTL 3600\n@ IN A 192.0.2.2\nwww IN CNAME @\n@ IN MX 10 mail\n"
+	desired, err := binarylane.ParseZoneFile(strings.NewReader(zone), "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Domains.SyncRecords(ctx, "example.com", desired); err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := client.Domains.PlanRecords(ctx, "example.com", desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !plan.Empty() {
+		t.Errorf("Domains.PlanRecords returned %+v after syncing", plan)
+	}
+
+	records, _, err := client.Domains.Records(ctx, "example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exported := binarylane.FormatZoneFile("example.com", records)
+	if !strings.Contains(exported, "@\t3600\tIN\tA\t192.0.2.2") || !strings.Contains(exported, "10 mail.example.com.") {
+		t.Errorf("FormatZoneFile returned\n%s", exported)
+	}
+}
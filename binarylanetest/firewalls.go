@@ -0,0 +1,203 @@
+package binarylanetest
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+func (s *Server) handleFirewalls(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listFirewalls(w, r, nil)
+		case http.MethodPost:
+			req := new(binarylane.FirewallRequest)
+			if !decode(w, r, req) {
+				return
+			}
+			if !s.validFirewall(w, req) {
+				return
+			}
+			fw := &binarylane.Firewall{
+				ID:      fmt.Sprintf("00000000-0000-4000-8000-%012d", s.newID()),
+				Status:  "succeeded",
+				Created: now(),
+			}
+			applyFirewall(fw, req)
+			s.firewalls[fw.ID] = fw
+			writeJSON(w, http.StatusAccepted, map[string]interface{}{"firewall": fw})
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	fw, ok := s.firewalls[segs[0]]
+	if !ok {
+		notFound(w)
+		return
+	}
+
+	if len(segs) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"firewall": fw})
+		case http.MethodPut:
+			req := new(binarylane.FirewallRequest)
+			if !decode(w, r, req) {
+				return
+			}
+			if !s.validFirewall(w, req) {
+				return
+			}
+			applyFirewall(fw, req)
+			writeJSON(w, http.StatusOK, map[string]interface{}{"firewall": fw})
+		case http.MethodDelete:
+			delete(s.firewalls, fw.ID)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+	if len(segs) != 2 {
+		notFound(w)
+		return
+	}
+	if r.Method != http.MethodPost && r.Method != http.MethodDelete {
+		methodNotAllowed(w)
+		return
+	}
+	add := r.Method == http.MethodPost
+
+	switch segs[1] {
+	case "servers":
+		req := new(struct {
+			ServerIDs []int `json:"server_ids"`
+		})
+		if !decode(w, r, req) {
+			return
+		}
+		if !s.serversExist(w, req.ServerIDs) {
+			return
+		}
+		if add {
+			fw.ServerIDs = addInts(fw.ServerIDs, req.ServerIDs)
+		} else {
+			fw.ServerIDs = removeInts(fw.ServerIDs, req.ServerIDs)
+		}
+	case "tags":
+		req := new(struct {
+			Tags []string `json:"tags"`
+		})
+		if !decode(w, r, req) {
+			return
+		}
+		if add {
+			fw.Tags = addStrings(fw.Tags, req.Tags)
+		} else {
+			fw.Tags = removeStrings(fw.Tags, req.Tags)
+		}
+	case "rules":
+		req := new(binarylane.FirewallRulesRequest)
+		if !decode(w, r, req) {
+			return
+		}
+		if add {
+			fw.InboundRules = append(fw.InboundRules, req.InboundRules...)
+			fw.OutboundRules = append(fw.OutboundRules, req.OutboundRules...)
+		} else {
+			fw.InboundRules = removeInboundRules(fw.InboundRules, req.InboundRules)
+			fw.OutboundRules = removeOutboundRules(fw.OutboundRules, req.OutboundRules)
+		}
+	default:
+		notFound(w)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listFirewalls writes the firewalls matching filter, paginated.
+func (s *Server) listFirewalls(w http.ResponseWriter, r *http.Request, filter func(*binarylane.Firewall) bool) {
+	var ids []string
+	for id, fw := range s.firewalls {
+		if filter == nil || filter(fw) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	start, end, links, meta := s.page(r, len(ids))
+	list := make([]binarylane.Firewall, 0, end-start)
+	for _, id := range ids[start:end] {
+		list = append(list, *s.firewalls[id])
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"firewalls": list, "links": links, "meta": meta})
+}
+
+func (s *Server) validFirewall(w http.ResponseWriter, req *binarylane.FirewallRequest) bool {
+	if req.Name == "" {
+		unprocessable(w, "Name is required.")
+		return false
+	}
+	return s.serversExist(w, req.ServerIDs)
+}
+
+// serversExist writes a 422 response and returns false if any of the IDs is
+// not a known server.
+func (s *Server) serversExist(w http.ResponseWriter, ids []int) bool {
+	for _, id := range ids {
+		if _, ok := s.servers[id]; !ok {
+			unprocessable(w, "Server %d does not exist.", id)
+			return false
+		}
+	}
+	return true
+}
+
+func applyFirewall(fw *binarylane.Firewall, req *binarylane.FirewallRequest) {
+	fw.Name = req.Name
+	fw.InboundRules = req.InboundRules
+	fw.OutboundRules = req.OutboundRules
+	fw.ServerIDs = req.ServerIDs
+	fw.Tags = req.Tags
+}
+
+func removeInboundRules(rules, remove []binarylane.InboundRule) []binarylane.InboundRule {
+	var out []binarylane.InboundRule
+	for _, rule := range rules {
+		keep := true
+		for _, r := range remove {
+			if reflect.DeepEqual(rule, r) {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			out = append(out, rule)
+		}
+	}
+	return out
+}
+
+func removeOutboundRules(rules, remove []binarylane.OutboundRule) []binarylane.OutboundRule {
+	var out []binarylane.OutboundRule
+	for _, rule := range rules {
+		keep := true
+		for _, r := range remove {
+			if reflect.DeepEqual(rule, r) {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			out = append(out, rule)
+		}
+	}
+	return out
+}
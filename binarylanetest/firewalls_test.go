@@ -0,0 +1,129 @@
+package binarylanetest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var sshRule = binarylane.InboundRule{
+	Protocol:  "tcp",
+	PortRange: "22",
+	Sources:   &binarylane.Sources{Addresses: []string{"0.0.0.0/0"}},
+}
+
+var httpRule = binarylane.InboundRule{
+	Protocol:  "tcp",
+	PortRange: "80",
+	Sources:   &binarylane.Sources{Addresses: []string{"0.0.0.0/0"}},
+}
+
+func TestFirewalls_Lifecycle(t *testing.T) {
+	_, client := setup(t, WithActionPolls(0))
+	web := createServer(t, client, "web")
+	db := createServer(t, client, "db")
+
+	fw, _, err := client.Firewalls.Create(ctx, &binarylane.FirewallRequest{
+		Name:         "web",
+		InboundRules: []binarylane.InboundRule{sshRule},
+		ServerIDs:    []int{web.ID},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fw.ID == "" || fw.Status != "succeeded" {
+		t.Errorf("Firewalls.Create returned %+v", fw)
+	}
+
+	if _, err := client.Firewalls.AddServers(ctx, fw.ID, db.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Firewalls.AddTags(ctx, fw.ID, "frontend"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Firewalls.AddRules(ctx, fw.ID, &binarylane.FirewallRulesRequest{InboundRules: []binarylane.InboundRule{httpRule}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Firewalls.RemoveRules(ctx, fw.ID, &binarylane.FirewallRulesRequest{InboundRules: []binarylane.InboundRule{sshRule}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Firewalls.RemoveServers(ctx, fw.ID, web.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	fw, _, err = client.Firewalls.Get(ctx, fw.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fw.ServerIDs, []int{db.ID}) || !reflect.DeepEqual(fw.Tags, []string{"frontend"}) {
+		t.Errorf("Firewall membership = %v %v", fw.ServerIDs, fw.Tags)
+	}
+	if !reflect.DeepEqual(fw.InboundRules, []binarylane.InboundRule{httpRule}) {
+		t.Errorf("Firewall inbound rules = %+v", fw.InboundRules)
+	}
+
+	byServer, _, err := client.Firewalls.ListByServer(ctx, db.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byServer) != 1 || byServer[0].ID != fw.ID {
+		t.Errorf("Firewalls.ListByServer returned %+v", byServer)
+	}
+	byServer, _, err = client.Firewalls.ListByServer(ctx, web.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byServer) != 0 {
+		t.Errorf("Firewalls.ListByServer returned %+v for removed server", byServer)
+	}
+
+	if _, err := client.Firewalls.Delete(ctx, fw.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Firewalls.Get(ctx, fw.ID); err == nil {
+		t.Error("Expected error getting deleted firewall")
+	}
+}
+
+func TestFirewalls_UnknownServer(t *testing.T) {
+	_, client := setup(t)
+
+	_, _, err := client.Firewalls.Create(ctx, &binarylane.FirewallRequest{Name: "web", ServerIDs: []int{999}})
+	if err == nil {
+		t.Error("Expected error creating a firewall for an unknown server")
+	}
+}
+
+func TestFirewalls_Reconcile(t *testing.T) {
+	_, client := setup(t, WithActionPolls(0))
+	web := createServer(t, client, "web")
+	db := createServer(t, client, "db")
+
+	fw, _, err := client.Firewalls.Create(ctx, &binarylane.FirewallRequest{
+		Name:         "web",
+		InboundRules: []binarylane.InboundRule{sshRule},
+		ServerIDs:    []int{web.ID},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	desired := &binarylane.FirewallRequest{
+		Name:         "frontend",
+		InboundRules: []binarylane.InboundRule{httpRule},
+		ServerIDs:    []int{db.ID},
+		Tags:         []string{"frontend"},
+	}
+	if _, _, err := client.Firewalls.Reconcile(ctx, fw.ID, desired); err != nil {
+		t.Fatal(err)
+	}
+
+	plan, _, err := client.Firewalls.Plan(ctx, fw.ID, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !plan.Empty() {
+		t.Errorf("Firewalls.Plan returned %+v after reconciling", plan)
+	}
+}
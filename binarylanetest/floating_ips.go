@@ -0,0 +1,171 @@
+package binarylanetest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+// floatingIP is a floating IP address and the server it is assigned to, if
+// any.
+type floatingIP struct {
+	ip       string
+	region   string
+	serverID int
+	created  int
+}
+
+func (s *Server) handleFloatingIPs(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listFloatingIPs(w, r)
+		case http.MethodPost:
+			s.createFloatingIP(w, r)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	ip := segs[0]
+	fip, ok := s.floatingIPs[ip]
+	if !ok {
+		notFound(w)
+		return
+	}
+
+	switch {
+	case len(segs) == 1:
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"floating_ip": s.floatingIP(fip)})
+		case http.MethodDelete:
+			delete(s.floatingIPs, ip)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(w)
+		}
+	case segs[1] == "actions" && len(segs) == 2:
+		switch r.Method {
+		case http.MethodGet:
+			s.listActions(w, r, forResource("floating_ip", ip))
+		case http.MethodPost:
+			s.floatingIPAction(w, r, fip)
+		default:
+			methodNotAllowed(w)
+		}
+	case segs[1] == "actions" && len(segs) == 3:
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		s.getAction(w, segs[2], forResource("floating_ip", ip))
+	default:
+		notFound(w)
+	}
+}
+
+// floatingIP returns the API representation of a floating IP.
+func (s *Server) floatingIP(fip *floatingIP) binarylane.FloatingIP {
+	out := binarylane.FloatingIP{IP: fip.ip, Region: &binarylane.Region{Slug: fip.region}}
+	if server, ok := s.servers[fip.serverID]; ok {
+		out.Server = server
+	}
+	return out
+}
+
+func (s *Server) listFloatingIPs(w http.ResponseWriter, r *http.Request) {
+	list := make([]*floatingIP, 0, len(s.floatingIPs))
+	for _, fip := range s.floatingIPs {
+		list = append(list, fip)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].created < list[j].created })
+
+	start, end, links, meta := s.page(r, len(list))
+	page := make([]binarylane.FloatingIP, 0, end-start)
+	for _, fip := range list[start:end] {
+		page = append(page, s.floatingIP(fip))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"floating_ips": page, "links": links, "meta": meta})
+}
+
+func (s *Server) createFloatingIP(w http.ResponseWriter, r *http.Request) {
+	req := new(binarylane.FloatingIPCreateRequest)
+	if !decode(w, r, req) {
+		return
+	}
+
+	fip := &floatingIP{region: req.Region, serverID: req.ServerID}
+	if req.ServerID != 0 {
+		server, ok := s.servers[req.ServerID]
+		if !ok {
+			unprocessable(w, "Server %d does not exist.", req.ServerID)
+			return
+		}
+		if server.Region != nil {
+			fip.region = server.Region.Slug
+		}
+	}
+	if fip.region == "" {
+		unprocessable(w, "Region or server_id is required.")
+		return
+	}
+
+	ip, ok := s.allocateFloatingIP()
+	if !ok {
+		unprocessable(w, "No floating IP addresses are available.")
+		return
+	}
+	fip.ip = ip
+	fip.created = s.newID()
+	s.floatingIPs[fip.ip] = fip
+
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"floating_ip": s.floatingIP(fip)})
+}
+
+// floatingIPPoolSize is the number of addresses in 198.51.100.0/24 that the
+// fake hands out as floating IPs.
+const floatingIPPoolSize = 254
+
+// allocateFloatingIP returns the next address in the pool that is not in use,
+// and false if every address is.
+func (s *Server) allocateFloatingIP() (string, bool) {
+	for i := 0; i < floatingIPPoolSize; i++ {
+		ip := fmt.Sprintf("198.51.100.%d", s.nextFloatingIP%floatingIPPoolSize+1)
+		s.nextFloatingIP++
+		if _, used := s.floatingIPs[ip]; !used {
+			return ip, true
+		}
+	}
+	return "", false
+}
+
+func (s *Server) floatingIPAction(w http.ResponseWriter, r *http.Request, fip *floatingIP) {
+	request := make(binarylane.ActionRequest)
+	if !decode(w, r, &request) {
+		return
+	}
+
+	var complete func()
+	switch request["type"] {
+	case "assign":
+		id, _ := request["server_id"].(float64)
+		if _, ok := s.servers[int(id)]; !ok {
+			unprocessable(w, "Server %v does not exist.", request["server_id"])
+			return
+		}
+		complete = func() { fip.serverID = int(id) }
+	case "unassign":
+		complete = func() { fip.serverID = 0 }
+	default:
+		unprocessable(w, "Unknown action type %v.", request["type"])
+		return
+	}
+
+	a := s.startAction(request["type"].(string), "floating_ip", fip.ip, fip.region, complete)
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"action": a.Action})
+}
@@ -0,0 +1,122 @@
+package binarylanetest
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+func TestFloatingIPs_AssignAndUnassign(t *testing.T) {
+	_, client := setup(t, WithActionPolls(0))
+	server := createServer(t, client, "web")
+
+	fip, _, err := client.FloatingIPs.Create(ctx, &binarylane.FloatingIPCreateRequest{Region: "syd"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fip.IP == "" || fip.Region.Slug != "syd" || fip.Server != nil {
+		t.Errorf("FloatingIPs.Create returned %+v", fip)
+	}
+
+	a, _, err := client.FloatingIPActions.Assign(ctx, fip.IP, server.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Type != "assign" || a.ResourceType != "floating_ip" {
+		t.Errorf("FloatingIPActions.Assign returned %+v", a)
+	}
+
+	fip, _, err = client.FloatingIPs.Get(ctx, fip.IP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fip.Server == nil || fip.Server.ID != server.ID {
+		t.Errorf("Floating IP server = %+v, expected ID %d", fip.Server, server.ID)
+	}
+
+	if _, _, err := client.FloatingIPActions.Unassign(ctx, fip.IP); err != nil {
+		t.Fatal(err)
+	}
+	actions, _, err := client.FloatingIPActions.List(ctx, fip.IP, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 2 {
+		t.Errorf("FloatingIPActions.List returned %+v", actions)
+	}
+
+	fip, _, err = client.FloatingIPs.Get(ctx, fip.IP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fip.Server != nil {
+		t.Errorf("Floating IP server = %+v after unassign, expected none", fip.Server)
+	}
+}
+
+func TestFloatingIPs_CreateForServer(t *testing.T) {
+	_, client := setup(t, WithActionPolls(0))
+	server := createServer(t, client, "web")
+
+	fip, _, err := client.FloatingIPs.Create(ctx, &binarylane.FloatingIPCreateRequest{ServerID: server.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fip.Region.Slug != "syd" || fip.Server == nil || fip.Server.ID != server.ID {
+		t.Errorf("FloatingIPs.Create returned %+v", fip)
+	}
+
+	if _, err := client.FloatingIPs.Delete(ctx, fip.IP); err != nil {
+		t.Fatal(err)
+	}
+	list, err := client.FloatingIPs.ListAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 0 {
+		t.Errorf("FloatingIPs.ListAll returned %+v after delete", list)
+	}
+}
+
+func TestFloatingIPs_CreateExhaustsPool(t *testing.T) {
+	_, client := setup(t)
+
+	seen := make(map[string]bool)
+	for i := 0; i < floatingIPPoolSize; i++ {
+		fip, _, err := client.FloatingIPs.Create(ctx, &binarylane.FloatingIPCreateRequest{Region: "syd"})
+		if err != nil {
+			t.Fatalf("FloatingIPs.Create %d returned error: %v", i+1, err)
+		}
+		if seen[fip.IP] {
+			t.Fatalf("FloatingIPs.Create returned %s twice", fip.IP)
+		}
+		seen[fip.IP] = true
+	}
+
+	_, _, err := client.FloatingIPs.Create(ctx, &binarylane.FloatingIPCreateRequest{Region: "syd"})
+	var errResp *binarylane.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("FloatingIPs.Create returned %v with the pool exhausted, expected a 422 error", err)
+	}
+
+	if _, err := client.FloatingIPs.Delete(ctx, "198.51.100.7"); err != nil {
+		t.Fatal(err)
+	}
+	fip, _, err := client.FloatingIPs.Create(ctx, &binarylane.FloatingIPCreateRequest{Region: "syd"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fip.IP != "198.51.100.7" {
+		t.Errorf("FloatingIPs.Create returned %s, expected the released 198.51.100.7", fip.IP)
+	}
+
+	list, err := client.FloatingIPs.ListAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != floatingIPPoolSize {
+		t.Errorf("FloatingIPs.ListAll returned %d floating IPs, expected %d", len(list), floatingIPPoolSize)
+	}
+}
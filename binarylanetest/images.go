@@ -0,0 +1,155 @@
+package binarylanetest
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+// snapshotServer completes a server snapshot action by recording the
+// snapshot as both a private image and a server snapshot.
+func (s *Server) snapshotServer(server *binarylane.Server, name string) {
+	if name == "" {
+		name = server.Name + " snapshot"
+	}
+	var regions []string
+	if server.Region != nil {
+		regions = []string{server.Region.Slug}
+	}
+
+	image := &binarylane.Image{
+		ID:          s.newID(),
+		Name:        name,
+		Type:        "snapshot",
+		Regions:     regions,
+		MinDiskSize: server.Disk,
+		Created:     now(),
+		Status:      "available",
+	}
+	s.images[image.ID] = image
+	s.snapshots[strconv.Itoa(image.ID)] = &binarylane.Snapshot{
+		ID:           strconv.Itoa(image.ID),
+		Name:         name,
+		ResourceID:   strconv.Itoa(server.ID),
+		ResourceType: "server",
+		Regions:      regions,
+		MinDiskSize:  server.Disk,
+		Created:      image.Created,
+	}
+}
+
+func (s *Server) handleImages(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) == 0 {
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		s.listImages(w, r)
+		return
+	}
+	if len(segs) != 1 {
+		notFound(w)
+		return
+	}
+
+	id, ok := parseID(w, segs[0])
+	if !ok {
+		return
+	}
+	image, ok := s.images[id]
+	if !ok {
+		notFound(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"image": image})
+	case http.MethodDelete:
+		delete(s.images, id)
+		delete(s.snapshots, segs[0])
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// listImages lists the images created from snapshots. Only private images
+// are faked, so every image is returned whether or not private=true is given.
+func (s *Server) listImages(w http.ResponseWriter, r *http.Request) {
+	imageType := r.URL.Query().Get("type")
+	var ids []int
+	for id, image := range s.images {
+		if imageType == "" || image.Type == imageType {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+
+	start, end, links, meta := s.page(r, len(ids))
+	list := make([]binarylane.Image, 0, end-start)
+	for _, id := range ids[start:end] {
+		list = append(list, *s.images[id])
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"images": list, "links": links, "meta": meta})
+}
+
+func (s *Server) handleSnapshots(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) == 0 {
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		s.listSnapshots(w, r)
+		return
+	}
+	if len(segs) != 1 {
+		notFound(w)
+		return
+	}
+
+	snapshot, ok := s.snapshots[segs[0]]
+	if !ok {
+		notFound(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"snapshot": snapshot})
+	case http.MethodDelete:
+		delete(s.snapshots, segs[0])
+		if id, err := strconv.Atoi(segs[0]); err == nil {
+			delete(s.images, id)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) listSnapshots(w http.ResponseWriter, r *http.Request) {
+	resourceType := r.URL.Query().Get("resource_type")
+	var ids []string
+	for id, snapshot := range s.snapshots {
+		if resourceType == "" || snapshot.ResourceType == resourceType {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, _ := strconv.Atoi(ids[i])
+		b, _ := strconv.Atoi(ids[j])
+		return a < b
+	})
+
+	start, end, links, meta := s.page(r, len(ids))
+	list := make([]binarylane.Snapshot, 0, end-start)
+	for _, id := range ids[start:end] {
+		list = append(list, *s.snapshots[id])
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"snapshots": list, "links": links, "meta": meta})
+}
@@ -0,0 +1,66 @@
+package binarylanetest
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/binarylane/go-binarylane/util"
+)
+
+func TestImages_SnapshotCreatesImage(t *testing.T) {
+	_, client := setup(t)
+	server := createServer(t, client, "web")
+
+	action, _, err := client.ServerActions.Snapshot(ctx, server.ID, "before upgrade")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	images, _, err := client.Images.ListUser(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 0 {
+		t.Errorf("Images.ListUser returned %+v before the snapshot completed", images)
+	}
+
+	waiter := util.NewWaiter(client)
+	waiter.Interval = time.Millisecond
+	if _, err := waiter.WaitForID(ctx, action.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	images, _, err = client.Images.ListUser(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 1 || images[0].Name != "before upgrade" || images[0].Type != "snapshot" {
+		t.Fatalf("Images.ListUser returned %+v", images)
+	}
+
+	snapshots, _, err := client.Snapshots.ListServer(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 1 || snapshots[0].ID != strconv.Itoa(images[0].ID) || snapshots[0].ResourceID != strconv.Itoa(server.ID) {
+		t.Fatalf("Snapshots.ListServer returned %+v", snapshots)
+	}
+
+	volumeSnapshots, _, err := client.Snapshots.ListVolume(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(volumeSnapshots) != 0 {
+		t.Errorf("Snapshots.ListVolume returned %+v", volumeSnapshots)
+	}
+
+	if _, err := client.Snapshots.Delete(ctx, snapshots[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	_, resp, err := client.Images.GetByID(ctx, images[0].ID)
+	if err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Images.GetByID after deleting the snapshot returned %v", err)
+	}
+}
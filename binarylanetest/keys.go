@@ -0,0 +1,131 @@
+package binarylanetest
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+func (s *Server) handleKeys(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listKeys(w, r)
+		case http.MethodPost:
+			s.createKey(w, r)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+	if len(segs) != 1 {
+		notFound(w)
+		return
+	}
+
+	key := s.findKey(segs[0])
+	if key == nil {
+		notFound(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"ssh_key": key})
+	case http.MethodPut:
+		req := new(binarylane.KeyUpdateRequest)
+		if !decode(w, r, req) {
+			return
+		}
+		if req.Name != "" {
+			key.Name = req.Name
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"ssh_key": key})
+	case http.MethodDelete:
+		delete(s.keys, key.ID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// findKey looks up a key by its ID or fingerprint.
+func (s *Server) findKey(idOrFingerprint string) *binarylane.Key {
+	if id, err := strconv.Atoi(idOrFingerprint); err == nil {
+		return s.keys[id]
+	}
+	for _, key := range s.keys {
+		if key.Fingerprint == idOrFingerprint {
+			return key
+		}
+	}
+	return nil
+}
+
+func (s *Server) listKeys(w http.ResponseWriter, r *http.Request) {
+	var ids []int
+	for id := range s.keys {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	start, end, links, meta := s.page(r, len(ids))
+	list := make([]binarylane.Key, 0, end-start)
+	for _, id := range ids[start:end] {
+		list = append(list, *s.keys[id])
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"ssh_keys": list, "links": links, "meta": meta})
+}
+
+func (s *Server) createKey(w http.ResponseWriter, r *http.Request) {
+	req := new(binarylane.KeyCreateRequest)
+	if !decode(w, r, req) {
+		return
+	}
+	if req.Name == "" || req.PublicKey == "" {
+		unprocessable(w, "Name and public key are required.")
+		return
+	}
+
+	fingerprint := keyFingerprint(req.PublicKey)
+	if s.findKey(fingerprint) != nil {
+		unprocessable(w, "SSH key is already in use on your account.")
+		return
+	}
+
+	key := &binarylane.Key{
+		ID:          s.newID(),
+		Name:        req.Name,
+		Fingerprint: fingerprint,
+		PublicKey:   req.PublicKey,
+	}
+	s.keys[key.ID] = key
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"ssh_key": key})
+}
+
+// keyFingerprint returns the colon separated MD5 fingerprint of an
+// authorized_keys formatted public key. Keys that cannot be decoded are
+// fingerprinted as text, so that any input yields a stable fingerprint.
+func keyFingerprint(publicKey string) string {
+	data := []byte(publicKey)
+	if fields := strings.Fields(publicKey); len(fields) >= 2 {
+		if decoded, err := base64.StdEncoding.DecodeString(fields[1]); err == nil {
+			data = decoded
+		}
+	}
+
+	sum := md5.Sum(data)
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.Join(parts, ":")
+}
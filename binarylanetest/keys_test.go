@@ -0,0 +1,47 @@
+package binarylanetest
+
+import (
+	"testing"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+func TestKeys_Lifecycle(t *testing.T) {
+	_, client := setup(t)
+
+	publicKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIB2d5QwC4bJ0q0Jb8Y3s2M9F1v9mD1d7dY2h5n8bX3Qa user@example"
+	key, _, err := client.Keys.Create(ctx, &binarylane.KeyCreateRequest{Name: "laptop", PublicKey: publicKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.ID == 0 || len(key.Fingerprint) != 47 || key.PublicKey != publicKey {
+		t.Errorf("Keys.Create returned %+v", key)
+	}
+
+	if _, _, err := client.Keys.Create(ctx, &binarylane.KeyCreateRequest{Name: "again", PublicKey: publicKey}); err == nil {
+		t.Error("Expected error creating a duplicate key")
+	}
+
+	got, _, err := client.Keys.GetByFingerprint(ctx, key.Fingerprint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != key.ID {
+		t.Errorf("Keys.GetByFingerprint returned %+v, expected ID %d", got, key.ID)
+	}
+
+	updated, _, err := client.Keys.UpdateByID(ctx, key.ID, &binarylane.KeyUpdateRequest{Name: "desktop"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "desktop" || updated.Fingerprint != key.Fingerprint {
+		t.Errorf("Keys.UpdateByID returned %+v", updated)
+	}
+
+	if _, err := client.Keys.DeleteByFingerprint(ctx, key.Fingerprint); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Keys.GetByID(ctx, key.ID); err == nil {
+		t.Error("Expected error getting deleted key")
+	}
+}
@@ -0,0 +1,188 @@
+package binarylanetest
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+func (s *Server) handleLoadBalancers(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listLoadBalancers(w, r)
+		case http.MethodPost:
+			req := new(binarylane.LoadBalancerRequest)
+			if !decode(w, r, req) {
+				return
+			}
+			if req.Region == "" {
+				unprocessable(w, "Region is required.")
+				return
+			}
+			if !s.validLoadBalancer(w, req) {
+				return
+			}
+			lb := &binarylane.LoadBalancer{
+				ID:      s.newID(),
+				Status:  "active",
+				Created: now(),
+				Region:  &binarylane.Region{Slug: req.Region},
+				Tags:    req.Tags,
+			}
+			lb.IP = fmt.Sprintf("192.0.2.%d", lb.ID%254+1)
+			applyLoadBalancer(lb, req)
+			s.loadBalancers[lb.ID] = lb
+			writeJSON(w, http.StatusAccepted, map[string]interface{}{"load_balancer": lb})
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	id, ok := parseID(w, segs[0])
+	if !ok {
+		return
+	}
+	lb, ok := s.loadBalancers[id]
+	if !ok {
+		notFound(w)
+		return
+	}
+
+	if len(segs) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"load_balancer": lb})
+		case http.MethodPut:
+			req := new(binarylane.LoadBalancerRequest)
+			if !decode(w, r, req) {
+				return
+			}
+			if !s.validLoadBalancer(w, req) {
+				return
+			}
+			applyLoadBalancer(lb, req)
+			writeJSON(w, http.StatusOK, map[string]interface{}{"load_balancer": lb})
+		case http.MethodDelete:
+			delete(s.loadBalancers, id)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+	if len(segs) != 2 {
+		notFound(w)
+		return
+	}
+	if r.Method != http.MethodPost && r.Method != http.MethodDelete {
+		methodNotAllowed(w)
+		return
+	}
+	add := r.Method == http.MethodPost
+
+	switch segs[1] {
+	case "servers":
+		req := new(struct {
+			ServerIDs []int `json:"server_ids"`
+		})
+		if !decode(w, r, req) {
+			return
+		}
+		if !s.serversExist(w, req.ServerIDs) {
+			return
+		}
+		if add {
+			lb.ServerIDs = addInts(lb.ServerIDs, req.ServerIDs)
+		} else {
+			lb.ServerIDs = removeInts(lb.ServerIDs, req.ServerIDs)
+		}
+	case "forwarding_rules":
+		req := new(struct {
+			Rules []binarylane.ForwardingRule `json:"forwarding_rules"`
+		})
+		if !decode(w, r, req) {
+			return
+		}
+		if add {
+			lb.ForwardingRules = append(lb.ForwardingRules, req.Rules...)
+		} else {
+			var rules []binarylane.ForwardingRule
+			for _, rule := range lb.ForwardingRules {
+				if !containsForwardingRule(req.Rules, rule) {
+					rules = append(rules, rule)
+				}
+			}
+			lb.ForwardingRules = rules
+		}
+	default:
+		notFound(w)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listLoadBalancers(w http.ResponseWriter, r *http.Request) {
+	var ids []int
+	for id := range s.loadBalancers {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	start, end, links, meta := s.page(r, len(ids))
+	list := make([]binarylane.LoadBalancer, 0, end-start)
+	for _, id := range ids[start:end] {
+		list = append(list, *s.loadBalancers[id])
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"load_balancers": list, "links": links, "meta": meta})
+}
+
+func (s *Server) validLoadBalancer(w http.ResponseWriter, req *binarylane.LoadBalancerRequest) bool {
+	if req.Name == "" {
+		unprocessable(w, "Name is required.")
+		return false
+	}
+	if len(req.ForwardingRules) == 0 {
+		unprocessable(w, "At least one forwarding rule is required.")
+		return false
+	}
+	if req.VPCID != 0 && s.vpcs[req.VPCID] == nil {
+		unprocessable(w, "VPC %d does not exist.", req.VPCID)
+		return false
+	}
+	return s.serversExist(w, req.ServerIDs)
+}
+
+// applyLoadBalancer updates a load balancer from a request. The region and
+// tags can only be set when the load balancer is created.
+func applyLoadBalancer(lb *binarylane.LoadBalancer, req *binarylane.LoadBalancerRequest) {
+	lb.Name = req.Name
+	lb.Algorithm = req.Algorithm
+	if lb.Algorithm == "" {
+		lb.Algorithm = "round_robin"
+	}
+	lb.SizeSlug = req.SizeSlug
+	lb.ForwardingRules = req.ForwardingRules
+	lb.HealthCheck = req.HealthCheck
+	lb.StickySessions = req.StickySessions
+	lb.ServerIDs = req.ServerIDs
+	lb.Tag = req.Tag
+	lb.RedirectHttpToHttps = req.RedirectHttpToHttps
+	lb.EnableProxyProtocol = req.EnableProxyProtocol
+	lb.EnableBackendKeepalive = req.EnableBackendKeepalive
+	lb.VPCID = req.VPCID
+}
+
+func containsForwardingRule(rules []binarylane.ForwardingRule, rule binarylane.ForwardingRule) bool {
+	for _, r := range rules {
+		if reflect.DeepEqual(r, rule) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package binarylanetest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var httpForwarding = binarylane.ForwardingRule{
+	EntryProtocol:  "http",
+	EntryPort:      80,
+	TargetProtocol: "http",
+	TargetPort:     8080,
+}
+
+func TestLoadBalancers_Lifecycle(t *testing.T) {
+	_, client := setup(t, WithActionPolls(0))
+	web1 := createServer(t, client, "web-1")
+	web2 := createServer(t, client, "web-2")
+
+	lb, _, err := client.LoadBalancers.Create(ctx, &binarylane.LoadBalancerRequest{
+		Name:            "lb",
+		Region:          "syd",
+		ForwardingRules: []binarylane.ForwardingRule{httpForwarding},
+		ServerIDs:       []int{web1.ID},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lb.ID == 0 || lb.IP == "" || lb.Status != "active" || lb.Region.Slug != "syd" {
+		t.Errorf("LoadBalancers.Create returned %+v", lb)
+	}
+
+	if _, err := client.LoadBalancers.AddServers(ctx, lb.ID, web2.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.LoadBalancers.RemoveServers(ctx, lb.ID, web1.ID); err != nil {
+		t.Fatal(err)
+	}
+	https := binarylane.ForwardingRule{EntryProtocol: "https", EntryPort: 443, TargetProtocol: "http", TargetPort: 8080, CertificateID: "c1"}
+	if _, err := client.LoadBalancers.AddForwardingRules(ctx, lb.ID, https); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.LoadBalancers.RemoveForwardingRules(ctx, lb.ID, httpForwarding); err != nil {
+		t.Fatal(err)
+	}
+
+	lb, _, err = client.LoadBalancers.Get(ctx, lb.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(lb.ServerIDs, []int{web2.ID}) {
+		t.Errorf("Load balancer servers = %v, expected [%d]", lb.ServerIDs, web2.ID)
+	}
+	if !reflect.DeepEqual(lb.ForwardingRules, []binarylane.ForwardingRule{https}) {
+		t.Errorf("Load balancer forwarding rules = %+v", lb.ForwardingRules)
+	}
+
+	req := lb.AsRequest()
+	req.Name = "renamed"
+	lb, _, err = client.LoadBalancers.Update(ctx, lb.ID, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lb.Name != "renamed" || !reflect.DeepEqual(lb.ServerIDs, []int{web2.ID}) {
+		t.Errorf("LoadBalancers.Update returned %+v", lb)
+	}
+
+	if _, err := client.Servers.Delete(ctx, web2.ID); err != nil {
+		t.Fatal(err)
+	}
+	lb, _, err = client.LoadBalancers.Get(ctx, lb.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lb.ServerIDs) != 0 {
+		t.Errorf("Load balancer servers = %v after server delete, expected none", lb.ServerIDs)
+	}
+}
+
+func TestLoadBalancers_CreateInvalid(t *testing.T) {
+	_, client := setup(t)
+
+	_, _, err := client.LoadBalancers.Create(ctx, &binarylane.LoadBalancerRequest{Name: "lb", Region: "syd"})
+	if err == nil {
+		t.Error("Expected error creating a load balancer without forwarding rules")
+	}
+}
@@ -0,0 +1,223 @@
+package binarylanetest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+// seedDefaultProject creates the project every account starts with.
+func (s *Server) seedDefaultProject() {
+	p := &binarylane.Project{
+		ID:          fmt.Sprintf("00000000-0000-4000-9000-%012d", s.newID()),
+		Name:        "Default",
+		Purpose:     "Other",
+		Environment: "Development",
+		IsDefault:   true,
+		CreatedAt:   now(),
+		UpdatedAt:   now(),
+	}
+	s.projects[p.ID] = p
+}
+
+// findProject looks up a project by ID, or the default project if the ID is
+// binarylane.DefaultProject.
+func (s *Server) findProject(id string) *binarylane.Project {
+	if id != binarylane.DefaultProject {
+		return s.projects[id]
+	}
+	for _, p := range s.projects {
+		if p.IsDefault {
+			return p
+		}
+	}
+	return nil
+}
+
+func (s *Server) handleProjects(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listProjects(w, r)
+		case http.MethodPost:
+			s.createProject(w, r)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	p := s.findProject(segs[0])
+	if p == nil {
+		notFound(w)
+		return
+	}
+
+	switch {
+	case len(segs) == 1:
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"project": p})
+		case http.MethodPatch, http.MethodPut:
+			s.updateProject(w, r, p)
+		case http.MethodDelete:
+			if p.IsDefault {
+				unprocessable(w, "The default project cannot be deleted.")
+				return
+			}
+			if len(s.projectResources[p.ID]) > 0 {
+				unprocessable(w, "The project still has resources.")
+				return
+			}
+			delete(s.projects, p.ID)
+			delete(s.projectResources, p.ID)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(w)
+		}
+	case segs[1] == "resources" && len(segs) == 2:
+		switch r.Method {
+		case http.MethodGet:
+			resources := s.projectResources[p.ID]
+			start, end, links, meta := s.page(r, len(resources))
+			list := append([]binarylane.ProjectResource{}, resources[start:end]...)
+			writeJSON(w, http.StatusOK, map[string]interface{}{"resources": list, "links": links, "meta": meta})
+		case http.MethodPost:
+			s.assignResources(w, r, p)
+		default:
+			methodNotAllowed(w)
+		}
+	default:
+		notFound(w)
+	}
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request) {
+	list := make([]binarylane.Project, 0, len(s.projects))
+	for _, p := range s.projects {
+		list = append(list, *p)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+
+	start, end, links, meta := s.page(r, len(list))
+	writeJSON(w, http.StatusOK, map[string]interface{}{"projects": list[start:end], "links": links, "meta": meta})
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	req := new(binarylane.CreateProjectRequest)
+	if !decode(w, r, req) {
+		return
+	}
+	if req.Name == "" || req.Purpose == "" {
+		unprocessable(w, "Name and purpose are required.")
+		return
+	}
+
+	p := &binarylane.Project{
+		ID:          fmt.Sprintf("00000000-0000-4000-9000-%012d", s.newID()),
+		Name:        req.Name,
+		Description: req.Description,
+		Purpose:     req.Purpose,
+		Environment: req.Environment,
+		CreatedAt:   now(),
+		UpdatedAt:   now(),
+	}
+	s.projects[p.ID] = p
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"project": p})
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request, p *binarylane.Project) {
+	req := new(struct {
+		Name        *string `json:"name"`
+		Description *string `json:"description"`
+		Purpose     *string `json:"purpose"`
+		Environment *string `json:"environment"`
+		IsDefault   *bool   `json:"is_default"`
+	})
+	if !decode(w, r, req) {
+		return
+	}
+
+	if req.Name != nil {
+		p.Name = *req.Name
+	}
+	if req.Description != nil {
+		p.Description = *req.Description
+	}
+	if req.Purpose != nil {
+		p.Purpose = *req.Purpose
+	}
+	if req.Environment != nil {
+		p.Environment = *req.Environment
+	}
+	if req.IsDefault != nil && *req.IsDefault {
+		for _, other := range s.projects {
+			other.IsDefault = false
+		}
+		p.IsDefault = true
+	}
+	p.UpdatedAt = now()
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"project": p})
+}
+
+// assignResources moves resources, identified by URN, into a project. A
+// resource belongs to at most one project.
+func (s *Server) assignResources(w http.ResponseWriter, r *http.Request, p *binarylane.Project) {
+	req := new(struct {
+		Resources []string `json:"resources"`
+	})
+	if !decode(w, r, req) {
+		return
+	}
+	for _, urn := range req.Resources {
+		if parts := strings.SplitN(urn, ":", 3); len(parts) != 3 || parts[0] != "bl" || parts[2] == "" {
+			unprocessable(w, "%q is not a valid URN.", urn)
+			return
+		}
+	}
+
+	assigned := make([]binarylane.ProjectResource, 0, len(req.Resources))
+	for _, urn := range req.Resources {
+		for id, resources := range s.projectResources {
+			var kept []binarylane.ProjectResource
+			for _, res := range resources {
+				if res.URN != urn {
+					kept = append(kept, res)
+				}
+			}
+			s.projectResources[id] = kept
+		}
+
+		res := binarylane.ProjectResource{
+			URN:        urn,
+			AssignedAt: now(),
+			Links:      &binarylane.ProjectResourceLinks{Self: s.resourceLink(urn)},
+			Status:     "ok",
+		}
+		s.projectResources[p.ID] = append(s.projectResources[p.ID], res)
+		assigned = append(assigned, res)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"resources": assigned})
+}
+
+// resourceLink returns the API URL of the resource a URN refers to.
+func (s *Server) resourceLink(urn string) string {
+	parts := strings.SplitN(urn, ":", 3)
+	collection := map[string]string{
+		"server":       "servers",
+		"floatingip":   "floating_ips",
+		"loadbalancer": "load_balancers",
+		"domain":       "domains",
+		"volume":       "volumes",
+	}[parts[1]]
+	if collection == "" {
+		collection = parts[1] + "s"
+	}
+	return s.URL + "/v2/" + collection + "/" + parts[2]
+}
@@ -0,0 +1,78 @@
+package binarylanetest
+
+import (
+	"testing"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+func TestProjects_Default(t *testing.T) {
+	_, client := setup(t)
+
+	p, _, err := client.Projects.GetDefault(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.IsDefault {
+		t.Errorf("Projects.GetDefault returned %+v", p)
+	}
+
+	if _, err := client.Projects.Delete(ctx, p.ID); err == nil {
+		t.Error("Expected error deleting the default project")
+	}
+}
+
+func TestProjects_AssignResources(t *testing.T) {
+	_, client := setup(t, WithActionPolls(0))
+	server := createServer(t, client, "web")
+
+	staging, _, err := client.Projects.Create(ctx, &binarylane.CreateProjectRequest{Name: "staging", Purpose: "Web Application", Environment: "Staging"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := client.Projects.AssignResources(ctx, binarylane.DefaultProject, server); err != nil {
+		t.Fatal(err)
+	}
+	assigned, _, err := client.Projects.AssignResources(ctx, staging.ID, server, "bl:domain:example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assigned) != 2 || assigned[0].URN != server.URN() || assigned[0].Links.Self == "" {
+		t.Errorf("Projects.AssignResources returned %+v", assigned)
+	}
+
+	defaults, _, err := client.Projects.ListResources(ctx, binarylane.DefaultProject, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(defaults) != 0 {
+		t.Errorf("Default project still has resources %+v after reassignment", defaults)
+	}
+
+	if _, err := client.Projects.Delete(ctx, staging.ID); err == nil {
+		t.Error("Expected error deleting a project with resources")
+	}
+
+	updated, _, err := client.Projects.Update(ctx, staging.ID, &binarylane.UpdateProjectRequest{Name: "prod", IsDefault: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "prod" || !updated.IsDefault || updated.Purpose != "Web Application" {
+		t.Errorf("Projects.Update returned %+v", updated)
+	}
+
+	projects, err := client.Projects.ListAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaultCount := 0
+	for _, p := range projects {
+		if p.IsDefault {
+			defaultCount++
+		}
+	}
+	if len(projects) != 2 || defaultCount != 1 {
+		t.Errorf("Projects.ListAll returned %+v", projects)
+	}
+}
@@ -0,0 +1,484 @@
+// Package binarylanetest provides an in-memory fake of the BinaryLane API for
+// testing code that uses go-binarylane.
+//
+// A Server is an httptest.Server holding stateful fakes of servers, actions,
+// domains and domain records, SSH keys, tags, floating IPs, firewalls, load
+// balancers, VPCs, projects, and the images and snapshots taken of servers.
+// List endpoints paginate with links.pages and meta.total, every response
+// carries RateLimit headers, actions start in-progress and complete after
+// being polled, and faults can be injected for any request path. Endpoints
+// that are not faked respond with 404 Not Found.
+//
+//	fake := binarylanetest.NewServer()
+//	defer fake.Close()
+//
+//	client, _ := binarylane.New(nil, binarylane.SetBaseURL(fake.URL))
+//	server, _, err := client.Servers.Create(ctx, createRequest)
+//
+// A Recorder records the requests made to the real API, and their
+// responses, to a cassette file that it can later replay without network
+// access.
+package binarylanetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+const (
+	defaultPerPage     = 20
+	maxPerPage         = 200
+	defaultRateLimit   = 5000
+	defaultRateWindow  = time.Hour
+	defaultActionPolls = 1
+)
+
+// Server is a fake BinaryLane API. All state is kept in memory and is
+// discarded when the server is closed. A Server is safe for concurrent use.
+type Server struct {
+	// URL of the fake API, suitable for binarylane.SetBaseURL.
+	URL string
+
+	ts *httptest.Server
+
+	mu          sync.Mutex
+	perPage     int
+	actionPolls int
+	failTypes   map[string]bool
+	faults      []*Fault
+	requests    int
+
+	rateLimit     int
+	rateWindow    time.Duration
+	rateRemaining int
+	rateReset     time.Time
+
+	nextID           int
+	nextFloatingIP   int
+	actions          map[int]*action
+	servers          map[int]*binarylane.Server
+	domains          map[string]*binarylane.Domain
+	records          map[string]map[int]*binarylane.DomainRecord
+	keys             map[int]*binarylane.Key
+	tags             map[string][]binarylane.Resource
+	floatingIPs      map[string]*floatingIP
+	firewalls        map[string]*binarylane.Firewall
+	loadBalancers    map[int]*binarylane.LoadBalancer
+	vpcs             map[int]*binarylane.VPC
+	projects         map[string]*binarylane.Project
+	projectResources map[string][]binarylane.ProjectResource
+	images           map[int]*binarylane.Image
+	snapshots        map[string]*binarylane.Snapshot
+}
+
+// Option configures a Server created by NewServer.
+type Option func(*Server)
+
+// WithPerPage sets the page size used when a list request does not specify
+// per_page. The default is 20.
+func WithPerPage(n int) Option {
+	return func(s *Server) {
+		if n > 0 {
+			s.perPage = n
+		}
+	}
+}
+
+// WithRateLimit sets the number of requests allowed in each window. Requests
+// beyond the limit are answered with 429 Too Many Requests until the window
+// resets. The default is 5000 requests per hour.
+func WithRateLimit(limit int, window time.Duration) Option {
+	return func(s *Server) {
+		if limit > 0 && window > 0 {
+			s.rateLimit = limit
+			s.rateWindow = window
+		}
+	}
+}
+
+// WithActionPolls sets how many times an action is reported as in-progress
+// before it completes. Zero completes actions on the first poll. The default
+// is 1.
+func WithActionPolls(n int) Option {
+	return func(s *Server) {
+		if n >= 0 {
+			s.actionPolls = n
+		}
+	}
+}
+
+// NewServer starts and returns a new fake API server. The caller should call
+// Close when finished, to shut it down.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		perPage:          defaultPerPage,
+		actionPolls:      defaultActionPolls,
+		failTypes:        make(map[string]bool),
+		rateLimit:        defaultRateLimit,
+		rateWindow:       defaultRateWindow,
+		actions:          make(map[int]*action),
+		servers:          make(map[int]*binarylane.Server),
+		domains:          make(map[string]*binarylane.Domain),
+		records:          make(map[string]map[int]*binarylane.DomainRecord),
+		keys:             make(map[int]*binarylane.Key),
+		tags:             make(map[string][]binarylane.Resource),
+		floatingIPs:      make(map[string]*floatingIP),
+		firewalls:        make(map[string]*binarylane.Firewall),
+		loadBalancers:    make(map[int]*binarylane.LoadBalancer),
+		vpcs:             make(map[int]*binarylane.VPC),
+		projects:         make(map[string]*binarylane.Project),
+		projectResources: make(map[string][]binarylane.ProjectResource),
+		images:           make(map[int]*binarylane.Image),
+		snapshots:        make(map[string]*binarylane.Snapshot),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.seedDefaultProject()
+
+	s.ts = httptest.NewServer(s)
+	s.URL = s.ts.URL
+	return s
+}
+
+// Close shuts down the server and blocks until all outstanding requests
+// have completed.
+func (s *Server) Close() {
+	s.ts.Close()
+}
+
+// Client returns a binarylane.Client that sends requests to the fake server.
+// Additional options are applied after the base URL is set.
+func (s *Server) Client(opts ...binarylane.ClientOpt) (*binarylane.Client, error) {
+	return binarylane.New(nil, append([]binarylane.ClientOpt{binarylane.SetBaseURL(s.URL)}, opts...)...)
+}
+
+// RequestCount returns the number of requests the server has received,
+// including those rejected by the rate limit or an injected fault.
+func (s *Server) RequestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// Fault describes an error response to return instead of handling a request.
+type Fault struct {
+	// Method to match, such as http.MethodPost. Empty matches any method.
+	Method string
+
+	// Path prefix to match, such as "/v2/servers". Empty matches any path.
+	Path string
+
+	// Status code of the error response.
+	Status int
+
+	// Message of the error response. Defaults to the status text.
+	Message string
+
+	// Count is the number of matching requests to fail. Zero fails every
+	// matching request until the fault is cleared.
+	Count int
+
+	// Header is added to the error response, for example to set Retry-After.
+	Header http.Header
+}
+
+// InjectFault arranges for matching requests to fail. Faults are matched in
+// the order they were injected.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all injected faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+	if !s.takeRateToken(w) {
+		writeError(w, http.StatusTooManyRequests, "too_many_requests", "API rate limit exceeded.")
+		return
+	}
+	if f := s.matchFault(r); f != nil {
+		for k, v := range f.Header {
+			w.Header()[k] = v
+		}
+		message := f.Message
+		if message == "" {
+			message = http.StatusText(f.Status)
+		}
+		writeError(w, f.Status, "injected_fault", message)
+		return
+	}
+
+	segs := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(segs) < 2 || segs[0] != "v2" {
+		notFound(w)
+		return
+	}
+
+	rest := segs[2:]
+	switch segs[1] {
+	case "actions":
+		s.handleActions(w, r, rest)
+	case "servers":
+		s.handleServers(w, r, rest)
+	case "domains":
+		s.handleDomains(w, r, rest)
+	case "account":
+		if len(rest) > 0 && rest[0] == "keys" {
+			s.handleKeys(w, r, rest[1:])
+			return
+		}
+		notFound(w)
+	case "tags":
+		s.handleTags(w, r, rest)
+	case "floating_ips":
+		s.handleFloatingIPs(w, r, rest)
+	case "firewalls":
+		s.handleFirewalls(w, r, rest)
+	case "load_balancers":
+		s.handleLoadBalancers(w, r, rest)
+	case "vpcs":
+		s.handleVPCs(w, r, rest)
+	case "projects":
+		s.handleProjects(w, r, rest)
+	case "images":
+		s.handleImages(w, r, rest)
+	case "snapshots":
+		s.handleSnapshots(w, r, rest)
+	default:
+		notFound(w)
+	}
+}
+
+// takeRateToken consumes one request from the current rate limit window and
+// sets the RateLimit headers. It returns false if the limit is exhausted.
+func (s *Server) takeRateToken(w http.ResponseWriter) bool {
+	now := time.Now()
+	if !now.Before(s.rateReset) {
+		s.rateRemaining = s.rateLimit
+		s.rateReset = now.Add(s.rateWindow)
+	}
+
+	allowed := s.rateRemaining > 0
+	if allowed {
+		s.rateRemaining--
+	}
+
+	h := w.Header()
+	h.Set("RateLimit-Limit", strconv.Itoa(s.rateLimit))
+	h.Set("RateLimit-Remaining", strconv.Itoa(s.rateRemaining))
+	h.Set("RateLimit-Reset", strconv.FormatInt(s.rateReset.Unix(), 10))
+	if !allowed {
+		retry := int(s.rateReset.Sub(now)/time.Second) + 1
+		h.Set("Retry-After", strconv.Itoa(retry))
+	}
+	return allowed
+}
+
+func (s *Server) matchFault(r *http.Request) *Fault {
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if !strings.HasPrefix(r.URL.Path, f.Path) {
+			continue
+		}
+		if f.Count > 0 {
+			f.Count--
+			if f.Count == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+func (s *Server) newID() int {
+	s.nextID++
+	return s.nextID
+}
+
+// page returns the bounds of the requested page of a list of n items, along
+// with the links and meta to return with it.
+func (s *Server) page(r *http.Request, n int) (int, int, *binarylane.Links, *binarylane.Meta) {
+	q := r.URL.Query()
+	perPage, err := strconv.Atoi(q.Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = s.perPage
+	}
+	if perPage > maxPerPage {
+		perPage = maxPerPage
+	}
+	page, err := strconv.Atoi(q.Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	last := (n + perPage - 1) / perPage
+	if last < 1 {
+		last = 1
+	}
+
+	start := (page - 1) * perPage
+	if start > n {
+		start = n
+	}
+	end := start + perPage
+	if end > n {
+		end = n
+	}
+
+	pages := &binarylane.Pages{}
+	if page > 1 {
+		pages.First = s.pageURL(r, 1, perPage)
+		prev := page - 1
+		if prev > last {
+			prev = last
+		}
+		pages.Prev = s.pageURL(r, prev, perPage)
+	}
+	if page < last {
+		pages.Next = s.pageURL(r, page+1, perPage)
+		pages.Last = s.pageURL(r, last, perPage)
+	}
+
+	links := &binarylane.Links{}
+	if *pages != (binarylane.Pages{}) {
+		links.Pages = pages
+	}
+	return start, end, links, &binarylane.Meta{Total: n}
+}
+
+func (s *Server) pageURL(r *http.Request, page, perPage int) string {
+	q := r.URL.Query()
+	q.Set("page", strconv.Itoa(page))
+	q.Set("per_page", strconv.Itoa(perPage))
+	u := url.URL{Path: r.URL.Path, RawQuery: q.Encode()}
+	return s.URL + u.String()
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v != nil {
+		_ = json.NewEncoder(w).Encode(v)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, id, message string) {
+	writeJSON(w, status, map[string]string{"id": id, "message": message})
+}
+
+func notFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "not_found", "The resource you were accessing could not be found.")
+}
+
+func methodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "The method is not allowed for the requested URL.")
+}
+
+func unprocessable(w http.ResponseWriter, format string, a ...interface{}) {
+	writeError(w, http.StatusUnprocessableEntity, "unprocessable_entity", fmt.Sprintf(format, a...))
+}
+
+// decode reads the JSON request body into v, writing a 400 response and
+// returning false if it cannot.
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "The request body could not be parsed: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// parseID parses a numeric identifier from a path segment, writing a 404
+// response and returning false if it is not one.
+func parseID(w http.ResponseWriter, seg string) (int, bool) {
+	id, err := strconv.Atoi(seg)
+	if err != nil || id < 1 {
+		notFound(w)
+		return 0, false
+	}
+	return id, true
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func containsInt(list []int, v int) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, v string) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func removeInts(list []int, remove []int) []int {
+	var out []int
+	for _, x := range list {
+		if !containsInt(remove, x) {
+			out = append(out, x)
+		}
+	}
+	return out
+}
+
+func removeStrings(list []string, remove []string) []string {
+	var out []string
+	for _, x := range list {
+		if !containsString(remove, x) {
+			out = append(out, x)
+		}
+	}
+	return out
+}
+
+func addInts(list []int, add []int) []int {
+	for _, x := range add {
+		if !containsInt(list, x) {
+			list = append(list, x)
+		}
+	}
+	return list
+}
+
+func addStrings(list []string, add []string) []string {
+	for _, x := range add {
+		if !containsString(list, x) {
+			list = append(list, x)
+		}
+	}
+	return list
+}
@@ -0,0 +1,159 @@
+package binarylanetest
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var ctx = context.TODO()
+
+// setup starts a fake server and returns a client for it. The server is
+// closed when the test finishes.
+func setup(t *testing.T, opts ...Option) (*Server, *binarylane.Client) {
+	fake := NewServer(opts...)
+	t.Cleanup(fake.Close)
+
+	client, err := fake.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fake, client
+}
+
+func TestServer_Pagination(t *testing.T) {
+	_, client := setup(t, WithPerPage(2))
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		if _, _, err := client.Keys.Create(ctx, &binarylane.KeyCreateRequest{Name: name, PublicKey: "ssh-ed25519 " + name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	keys, resp, err := client.Keys.List(ctx, &binarylane.ListOptions{Page: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[0].Name != "c" || keys[1].Name != "d" {
+		t.Errorf("Keys.List page 2 returned %+v", keys)
+	}
+	if resp.Meta == nil || resp.Meta.Total != 5 {
+		t.Errorf("Keys.List returned meta %+v, expected total 5", resp.Meta)
+	}
+	if page, err := resp.Links.CurrentPage(); err != nil || page != 2 {
+		t.Errorf("Links.CurrentPage() = %d, %v, expected 2", page, err)
+	}
+	if resp.Links.IsLastPage() {
+		t.Error("Links.IsLastPage() = true on page 2 of 3")
+	}
+
+	all, err := client.Keys.ListAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, k := range all {
+		names = append(names, k.Name)
+	}
+	if expected := []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("Keys.ListAll returned %v, expected %v", names, expected)
+	}
+}
+
+func TestServer_RateLimitHeaders(t *testing.T) {
+	_, client := setup(t, WithRateLimit(3, time.Minute))
+
+	_, resp, err := client.Servers.List(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Rate.Limit != 3 || resp.Rate.Remaining != 2 {
+		t.Errorf("Rate = %+v, expected limit 3 and 2 remaining", resp.Rate)
+	}
+	if resp.Rate.Reset.Time.Before(time.Now()) {
+		t.Errorf("Rate.Reset = %v, expected a time in the future", resp.Rate.Reset)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Servers.List(ctx, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, resp, err = client.Servers.List(ctx, nil)
+	if err == nil {
+		t.Fatal("Expected error once the rate limit was exhausted")
+	}
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("StatusCode = %d, expected %d", resp.StatusCode, http.StatusTooManyRequests)
+	}
+	if resp.Header.Get("Retry-After") == "" {
+		t.Error("Expected Retry-After header on 429 response")
+	}
+}
+
+func TestServer_InjectFault(t *testing.T) {
+	fake, client := setup(t)
+
+	fake.InjectFault(Fault{Method: http.MethodGet, Path: "/v2/servers", Status: http.StatusServiceUnavailable, Message: "maintenance", Count: 2})
+
+	for i := 0; i < 2; i++ {
+		_, resp, err := client.Servers.List(ctx, nil)
+		errResp, ok := err.(*binarylane.ErrorResponse)
+		if !ok {
+			t.Fatalf("Expected *ErrorResponse, got %#v", err)
+		}
+		if resp.StatusCode != http.StatusServiceUnavailable || errResp.Message != "maintenance" {
+			t.Errorf("Request %d returned %d %q", i, resp.StatusCode, errResp.Message)
+		}
+	}
+
+	if _, _, err := client.Servers.List(ctx, nil); err != nil {
+		t.Errorf("Expected fault to be exhausted, got %v", err)
+	}
+	if _, _, err := client.Keys.List(ctx, nil); err != nil {
+		t.Errorf("Fault matched an unrelated path: %v", err)
+	}
+	if n := fake.RequestCount(); n != 4 {
+		t.Errorf("RequestCount() = %d, expected 4", n)
+	}
+}
+
+func TestServer_ClearFaults(t *testing.T) {
+	fake, client := setup(t)
+
+	fake.InjectFault(Fault{Status: http.StatusInternalServerError})
+	if _, _, err := client.Keys.List(ctx, nil); err == nil {
+		t.Fatal("Expected injected fault")
+	}
+
+	fake.ClearFaults()
+	if _, _, err := client.Keys.List(ctx, nil); err != nil {
+		t.Errorf("Expected no error after ClearFaults, got %v", err)
+	}
+}
+
+func TestServer_NotFound(t *testing.T) {
+	_, client := setup(t)
+
+	_, resp, err := client.Servers.Get(ctx, 1234)
+	if err == nil {
+		t.Fatal("Expected error for unknown server")
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("StatusCode = %d, expected %d", resp.StatusCode, http.StatusNotFound)
+	}
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "v2/unknown", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = client.Do(ctx, req, nil)
+	if err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Unknown route returned %v, %v", resp, err)
+	}
+}
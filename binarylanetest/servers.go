@@ -0,0 +1,298 @@
+package binarylanetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+// serverCreateRequest accepts both single and multiple server creation. The
+// image may be given as a slug or an ID.
+type serverCreateRequest struct {
+	Name   string          `json:"name"`
+	Names  []string        `json:"names"`
+	Region string          `json:"region"`
+	Size   string          `json:"size"`
+	Image  json.RawMessage `json:"image"`
+	Tags   []string        `json:"tags"`
+	VPCID  int             `json:"vpc_id"`
+}
+
+func (s *Server) handleServers(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listServers(w, r)
+		case http.MethodPost:
+			s.createServers(w, r)
+		case http.MethodDelete:
+			s.deleteServersByTag(w, r)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	if segs[0] == "actions" && len(segs) == 1 {
+		if r.Method != http.MethodPost {
+			methodNotAllowed(w)
+			return
+		}
+		s.serverActionsByTag(w, r)
+		return
+	}
+
+	id, ok := parseID(w, segs[0])
+	if !ok {
+		return
+	}
+	server, ok := s.servers[id]
+	if !ok {
+		notFound(w)
+		return
+	}
+
+	switch {
+	case len(segs) == 1:
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"server": server})
+		case http.MethodDelete:
+			s.deleteServer(id)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(w)
+		}
+	case segs[1] == "actions" && len(segs) == 2:
+		switch r.Method {
+		case http.MethodGet:
+			s.listActions(w, r, forResource("server", segs[0]))
+		case http.MethodPost:
+			request := make(binarylane.ActionRequest)
+			if !decode(w, r, &request) {
+				return
+			}
+			a := s.serverAction(server, request)
+			if a == nil {
+				unprocessable(w, "Unknown action type %v.", request["type"])
+				return
+			}
+			writeJSON(w, http.StatusCreated, map[string]interface{}{"action": a.Action})
+		default:
+			methodNotAllowed(w)
+		}
+	case segs[1] == "actions" && len(segs) == 3:
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		s.getAction(w, segs[2], forResource("server", segs[0]))
+	case segs[1] == "firewalls" && len(segs) == 2:
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		s.listFirewalls(w, r, func(fw *binarylane.Firewall) bool {
+			return containsInt(fw.ServerIDs, id)
+		})
+	default:
+		notFound(w)
+	}
+}
+
+// serverIDs returns the IDs of servers with the given tag, or of all servers
+// if tag is empty, in ascending order.
+func (s *Server) serverIDs(tag string) []int {
+	var ids []int
+	for id, server := range s.servers {
+		if tag == "" || containsString(server.Tags, tag) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+func (s *Server) listServers(w http.ResponseWriter, r *http.Request) {
+	ids := s.serverIDs(r.URL.Query().Get("tag_name"))
+
+	start, end, links, meta := s.page(r, len(ids))
+	list := make([]binarylane.Server, 0, end-start)
+	for _, id := range ids[start:end] {
+		list = append(list, *s.servers[id])
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"servers": list, "links": links, "meta": meta})
+}
+
+func (s *Server) createServers(w http.ResponseWriter, r *http.Request) {
+	req := new(serverCreateRequest)
+	if !decode(w, r, req) {
+		return
+	}
+
+	names := req.Names
+	if len(names) == 0 {
+		names = []string{req.Name}
+	}
+	for _, name := range names {
+		if name == "" {
+			unprocessable(w, "Name is required.")
+			return
+		}
+	}
+	if req.Region == "" || req.Size == "" || len(req.Image) == 0 {
+		unprocessable(w, "Region, size and image are required.")
+		return
+	}
+	if req.VPCID != 0 && s.vpcs[req.VPCID] == nil {
+		unprocessable(w, "VPC %d does not exist.", req.VPCID)
+		return
+	}
+
+	image := &binarylane.Image{}
+	if err := json.Unmarshal(req.Image, &image.Slug); err != nil {
+		if err := json.Unmarshal(req.Image, &image.ID); err != nil {
+			unprocessable(w, "Image must be a slug or an ID.")
+			return
+		}
+	}
+
+	var servers []binarylane.Server
+	var actions []*action
+	for _, name := range names {
+		server := &binarylane.Server{
+			ID:       s.newID(),
+			Name:     name,
+			Region:   &binarylane.Region{Slug: req.Region},
+			Image:    image,
+			Size:     &binarylane.Size{Slug: req.Size},
+			SizeSlug: req.Size,
+			Status:   "new",
+			Created:  now(),
+			Tags:     append([]string(nil), req.Tags...),
+			VPCID:    req.VPCID,
+		}
+		server.Networks = &binarylane.Networks{V4: []binarylane.NetworkV4{{
+			IPAddress: fmt.Sprintf("203.0.113.%d", server.ID%254+1),
+			Netmask:   "255.255.255.0",
+			Gateway:   "203.0.113.254",
+			Type:      "public",
+		}}}
+		s.servers[server.ID] = server
+		for _, tag := range req.Tags {
+			s.tagResource(tag, binarylane.Resource{ID: strconv.Itoa(server.ID), Type: binarylane.ServerResourceType})
+		}
+
+		actions = append(actions, s.startAction("create", "server", strconv.Itoa(server.ID), req.Region, func() {
+			server.Status = "active"
+		}))
+		servers = append(servers, *server)
+	}
+
+	links := s.actionLinks("create", actions...)
+	if len(req.Names) == 0 {
+		writeJSON(w, http.StatusAccepted, map[string]interface{}{"server": servers[0], "links": links})
+		return
+	}
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"servers": servers, "links": links})
+}
+
+func (s *Server) deleteServersByTag(w http.ResponseWriter, r *http.Request) {
+	tag := r.URL.Query().Get("tag_name")
+	if tag == "" {
+		unprocessable(w, "tag_name is required.")
+		return
+	}
+
+	for _, id := range s.serverIDs(tag) {
+		s.deleteServer(id)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteServer removes a server along with its tags, floating IP
+// assignments, firewall and load balancer membership.
+func (s *Server) deleteServer(id int) {
+	server := s.servers[id]
+	for _, tag := range server.Tags {
+		s.untagResource(tag, binarylane.Resource{ID: strconv.Itoa(id), Type: binarylane.ServerResourceType})
+	}
+	for _, fip := range s.floatingIPs {
+		if fip.serverID == id {
+			fip.serverID = 0
+		}
+	}
+	for _, fw := range s.firewalls {
+		fw.ServerIDs = removeInts(fw.ServerIDs, []int{id})
+	}
+	for _, lb := range s.loadBalancers {
+		lb.ServerIDs = removeInts(lb.ServerIDs, []int{id})
+	}
+	delete(s.servers, id)
+}
+
+func (s *Server) serverActionsByTag(w http.ResponseWriter, r *http.Request) {
+	tag := r.URL.Query().Get("tag_name")
+	if tag == "" {
+		unprocessable(w, "tag_name is required.")
+		return
+	}
+	request := make(binarylane.ActionRequest)
+	if !decode(w, r, &request) {
+		return
+	}
+
+	list := []binarylane.Action{}
+	for _, id := range s.serverIDs(tag) {
+		a := s.serverAction(s.servers[id], request)
+		if a == nil {
+			unprocessable(w, "Unknown action type %v.", request["type"])
+			return
+		}
+		list = append(list, a.Action)
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"actions": list})
+}
+
+// serverAction starts an action against a server, returning nil if the
+// action type is not recognised. Power and rename actions change the server
+// when they complete, and snapshots are recorded as images; other actions have no visible effect.
+func (s *Server) serverAction(server *binarylane.Server, request binarylane.ActionRequest) *action {
+	actionType, _ := request["type"].(string)
+
+	var complete func()
+	switch actionType {
+	case "power_off", "shutdown":
+		complete = func() { server.Status = "off" }
+	case "power_on", "reboot", "power_cycle", "restore", "rebuild", "password_reset":
+		complete = func() { server.Status = "active" }
+	case "rename":
+		name, _ := request["name"].(string)
+		complete = func() { server.Name = name }
+	case "resize":
+		size, _ := request["size"].(string)
+		complete = func() {
+			server.SizeSlug = size
+			server.Size = &binarylane.Size{Slug: size}
+		}
+	case "snapshot":
+		name, _ := request["name"].(string)
+		complete = func() { s.snapshotServer(server, name) }
+	case "enable_backups", "disable_backups", "enable_ipv6", "enable_private_networking",
+		"change_kernel":
+	default:
+		return nil
+	}
+
+	region := ""
+	if server.Region != nil {
+		region = server.Region.Slug
+	}
+	return s.startAction(actionType, "server", strconv.Itoa(server.ID), region, complete)
+}
@@ -0,0 +1,169 @@
+package binarylanetest
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+	"github.com/binarylane/go-binarylane/util"
+)
+
+// createServer creates a server in the fake and returns it.
+func createServer(t *testing.T, client *binarylane.Client, name string, tags ...string) *binarylane.Server {
+	server, _, err := client.Servers.Create(ctx, &binarylane.ServerCreateRequest{
+		Name:   name,
+		Region: "syd",
+		Size:   "std-min",
+		Image:  binarylane.ServerCreateImage{Slug: "ubuntu-20.04"},
+		Tags:   tags,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server
+}
+
+func TestServers_CreateAndWait(t *testing.T) {
+	_, client := setup(t, WithActionPolls(3))
+
+	server, resp, err := client.Servers.Create(ctx, &binarylane.ServerCreateRequest{
+		Name:   "web",
+		Region: "syd",
+		Size:   "std-min",
+		Image:  binarylane.ServerCreateImage{ID: 42},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.Status != "new" || server.Image.ID != 42 || server.Region.Slug != "syd" || server.SizeSlug != "std-min" {
+		t.Errorf("Servers.Create returned %+v", server)
+	}
+	if server.Networks == nil || len(server.Networks.V4) != 1 {
+		t.Errorf("Servers.Create returned networks %+v, expected a public IPv4 address", server.Networks)
+	}
+
+	waiter := util.NewWaiter(client)
+	waiter.Interval = time.Millisecond
+	a, err := waiter.WaitForURI(ctx, resp.Links.Actions[0].HREF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Status != binarylane.ActionCompleted {
+		t.Errorf("WaitForURI returned status %q", a.Status)
+	}
+
+	server, _, err = client.Servers.Get(ctx, server.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.Status != "active" {
+		t.Errorf("Server status = %q, expected active", server.Status)
+	}
+}
+
+func TestServers_CreateInvalid(t *testing.T) {
+	_, client := setup(t)
+
+	_, resp, err := client.Servers.Create(ctx, &binarylane.ServerCreateRequest{Name: "web"})
+	if err == nil {
+		t.Fatal("Expected error for incomplete create request")
+	}
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("StatusCode = %d, expected %d", resp.StatusCode, http.StatusUnprocessableEntity)
+	}
+}
+
+func TestServers_CreateMultipleAndTags(t *testing.T) {
+	_, client := setup(t, WithActionPolls(0))
+
+	servers, resp, err := client.Servers.CreateMultiple(ctx, &binarylane.ServerMultiCreateRequest{
+		Names:  []string{"web-1", "web-2"},
+		Region: "syd",
+		Size:   "std-min",
+		Image:  binarylane.ServerCreateImage{Slug: "ubuntu-20.04"},
+		Tags:   []string{"web"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(servers) != 2 || len(resp.Links.Actions) != 2 {
+		t.Fatalf("Servers.CreateMultiple returned %d servers and %d actions", len(servers), len(resp.Links.Actions))
+	}
+	createServer(t, client, "db", "db")
+
+	tagged, _, err := client.Servers.ListByTag(ctx, "web", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, s := range tagged {
+		names = append(names, s.Name)
+	}
+	if expected := []string{"web-1", "web-2"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("Servers.ListByTag returned %v, expected %v", names, expected)
+	}
+
+	if _, err := client.Servers.DeleteByTag(ctx, "web"); err != nil {
+		t.Fatal(err)
+	}
+	remaining, err := client.Servers.ListAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(remaining) != 1 || remaining[0].Name != "db" {
+		t.Errorf("Servers.ListAll after DeleteByTag returned %+v", remaining)
+	}
+}
+
+func TestServers_Actions(t *testing.T) {
+	_, client := setup(t, WithActionPolls(0))
+	server := createServer(t, client, "web")
+
+	if _, _, err := client.ServerActions.PowerOff(ctx, server.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.ServerActions.Rename(ctx, server.ID, "renamed"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.ServerActions.Resize(ctx, server.ID, "std-1vcpu", true); err != nil {
+		t.Fatal(err)
+	}
+
+	server, _, err := client.Servers.Get(ctx, server.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.Status != "off" || server.Name != "renamed" || server.SizeSlug != "std-1vcpu" {
+		t.Errorf("Server after actions = %+v", server)
+	}
+
+	actions, _, err := client.ServerActions.PowerOnByTag(ctx, "missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 0 {
+		t.Errorf("PowerOnByTag for an unused tag returned %+v", actions)
+	}
+}
+
+func TestServers_Delete(t *testing.T) {
+	_, client := setup(t, WithActionPolls(0))
+	server := createServer(t, client, "web", "web")
+
+	if _, err := client.Servers.Delete(ctx, server.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Servers.Get(ctx, server.ID); err == nil {
+		t.Error("Expected error getting deleted server")
+	}
+
+	tag, _, err := client.Tags.Get(ctx, "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tag.Resources.Count != 0 {
+		t.Errorf("Tag still counts deleted server: %+v", tag.Resources)
+	}
+}
@@ -0,0 +1,160 @@
+package binarylanetest
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+func (s *Server) handleTags(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listTags(w, r)
+		case http.MethodPost:
+			req := new(binarylane.TagCreateRequest)
+			if !decode(w, r, req) {
+				return
+			}
+			if req.Name == "" {
+				unprocessable(w, "Name is required.")
+				return
+			}
+			if _, ok := s.tags[req.Name]; !ok {
+				s.tags[req.Name] = nil
+			}
+			writeJSON(w, http.StatusCreated, map[string]interface{}{"tag": s.tag(req.Name)})
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	name := segs[0]
+	if _, ok := s.tags[name]; !ok {
+		notFound(w)
+		return
+	}
+
+	switch {
+	case len(segs) == 1:
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]interface{}{"tag": s.tag(name)})
+		case http.MethodDelete:
+			for _, res := range append([]binarylane.Resource(nil), s.tags[name]...) {
+				s.untagResource(name, res)
+			}
+			delete(s.tags, name)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(w)
+		}
+	case segs[1] == "resources" && len(segs) == 2:
+		req := new(binarylane.TagResourcesRequest)
+		if r.Method != http.MethodPost && r.Method != http.MethodDelete {
+			methodNotAllowed(w)
+			return
+		}
+		if !decode(w, r, req) {
+			return
+		}
+		for _, res := range req.Resources {
+			if res.Type == binarylane.ServerResourceType && s.resourceServer(res) == nil {
+				unprocessable(w, "Server %s does not exist.", res.ID)
+				return
+			}
+		}
+		for _, res := range req.Resources {
+			if r.Method == http.MethodPost {
+				s.tagResource(name, res)
+			} else {
+				s.untagResource(name, res)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		notFound(w)
+	}
+}
+
+func (s *Server) listTags(w http.ResponseWriter, r *http.Request) {
+	var names []string
+	for name := range s.tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	start, end, links, meta := s.page(r, len(names))
+	list := make([]binarylane.Tag, 0, end-start)
+	for _, name := range names[start:end] {
+		list = append(list, s.tag(name))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"tags": list, "links": links, "meta": meta})
+}
+
+// tag returns a tag with counts of the resources it is attached to.
+func (s *Server) tag(name string) binarylane.Tag {
+	resources := &binarylane.TaggedResources{
+		Servers:         &binarylane.TaggedServersResources{},
+		Images:          &binarylane.TaggedImagesResources{},
+		Volumes:         &binarylane.TaggedVolumesResources{},
+		VolumeSnapshots: &binarylane.TaggedVolumeSnapshotsResources{},
+		Databases:       &binarylane.TaggedDatabasesResources{},
+	}
+	for _, res := range s.tags[name] {
+		resources.Count++
+		if res.Type == binarylane.ServerResourceType {
+			resources.Servers.Count++
+			resources.Servers.LastTagged = s.resourceServer(res)
+			resources.Servers.LastTaggedURI = s.URL + "/v2/servers/" + res.ID
+			resources.LastTaggedURI = resources.Servers.LastTaggedURI
+		}
+	}
+	return binarylane.Tag{Name: name, Resources: resources}
+}
+
+// tagResource attaches a tag to a resource, creating the tag if needed.
+func (s *Server) tagResource(name string, res binarylane.Resource) {
+	for _, existing := range s.tags[name] {
+		if existing == res {
+			return
+		}
+	}
+	s.tags[name] = append(s.tags[name], res)
+
+	if server := s.resourceServer(res); server != nil {
+		server.Tags = addStrings(server.Tags, []string{name})
+	}
+}
+
+// untagResource detaches a tag from a resource.
+func (s *Server) untagResource(name string, res binarylane.Resource) {
+	var list []binarylane.Resource
+	for _, existing := range s.tags[name] {
+		if existing != res {
+			list = append(list, existing)
+		}
+	}
+	if _, ok := s.tags[name]; ok {
+		s.tags[name] = list
+	}
+
+	if server := s.resourceServer(res); server != nil {
+		server.Tags = removeStrings(server.Tags, []string{name})
+	}
+}
+
+func (s *Server) resourceServer(res binarylane.Resource) *binarylane.Server {
+	if res.Type != binarylane.ServerResourceType {
+		return nil
+	}
+	id, err := strconv.Atoi(res.ID)
+	if err != nil {
+		return nil
+	}
+	return s.servers[id]
+}
@@ -0,0 +1,89 @@
+package binarylanetest
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+func TestTags_TagResources(t *testing.T) {
+	_, client := setup(t, WithActionPolls(0))
+	server := createServer(t, client, "web")
+
+	if _, _, err := client.Tags.Create(ctx, &binarylane.TagCreateRequest{Name: "frontend"}); err != nil {
+		t.Fatal(err)
+	}
+
+	resources := []binarylane.Resource{{ID: strconv.Itoa(server.ID), Type: binarylane.ServerResourceType}}
+	if _, err := client.Tags.TagResources(ctx, "frontend", &binarylane.TagResourcesRequest{Resources: resources}); err != nil {
+		t.Fatal(err)
+	}
+
+	tag, _, err := client.Tags.Get(ctx, "frontend")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tag.Resources.Count != 1 || tag.Resources.Servers.Count != 1 || tag.Resources.Servers.LastTagged.ID != server.ID {
+		t.Errorf("Tags.Get returned resources %+v", tag.Resources)
+	}
+
+	server, _, err = client.Servers.Get(ctx, server.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(server.Tags, []string{"frontend"}) {
+		t.Errorf("Server tags = %v, expected [frontend]", server.Tags)
+	}
+
+	if _, err := client.Tags.UntagResources(ctx, "frontend", &binarylane.UntagResourcesRequest{Resources: resources}); err != nil {
+		t.Fatal(err)
+	}
+	server, _, err = client.Servers.Get(ctx, server.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(server.Tags) != 0 {
+		t.Errorf("Server tags = %v after untagging, expected none", server.Tags)
+	}
+}
+
+func TestTags_TagUnknownServer(t *testing.T) {
+	_, client := setup(t)
+
+	if _, _, err := client.Tags.Create(ctx, &binarylane.TagCreateRequest{Name: "frontend"}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := client.Tags.TagResources(ctx, "frontend", &binarylane.TagResourcesRequest{
+		Resources: []binarylane.Resource{{ID: "999", Type: binarylane.ServerResourceType}},
+	})
+	if err == nil {
+		t.Error("Expected error tagging an unknown server")
+	}
+}
+
+func TestTags_Delete(t *testing.T) {
+	_, client := setup(t, WithActionPolls(0))
+	server := createServer(t, client, "web", "frontend", "prod")
+
+	if _, err := client.Tags.Delete(ctx, "frontend"); err != nil {
+		t.Fatal(err)
+	}
+
+	tags, err := client.Tags.ListAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 1 || tags[0].Name != "prod" {
+		t.Errorf("Tags.ListAll returned %+v", tags)
+	}
+
+	server, _, err = client.Servers.Get(ctx, server.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(server.Tags, []string{"prod"}) {
+		t.Errorf("Server tags = %v, expected [prod]", server.Tags)
+	}
+}
@@ -0,0 +1,149 @@
+package binarylanetest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+func (s *Server) handleVPCs(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listVPCs(w, r)
+		case http.MethodPost:
+			s.createVPC(w, r)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+	if len(segs) != 1 {
+		notFound(w)
+		return
+	}
+
+	id, ok := parseID(w, segs[0])
+	if !ok {
+		return
+	}
+	vpc, ok := s.vpcs[id]
+	if !ok {
+		notFound(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"vpc": vpc})
+	case http.MethodPut:
+		req := new(binarylane.VPCUpdateRequest)
+		if !decode(w, r, req) {
+			return
+		}
+		if req.Name == "" {
+			unprocessable(w, "Name is required.")
+			return
+		}
+		vpc.Name = req.Name
+		vpc.Description = req.Description
+		if req.Default != nil && *req.Default {
+			s.setDefaultVPC(vpc)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"vpc": vpc})
+	case http.MethodPatch:
+		req := new(struct {
+			Name        *string `json:"name"`
+			Description *string `json:"description"`
+			Default     *bool   `json:"default"`
+		})
+		if !decode(w, r, req) {
+			return
+		}
+		if req.Name != nil {
+			vpc.Name = *req.Name
+		}
+		if req.Description != nil {
+			vpc.Description = *req.Description
+		}
+		if req.Default != nil && *req.Default {
+			s.setDefaultVPC(vpc)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"vpc": vpc})
+	case http.MethodDelete:
+		for _, server := range s.servers {
+			if server.VPCID == id {
+				writeError(w, http.StatusConflict, "conflict", "The VPC still has members.")
+				return
+			}
+		}
+		delete(s.vpcs, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) listVPCs(w http.ResponseWriter, r *http.Request) {
+	var ids []int
+	for id := range s.vpcs {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	start, end, links, meta := s.page(r, len(ids))
+	list := make([]*binarylane.VPC, 0, end-start)
+	for _, id := range ids[start:end] {
+		list = append(list, s.vpcs[id])
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"vpcs": list, "links": links, "meta": meta})
+}
+
+func (s *Server) createVPC(w http.ResponseWriter, r *http.Request) {
+	req := new(binarylane.VPCCreateRequest)
+	if !decode(w, r, req) {
+		return
+	}
+	if req.Name == "" || req.RegionSlug == "" {
+		unprocessable(w, "Name and region are required.")
+		return
+	}
+
+	vpc := &binarylane.VPC{
+		ID:          s.newID(),
+		Name:        req.Name,
+		Description: req.Description,
+		IPRange:     req.IPRange,
+		RegionSlug:  req.RegionSlug,
+		CreatedAt:   time.Now().UTC().Truncate(time.Second),
+	}
+	if vpc.IPRange == "" {
+		vpc.IPRange = fmt.Sprintf("10.%d.0.0/20", vpc.ID%256)
+	}
+
+	// The first VPC in a region becomes its default.
+	vpc.Default = true
+	for _, other := range s.vpcs {
+		if other.RegionSlug == vpc.RegionSlug {
+			vpc.Default = false
+			break
+		}
+	}
+	s.vpcs[vpc.ID] = vpc
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"vpc": vpc})
+}
+
+// setDefaultVPC makes vpc the default in its region.
+func (s *Server) setDefaultVPC(vpc *binarylane.VPC) {
+	for _, other := range s.vpcs {
+		if other.RegionSlug == vpc.RegionSlug {
+			other.Default = false
+		}
+	}
+	vpc.Default = true
+}
@@ -0,0 +1,69 @@
+package binarylanetest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+func TestVPCs_Lifecycle(t *testing.T) {
+	_, client := setup(t, WithActionPolls(0))
+
+	first, _, err := client.VPCs.Create(ctx, &binarylane.VPCCreateRequest{Name: "first", RegionSlug: "syd"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !first.Default || first.IPRange == "" {
+		t.Errorf("First VPC in region = %+v, expected default with an IP range", first)
+	}
+
+	second, _, err := client.VPCs.Create(ctx, &binarylane.VPCCreateRequest{Name: "second", RegionSlug: "syd", IPRange: "10.10.0.0/20"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.Default || second.IPRange != "10.10.0.0/20" {
+		t.Errorf("Second VPC in region = %+v", second)
+	}
+
+	second, _, err = client.VPCs.Set(ctx, second.ID, binarylane.VPCSetName("renamed"), binarylane.VPCSetDefault())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.Name != "renamed" || !second.Default {
+		t.Errorf("VPCs.Set returned %+v", second)
+	}
+	first, _, err = client.VPCs.Get(ctx, first.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Default {
+		t.Error("Previous default VPC is still the default")
+	}
+
+	_, _, err = client.Servers.Create(ctx, &binarylane.ServerCreateRequest{
+		Name:   "web",
+		Region: "syd",
+		Size:   "std-min",
+		Image:  binarylane.ServerCreateImage{Slug: "ubuntu-20.04"},
+		VPCID:  second.ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.VPCs.Delete(ctx, second.ID)
+	if err == nil || resp.StatusCode != http.StatusConflict {
+		t.Errorf("Deleting a VPC with members returned %v", err)
+	}
+
+	if _, err := client.VPCs.Delete(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	}
+	vpcs, err := client.VPCs.ListAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vpcs) != 1 || vpcs[0].ID != second.ID {
+		t.Errorf("VPCs.ListAll returned %+v", vpcs)
+	}
+}
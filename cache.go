@@ -0,0 +1,492 @@
+package binarylane
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	defaultCacheEntries = 1000
+
+	headerETag            = "ETag"
+	headerLastModified    = "Last-Modified"
+	headerIfNoneMatch     = "If-None-Match"
+	headerIfModifiedSince = "If-Modified-Since"
+	headerCacheControl    = "Cache-Control"
+
+	// headerFromCache marks responses served from the cache without
+	// contacting the API.
+	headerFromCache = "X-From-Cache"
+)
+
+// DefaultCacheTTLs are the TTLs used by WithCache when CacheOptions.TTLs is
+// nil. They cover reference data that rarely changes.
+var DefaultCacheTTLs = map[string]time.Duration{
+	"/v2/regions": time.Hour,
+	"/v2/sizes":   time.Hour,
+	"/v2/images":  5 * time.Minute,
+	"/v2/account": 5 * time.Minute,
+}
+
+// CachedResponse is a response stored in a CacheStore.
+type CachedResponse struct {
+	// URL is the URL of the request the response was for.
+	URL        string      `json:"url"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+
+	// Expires is when the response must be revalidated with the API before
+	// it is used again.
+	Expires time.Time `json:"expires"`
+}
+
+// CacheStore stores cached responses. Implementations must be safe for
+// concurrent use.
+type CacheStore interface {
+	Get(key string) (*CachedResponse, bool)
+	Set(key string, r *CachedResponse)
+	Delete(key string)
+	// Keys returns the key of every stored response.
+	Keys() []string
+}
+
+// CacheOptions configure the response cache added by WithCache.
+type CacheOptions struct {
+	// Store holds cached responses. Defaults to an in-memory LRU cache of
+	// 1000 responses.
+	Store CacheStore
+
+	// TTLs maps URL path prefixes, such as "/v2/regions", to how long
+	// responses to GET requests for matching paths are used without
+	// revalidation. The longest matching prefix applies. A TTL of 0 caches
+	// the response but revalidates it on every request. Defaults to
+	// DefaultCacheTTLs.
+	TTLs map[string]time.Duration
+
+	// DefaultTTL applies to paths that do not match any prefix in TTLs. If
+	// it is 0, those paths are not cached.
+	DefaultTTL time.Duration
+}
+
+// WithCache is a client option that caches responses to GET requests,
+// according to opts. Fresh responses are served without contacting the API;
+// stale responses with an ETag or Last-Modified header are revalidated with
+// a conditional request. A successful POST, PUT, PATCH or DELETE request
+// removes cached responses for the same path, its parents and its children,
+// so that the client sees its own changes.
+//
+// Cached responses are keyed by the request's Authorization header, so that
+// clients for different accounts can share a Store. When the client
+// authenticates with an oauth2.Transport, as it does when created with
+// NewFromToken or NewFromTokenSource, the cache is installed beneath it so
+// that it sees the header. Clients that authenticate some other way must set
+// the header before the request reaches the cache, or must not share a Store.
+//
+// Responses served from the cache do not update the client's Rate or its
+// rate limiter, and are not held back by the rate limiter.
+func WithCache(opts CacheOptions) ClientOpt {
+	return func(c *Client) error {
+		hc := *c.client
+		if auth, ok := hc.Transport.(*oauth2.Transport); ok {
+			hc.Transport = &oauth2.Transport{
+				Source: auth.Source,
+				Base:   NewCachingTransport(auth.Base, opts),
+			}
+		} else {
+			hc.Transport = NewCachingTransport(hc.Transport, opts)
+		}
+		c.client = &hc
+		c.cached = true
+		return nil
+	}
+}
+
+// cachingTransport is an http.RoundTripper that caches responses.
+type cachingTransport struct {
+	next       http.RoundTripper
+	store      CacheStore
+	ttls       map[string]time.Duration
+	defaultTTL time.Duration
+	now        func() time.Time
+}
+
+// NewCachingTransport returns an http.RoundTripper that caches the responses
+// of next as described by WithCache. If next is nil, http.DefaultTransport
+// is used.
+func NewCachingTransport(next http.RoundTripper, opts CacheOptions) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if opts.Store == nil {
+		opts.Store = NewMemoryCache(defaultCacheEntries)
+	}
+	if opts.TTLs == nil {
+		opts.TTLs = DefaultCacheTTLs
+	}
+
+	ttls := make(map[string]time.Duration, len(opts.TTLs))
+	for prefix, ttl := range opts.TTLs {
+		ttls["/"+strings.TrimPrefix(prefix, "/")] = ttl
+	}
+
+	return &cachingTransport{
+		next:       next,
+		store:      opts.Store,
+		ttls:       ttls,
+		defaultTTL: opts.DefaultTTL,
+		now:        time.Now,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		resp, err := t.send(req)
+		if err == nil && resp.StatusCode < 400 && req.Method != http.MethodHead && req.Method != http.MethodOptions {
+			t.invalidate(req.URL.Path)
+		}
+		return resp, err
+	}
+
+	ttl, ok := t.ttlFor(req.URL.Path)
+	if !ok {
+		return t.send(req)
+	}
+
+	key := cacheKey(req)
+	cached, found := t.store.Get(key)
+	if found && t.now().Before(cached.Expires) {
+		resp := cached.response(req, nil)
+		resp.Header.Set(headerFromCache, "1")
+		return resp, nil
+	}
+
+	outReq := req
+	if found && req.Header.Get(headerIfNoneMatch) == "" && req.Header.Get(headerIfModifiedSince) == "" {
+		etag, modified := cached.Header.Get(headerETag), cached.Header.Get(headerLastModified)
+		if etag != "" || modified != "" {
+			outReq = req.Clone(req.Context())
+			if etag != "" {
+				outReq.Header.Set(headerIfNoneMatch, etag)
+			}
+			if modified != "" {
+				outReq.Header.Set(headerIfModifiedSince, modified)
+			}
+		}
+	}
+
+	resp, err := t.send(outReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && outReq != req {
+		discardBody(resp.Body)
+		cached.Expires = t.now().Add(ttl)
+		for name, values := range resp.Header {
+			cached.Header[name] = values
+		}
+		t.store.Set(key, cached)
+		return cached.response(req, resp.Header), nil
+	}
+
+	if resp.StatusCode != http.StatusOK || strings.Contains(resp.Header.Get(headerCacheControl), "no-store") {
+		return resp, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	t.store.Set(key, &CachedResponse{
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+		Expires:    t.now().Add(ttl),
+	})
+	return resp, nil
+}
+
+// send passes req on to the next transport, once the rate limiter carried by
+// its context, if any, allows.
+func (t *cachingTransport) send(req *http.Request) (*http.Response, error) {
+	if err := waitRateLimit(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
+
+// ttlFor returns the TTL for path, and false if it is not cached.
+func (t *cachingTransport) ttlFor(path string) (time.Duration, bool) {
+	best := -1
+	var ttl time.Duration
+	for prefix, d := range t.ttls {
+		if pathHasPrefix(path, prefix) && len(prefix) > best {
+			best, ttl = len(prefix), d
+		}
+	}
+	if best >= 0 {
+		return ttl, true
+	}
+	return t.defaultTTL, t.defaultTTL > 0
+}
+
+// invalidate removes cached responses for path, its parents and its
+// children. The API version prefix alone is not treated as a parent.
+func (t *cachingTransport) invalidate(path string) {
+	path = strings.TrimSuffix(path, "/")
+	for _, key := range t.store.Keys() {
+		cached, ok := t.store.Get(key)
+		if !ok {
+			continue
+		}
+		u, err := url.Parse(cached.URL)
+		if err != nil {
+			continue
+		}
+		cachedPath := strings.TrimSuffix(u.Path, "/")
+		if pathHasPrefix(cachedPath, path) || (pathHasPrefix(path, cachedPath) && strings.Count(cachedPath, "/") > 1) {
+			t.store.Delete(key)
+		}
+	}
+}
+
+// fromCache reports whether resp was served from the cache without
+// contacting the API.
+func fromCache(resp *http.Response) bool {
+	return resp.Header.Get(headerFromCache) != ""
+}
+
+// pathHasPrefix reports whether path is prefix or lies beneath it.
+func pathHasPrefix(path, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// cacheKey identifies the response to req. The Authorization header is
+// included, hashed, so that clients for different accounts sharing a store
+// do not see each other's responses.
+func cacheKey(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.Header.Get(headerAuthorization)))
+	return hex.EncodeToString(sum[:8]) + " " + req.URL.String()
+}
+
+// response builds an http.Response for req from the cached response. Any
+// header in override, such as those of a 304 response, replaces the cached
+// value.
+func (r *CachedResponse) response(req *http.Request, override http.Header) *http.Response {
+	header := r.Header.Clone()
+	for name, values := range override {
+		header[name] = values
+	}
+
+	return &http.Response{
+		Status:        strconv.Itoa(r.StatusCode) + " " + http.StatusText(r.StatusCode),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+// memoryCache is an in-memory CacheStore that evicts the least recently used
+// response once it is full.
+type memoryCache struct {
+	mu      sync.Mutex
+	max     int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type memoryCacheEntry struct {
+	key      string
+	response *CachedResponse
+}
+
+// NewMemoryCache returns an in-memory CacheStore holding at most maxEntries
+// responses, evicting the least recently used.
+func NewMemoryCache(maxEntries int) CacheStore {
+	if maxEntries <= 0 {
+		maxEntries = defaultCacheEntries
+	}
+	return &memoryCache{max: maxEntries, order: list.New(), entries: make(map[string]*list.Element)}
+}
+
+func (c *memoryCache) Get(key string) (*CachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*memoryCacheEntry).response.copy(), true
+}
+
+func (c *memoryCache) Set(key string, r *CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*memoryCacheEntry).response = r.copy()
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&memoryCacheEntry{key: key, response: r.copy()})
+	for c.order.Len() > c.max {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+func (c *memoryCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		c.order.Remove(el)
+		delete(c.entries, key)
+	}
+}
+
+func (c *memoryCache) Keys() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys := make([]string, 0, len(c.entries))
+	for key := range c.entries {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// copy returns a copy of r that does not share its header map, so that
+// callers may modify it.
+func (r *CachedResponse) copy() *CachedResponse {
+	c := *r
+	c.Header = r.Header.Clone()
+	return &c
+}
+
+// fileCache is a CacheStore that keeps each response in a JSON file.
+type fileCache struct {
+	mu  sync.Mutex
+	dir string
+}
+
+type fileCacheEntry struct {
+	Key      string          `json:"key"`
+	Response *CachedResponse `json:"response"`
+}
+
+// NewFileCache returns a CacheStore that keeps responses as files in dir,
+// creating it if necessary, so that they survive restarts and can be shared
+// by several processes.
+func NewFileCache(dir string) (CacheStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &fileCache{dir: dir}, nil
+}
+
+func (c *fileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (c *fileCache) read(path string) (*fileCacheEntry, bool) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	entry := new(fileCacheEntry)
+	if err := json.Unmarshal(data, entry); err != nil || entry.Response == nil {
+		return nil, false
+	}
+	return entry, true
+}
+
+func (c *fileCache) Get(key string) (*CachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.read(c.path(key))
+	if !ok || entry.Key != key {
+		return nil, false
+	}
+	return entry.Response, true
+}
+
+func (c *fileCache) Set(key string, r *CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.Marshal(&fileCacheEntry{Key: key, Response: r})
+	if err != nil {
+		return
+	}
+
+	// Write to a temporary file and rename it, so that readers never see a
+	// partly written entry.
+	tmp, err := ioutil.TempFile(c.dir, ".tmp-")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(data)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), c.path(key))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+func (c *fileCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	os.Remove(c.path(key))
+}
+
+func (c *fileCache) Keys() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	paths, _ := filepath.Glob(filepath.Join(c.dir, "*.json"))
+	keys := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if entry, ok := c.read(path); ok {
+			keys = append(keys, entry.Key)
+		}
+	}
+	return keys
+}
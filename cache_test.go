@@ -0,0 +1,383 @@
+package binarylane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func setupCache(t *testing.T, opts CacheOptions) {
+	if err := WithCache(opts)(client); err != nil {
+		t.Fatalf("WithCache(): %v", err)
+	}
+}
+
+func TestCache_servesFreshResponses(t *testing.T) {
+	setup()
+	defer teardown()
+	setupCache(t, CacheOptions{})
+
+	requests := 0
+	mux.HandleFunc("/v2/regions", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"regions":[{"slug":"syd"}]}`)
+	})
+
+	for i := 0; i < 3; i++ {
+		regions, _, err := client.Regions.List(ctx, nil)
+		if err != nil {
+			t.Fatalf("Regions.List returned error: %v", err)
+		}
+		expected := []Region{{Slug: "syd"}}
+		if !reflect.DeepEqual(regions, expected) {
+			t.Errorf("Regions.List returned %+v, expected %+v", regions, expected)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("API received %d requests, expected 1", requests)
+	}
+}
+
+func TestCache_skipsUnlistedPaths(t *testing.T) {
+	setup()
+	defer teardown()
+	setupCache(t, CacheOptions{})
+
+	requests := 0
+	mux.HandleFunc("/v2/servers/1", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"server":{"id":1}}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Servers.Get(ctx, 1); err != nil {
+			t.Fatalf("Servers.Get returned error: %v", err)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("API received %d requests, expected 2", requests)
+	}
+}
+
+func TestCache_revalidatesWithETag(t *testing.T) {
+	setup()
+	defer teardown()
+	setupCache(t, CacheOptions{TTLs: map[string]time.Duration{"/v2/account": 0}})
+
+	var conditional []string
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		conditional = append(conditional, r.Header.Get("If-None-Match"))
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"account":{"email":"sammy@example.com"}}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		account, resp, err := client.Account.Get(ctx)
+		if err != nil {
+			t.Fatalf("Account.Get returned error: %v", err)
+		}
+		if account.Email != "sammy@example.com" || resp.StatusCode != http.StatusOK {
+			t.Errorf("Account.Get returned %+v with status %d", account, resp.StatusCode)
+		}
+	}
+
+	expected := []string{"", `"v1"`}
+	if !reflect.DeepEqual(conditional, expected) {
+		t.Errorf("If-None-Match headers = %q, expected %q", conditional, expected)
+	}
+}
+
+func TestCache_revalidatesWithLastModified(t *testing.T) {
+	var ifModifiedSince []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifModifiedSince = append(ifModifiedSince, r.Header.Get("If-Modified-Since"))
+		if r.Header.Get("If-Modified-Since") != "" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		fmt.Fprint(w, "body")
+	}))
+	defer api.Close()
+
+	now := time.Now()
+	transport := NewCachingTransport(nil, CacheOptions{TTLs: map[string]time.Duration{"/": time.Minute}}).(*cachingTransport)
+	transport.now = func() time.Time { return now }
+	hc := &http.Client{Transport: transport}
+
+	get := func() string {
+		resp, err := hc.Get(api.URL + "/v2/sizes")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := ioutil.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	get()
+	now = now.Add(30 * time.Second)
+	get()
+	now = now.Add(time.Minute)
+	if body := get(); body != "body" {
+		t.Errorf("Revalidated body = %q, expected %q", body, "body")
+	}
+
+	expected := []string{"", "Mon, 02 Jan 2006 15:04:05 GMT"}
+	if !reflect.DeepEqual(ifModifiedSince, expected) {
+		t.Errorf("If-Modified-Since headers = %q, expected %q", ifModifiedSince, expected)
+	}
+}
+
+func TestCache_invalidatesOnMutation(t *testing.T) {
+	setup()
+	defer teardown()
+	setupCache(t, CacheOptions{DefaultTTL: time.Hour})
+
+	gets := map[string]int{}
+	mux.HandleFunc("/v2/servers", func(w http.ResponseWriter, r *http.Request) {
+		gets[r.URL.Path]++
+		fmt.Fprint(w, `{"servers":[]}`)
+	})
+	mux.HandleFunc("/v2/servers/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		gets[r.URL.Path]++
+		fmt.Fprint(w, `{"server":{"id":1}}`)
+	})
+	mux.HandleFunc("/v2/sizes", func(w http.ResponseWriter, r *http.Request) {
+		gets[r.URL.Path]++
+		fmt.Fprint(w, `{"sizes":[]}`)
+	})
+
+	fetch := func() {
+		if _, _, err := client.Servers.List(ctx, nil); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := client.Servers.Get(ctx, 1); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := client.Sizes.List(ctx, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fetch()
+	if _, err := client.Servers.Delete(ctx, 1); err != nil {
+		t.Fatalf("Servers.Delete returned error: %v", err)
+	}
+	fetch()
+
+	expected := map[string]int{"/v2/servers": 2, "/v2/servers/1": 2, "/v2/sizes": 1}
+	if !reflect.DeepEqual(gets, expected) {
+		t.Errorf("GET requests = %v, expected %v", gets, expected)
+	}
+}
+
+func TestCache_noStore(t *testing.T) {
+	setup()
+	defer teardown()
+	setupCache(t, CacheOptions{})
+
+	requests := 0
+	mux.HandleFunc("/v2/regions", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Cache-Control", "no-store")
+		fmt.Fprint(w, `{"regions":[]}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Regions.List(ctx, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("API received %d requests, expected 2", requests)
+	}
+}
+
+func TestCache_keyIncludesAuthorization(t *testing.T) {
+	a, _ := http.NewRequest(http.MethodGet, "https://api.binarylane.com.au/v2/regions", nil)
+	b, _ := http.NewRequest(http.MethodGet, "https://api.binarylane.com.au/v2/regions", nil)
+	a.Header.Set("Authorization", "Bearer one")
+	b.Header.Set("Authorization", "Bearer two")
+
+	if cacheKey(a) == cacheKey(b) {
+		t.Error("cacheKey is the same for different Authorization headers")
+	}
+	if strings.Contains(cacheKey(a), "one") {
+		t.Errorf("cacheKey %q contains the token", cacheKey(a))
+	}
+}
+
+func TestCache_sharedStoreAcrossTokens(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		email := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ") + "@example.com"
+		fmt.Fprintf(w, `{"account":{"email":%q}}`, email)
+	})
+
+	store := NewMemoryCache(0)
+	for _, token := range []string{"alice", "bob", "alice"} {
+		c := NewFromToken(token)
+		if err := SetBaseURL(server.URL)(c); err != nil {
+			t.Fatal(err)
+		}
+		if err := WithCache(CacheOptions{Store: store})(c); err != nil {
+			t.Fatal(err)
+		}
+
+		account, _, err := c.Account.Get(ctx)
+		if err != nil {
+			t.Fatalf("Account.Get returned error: %v", err)
+		}
+		if expected := token + "@example.com"; account.Email != expected {
+			t.Errorf("Account.Get for %s returned %q, expected %q", token, account.Email, expected)
+		}
+	}
+	if n := len(store.Keys()); n != 2 {
+		t.Errorf("Store holds %d responses, expected 2", n)
+	}
+}
+
+func TestCache_hitsDoNotUpdateRate(t *testing.T) {
+	setup()
+	defer teardown()
+	setupCache(t, CacheOptions{})
+
+	remaining := 100
+	mux.HandleFunc("/v2/regions", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "100")
+		w.Header().Set(headerRateRemaining, fmt.Sprint(remaining))
+		fmt.Fprint(w, `{"regions":[]}`)
+	})
+	mux.HandleFunc("/v2/sizes", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "100")
+		w.Header().Set(headerRateRemaining, fmt.Sprint(remaining))
+		fmt.Fprint(w, `{"sizes":[]}`)
+	})
+
+	if _, _, err := client.Regions.List(ctx, nil); err != nil {
+		t.Fatalf("Regions.List returned error: %v", err)
+	}
+	remaining = 50
+	if _, _, err := client.Sizes.List(ctx, nil); err != nil {
+		t.Fatalf("Sizes.List returned error: %v", err)
+	}
+	if _, _, err := client.Regions.List(ctx, nil); err != nil {
+		t.Fatalf("Regions.List returned error: %v", err)
+	}
+
+	if rate := client.GetRate(); rate.Remaining != 50 {
+		t.Errorf("Client Rate.Remaining = %d after a cache hit, expected 50", rate.Remaining)
+	}
+}
+
+func TestCache_hitsBypassRateLimiter(t *testing.T) {
+	setup()
+	defer teardown()
+	if err := WithRateLimiter(5)(client); err != nil {
+		t.Fatal(err)
+	}
+	setupCache(t, CacheOptions{})
+
+	requests := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		for name, values := range rateHeader(100, 5, time.Now().Add(time.Hour)) {
+			w.Header()[name] = values
+		}
+		fmt.Fprint(w, `{"regions":[],"servers":[]}`)
+	}
+	mux.HandleFunc("/v2/regions", handler)
+	mux.HandleFunc("/v2/servers", handler)
+
+	if _, _, err := client.Regions.List(ctx, nil); err != nil {
+		t.Fatalf("Regions.List returned error: %v", err)
+	}
+
+	// The limiter is now at its floor. Cached responses are still served,
+	// but requests to the API wait for the window to reset.
+	cctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	for i := 0; i < 3; i++ {
+		if _, _, err := client.Regions.List(cctx, nil); err != nil {
+			t.Fatalf("Regions.List from the cache returned error: %v", err)
+		}
+	}
+	if _, _, err := client.Servers.List(cctx, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Servers.List returned %v, expected to wait on the rate limiter", err)
+	}
+	if requests != 1 {
+		t.Errorf("API received %d requests, expected 1", requests)
+	}
+}
+
+func TestMemoryCache_evictsLeastRecentlyUsed(t *testing.T) {
+	store := NewMemoryCache(2)
+	store.Set("a", &CachedResponse{StatusCode: 200})
+	store.Set("b", &CachedResponse{StatusCode: 200})
+	store.Get("a")
+	store.Set("c", &CachedResponse{StatusCode: 200})
+
+	if _, ok := store.Get("b"); ok {
+		t.Error("Least recently used entry was not evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := store.Get(key); !ok {
+			t.Errorf("Entry %q was evicted", key)
+		}
+	}
+}
+
+func TestFileCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "binarylane-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	store, err := NewFileCache(dir)
+	if err != nil {
+		t.Fatalf("NewFileCache returned error: %v", err)
+	}
+
+	expected := &CachedResponse{
+		URL:        "https://api.binarylane.com.au/v2/regions",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Etag": {`"v1"`}},
+		Body:       []byte(`{"regions":[]}`),
+		Expires:    time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	store.Set("key", expected)
+
+	reopened, _ := NewFileCache(dir)
+	got, ok := reopened.Get("key")
+	if !ok || !reflect.DeepEqual(got, expected) {
+		t.Errorf("FileCache.Get returned %+v, %v, expected %+v", got, ok, expected)
+	}
+	if keys := reopened.Keys(); !reflect.DeepEqual(keys, []string{"key"}) {
+		t.Errorf("FileCache.Keys returned %v", keys)
+	}
+
+	reopened.Delete("key")
+	if _, ok := store.Get("key"); ok {
+		t.Error("FileCache.Get found a deleted entry")
+	}
+}
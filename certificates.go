@@ -0,0 +1,256 @@
+package binarylane
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	certificatesBasePath = "v2/certificates"
+
+	// CertificateTypeCustom is a certificate uploaded as a PEM encoded
+	// private key and certificate chain.
+	CertificateTypeCustom = "custom"
+
+	// CertificateTypeLetsEncrypt is a certificate issued and renewed by
+	// BinaryLane for the requested DNS names.
+	CertificateTypeLetsEncrypt = "lets_encrypt"
+)
+
+// CertificatesService is an interface for managing certificates with the
+// BinaryLane API. Certificates are used for TLS termination by load balancer
+// forwarding rules.
+// See: https://api.binarylane.com.au/reference#certificates
+type CertificatesService interface {
+	List(context.Context, *ListOptions) ([]Certificate, *Response, error)
+	ListAll(context.Context) ([]Certificate, error)
+	Get(context.Context, string) (*Certificate, *Response, error)
+	Create(context.Context, *CertificateRequest) (*Certificate, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+}
+
+// CertificatesServiceOp handles communication with the certificate related
+// methods of the BinaryLane API.
+type CertificatesServiceOp struct {
+	client *Client
+}
+
+var _ CertificatesService = &CertificatesServiceOp{}
+
+// Certificate represents a BinaryLane certificate configuration.
+type Certificate struct {
+	ID              string   `json:"id,omitempty"`
+	Name            string   `json:"name,omitempty"`
+	DNSNames        []string `json:"dns_names,omitempty"`
+	NotAfter        string   `json:"not_after,omitempty"`
+	SHA1Fingerprint string   `json:"sha1_fingerprint,omitempty"`
+	Created         string   `json:"created_at,omitempty"`
+	State           string   `json:"state,omitempty"`
+	Type            string   `json:"type,omitempty"`
+}
+
+func (c Certificate) String() string {
+	return Stringify(c)
+}
+
+// CertificateRequest represents configuration for a new certificate. Custom
+// certificates require PrivateKey and LeafCertificate, and optionally
+// CertificateChain. Let's Encrypt certificates require DNSNames.
+type CertificateRequest struct {
+	Name             string   `json:"name,omitempty"`
+	DNSNames         []string `json:"dns_names,omitempty"`
+	PrivateKey       string   `json:"private_key,omitempty"`
+	LeafCertificate  string   `json:"leaf_certificate,omitempty"`
+	CertificateChain string   `json:"certificate_chain,omitempty"`
+	Type             string   `json:"type,omitempty"`
+}
+
+type certificateRoot struct {
+	Certificate *Certificate `json:"certificate"`
+}
+
+type certificatesRoot struct {
+	Certificates []Certificate `json:"certificates"`
+	Links        *Links        `json:"links"`
+	Meta         *Meta         `json:"meta"`
+}
+
+// Validate checks that the request is complete for its type and, for custom
+// certificates, that the PEM encoded inputs parse and that the private key
+// matches the leaf certificate.
+func (r *CertificateRequest) Validate() error {
+	if r.Name == "" {
+		return NewArgError("Name", "cannot be empty")
+	}
+
+	switch r.Type {
+	case CertificateTypeLetsEncrypt:
+		if len(r.DNSNames) == 0 {
+			return NewArgError("DNSNames", "cannot be empty for a lets_encrypt certificate")
+		}
+		if r.PrivateKey != "" || r.LeafCertificate != "" || r.CertificateChain != "" {
+			return NewArgError("Type", "lets_encrypt certificates cannot include PEM data")
+		}
+		return nil
+	case "", CertificateTypeCustom:
+	default:
+		return NewArgError("Type", fmt.Sprintf("must be %q or %q", CertificateTypeCustom, CertificateTypeLetsEncrypt))
+	}
+
+	if r.PrivateKey == "" {
+		return NewArgError("PrivateKey", "cannot be empty for a custom certificate")
+	}
+	if r.LeafCertificate == "" {
+		return NewArgError("LeafCertificate", "cannot be empty for a custom certificate")
+	}
+	if _, err := parsePEMCertificates(r.LeafCertificate); err != nil {
+		return NewArgError("LeafCertificate", err.Error())
+	}
+	if r.CertificateChain != "" {
+		if _, err := parsePEMCertificates(r.CertificateChain); err != nil {
+			return NewArgError("CertificateChain", err.Error())
+		}
+	}
+	if _, err := tls.X509KeyPair([]byte(r.LeafCertificate), []byte(r.PrivateKey)); err != nil {
+		return NewArgError("PrivateKey", err.Error())
+	}
+
+	return nil
+}
+
+// parsePEMCertificates decodes every PEM block in data as an X.509
+// certificate.
+func parsePEMCertificates(data string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("it contains a %q PEM block, expected CERTIFICATE", block.Type)
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("it could not be parsed: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("it contains no PEM encoded certificates")
+	}
+	if len(strings.TrimSpace(string(rest))) > 0 {
+		return nil, fmt.Errorf("it contains trailing data that is not PEM encoded")
+	}
+
+	return certs, nil
+}
+
+// Get an existing certificate by its identifier.
+func (c *CertificatesServiceOp) Get(ctx context.Context, cID string) (*Certificate, *Response, error) {
+	ctx = withOperation(ctx, "Certificates.Get")
+	path := fmt.Sprintf("%s/%s", certificatesBasePath, cID)
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(certificateRoot)
+	resp, err := c.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Certificate, resp, nil
+}
+
+// List all certificates.
+func (c *CertificatesServiceOp) List(ctx context.Context, opt *ListOptions) ([]Certificate, *Response, error) {
+	ctx = withOperation(ctx, "Certificates.List")
+	path, err := addOptions(certificatesBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(certificatesRoot)
+	resp, err := c.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+	if m := root.Meta; m != nil {
+		resp.Meta = m
+	}
+
+	return root.Certificates, resp, nil
+}
+
+// ListAll lists all certificates, fetching every page of results.
+func (c *CertificatesServiceOp) ListAll(ctx context.Context) ([]Certificate, error) {
+	var list []Certificate
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := c.List(ctx, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// Create a new certificate with provided configuration. The request is
+// validated before it is sent.
+func (c *CertificatesServiceOp) Create(ctx context.Context, cr *CertificateRequest) (*Certificate, *Response, error) {
+	ctx = withOperation(ctx, "Certificates.Create")
+	if cr == nil {
+		return nil, nil, NewArgError("cr", "cannot be nil")
+	}
+	if err := cr.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.client.NewRequest(ctx, http.MethodPost, certificatesBasePath, cr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(certificateRoot)
+	resp, err := c.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Certificate, resp, nil
+}
+
+// Delete a certificate by its identifier.
+func (c *CertificatesServiceOp) Delete(ctx context.Context, cID string) (*Response, error) {
+	ctx = withOperation(ctx, "Certificates.Delete")
+	path := fmt.Sprintf("%s/%s", certificatesBasePath, cID)
+
+	req, err := c.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.client.Do(ctx, req, nil)
+}
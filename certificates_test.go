@@ -0,0 +1,266 @@
+package binarylane
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var certJSONResponse = `
+{
+	"certificate": {
+		"id": "892071a0-bb95-49bc-8021-3afd67a210bf",
+		"name": "web-cert-01",
+		"dns_names": ["www.example.com"],
+		"not_after": "2021-02-22T00:00:00Z",
+		"sha1_fingerprint": "dfcc9f57d86bf58e321c2c6c31c7a971be244ac7",
+		"created_at": "2021-02-20T00:00:00Z",
+		"state": "verified",
+		"type": "custom"
+	}
+}
+`
+
+var expectedCertificate = &Certificate{
+	ID:              "892071a0-bb95-49bc-8021-3afd67a210bf",
+	Name:            "web-cert-01",
+	DNSNames:        []string{"www.example.com"},
+	NotAfter:        "2021-02-22T00:00:00Z",
+	SHA1Fingerprint: "dfcc9f57d86bf58e321c2c6c31c7a971be244ac7",
+	Created:         "2021-02-20T00:00:00Z",
+	State:           "verified",
+	Type:            CertificateTypeCustom,
+}
+
+// testCertificatePEM returns a PEM encoded self-signed certificate and its
+// private key.
+func testCertificatePEM(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "www.example.com"},
+		DNSNames:     []string{"www.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	priv := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(cert), string(priv)
+}
+
+func TestCertificates_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cID := "892071a0-bb95-49bc-8021-3afd67a210bf"
+	urlStr := fmt.Sprintf("/v2/certificates/%s", cID)
+	mux.HandleFunc(urlStr, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, certJSONResponse)
+	})
+
+	certificate, _, err := client.Certificates.Get(ctx, cID)
+	if err != nil {
+		t.Errorf("Certificates.Get returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(certificate, expectedCertificate) {
+		t.Errorf("Certificates.Get returned %+v, expected %+v", certificate, expectedCertificate)
+	}
+}
+
+func TestCertificates_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/certificates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"certificates":[{"id":"c1","name":"web"},{"id":"c2","name":"api"}],"meta":{"total":2}}`)
+	})
+
+	certificates, resp, err := client.Certificates.List(ctx, nil)
+	if err != nil {
+		t.Errorf("Certificates.List returned error: %v", err)
+	}
+
+	expected := []Certificate{{ID: "c1", Name: "web"}, {ID: "c2", Name: "api"}}
+	if !reflect.DeepEqual(certificates, expected) {
+		t.Errorf("Certificates.List returned %+v, expected %+v", certificates, expected)
+	}
+
+	expectedMeta := &Meta{Total: 2}
+	if !reflect.DeepEqual(resp.Meta, expectedMeta) {
+		t.Errorf("Certificates.List returned meta %+v, expected %+v", resp.Meta, expectedMeta)
+	}
+}
+
+func TestCertificates_CreateCustom(t *testing.T) {
+	setup()
+	defer teardown()
+
+	leaf, key := testCertificatePEM(t)
+	createRequest := &CertificateRequest{
+		Name:            "web-cert-01",
+		PrivateKey:      key,
+		LeafCertificate: leaf,
+		Type:            CertificateTypeCustom,
+	}
+
+	mux.HandleFunc("/v2/certificates", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CertificateRequest)
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testMethod(t, r, http.MethodPost)
+		if !reflect.DeepEqual(v, createRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, createRequest)
+		}
+
+		fmt.Fprint(w, certJSONResponse)
+	})
+
+	certificate, _, err := client.Certificates.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("Certificates.Create returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(certificate, expectedCertificate) {
+		t.Errorf("Certificates.Create returned %+v, expected %+v", certificate, expectedCertificate)
+	}
+}
+
+func TestCertificates_CreateLetsEncrypt(t *testing.T) {
+	setup()
+	defer teardown()
+
+	createRequest := &CertificateRequest{
+		Name:     "managed",
+		DNSNames: []string{"example.com", "www.example.com"},
+		Type:     CertificateTypeLetsEncrypt,
+	}
+
+	mux.HandleFunc("/v2/certificates", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CertificateRequest)
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testMethod(t, r, http.MethodPost)
+		if !reflect.DeepEqual(v, createRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, createRequest)
+		}
+
+		fmt.Fprint(w, `{"certificate":{"id":"c1","name":"managed","dns_names":["example.com","www.example.com"],"state":"pending","type":"lets_encrypt"}}`)
+	})
+
+	certificate, _, err := client.Certificates.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("Certificates.Create returned error: %v", err)
+	}
+
+	expected := &Certificate{
+		ID:       "c1",
+		Name:     "managed",
+		DNSNames: []string{"example.com", "www.example.com"},
+		State:    "pending",
+		Type:     CertificateTypeLetsEncrypt,
+	}
+	if !reflect.DeepEqual(certificate, expected) {
+		t.Errorf("Certificates.Create returned %+v, expected %+v", certificate, expected)
+	}
+}
+
+func TestCertificates_CreateInvalidNotSent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/certificates", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Invalid certificate request should not be sent")
+	})
+
+	_, _, err := client.Certificates.Create(ctx, &CertificateRequest{
+		Name:            "bad",
+		PrivateKey:      "not a key",
+		LeafCertificate: "not a certificate",
+	})
+	if _, ok := err.(*ArgError); !ok {
+		t.Errorf("Expected *ArgError, got %#v", err)
+	}
+}
+
+func TestCertificateRequest_Validate(t *testing.T) {
+	leaf, key := testCertificatePEM(t)
+	otherLeaf, otherKey := testCertificatePEM(t)
+
+	cases := []struct {
+		name    string
+		request CertificateRequest
+		valid   bool
+	}{
+		{"custom", CertificateRequest{Name: "c", PrivateKey: key, LeafCertificate: leaf}, true},
+		{"custom with chain", CertificateRequest{Name: "c", PrivateKey: key, LeafCertificate: leaf, CertificateChain: otherLeaf + leaf}, true},
+		{"missing name", CertificateRequest{PrivateKey: key, LeafCertificate: leaf}, false},
+		{"missing key", CertificateRequest{Name: "c", LeafCertificate: leaf}, false},
+		{"missing leaf", CertificateRequest{Name: "c", PrivateKey: key}, false},
+		{"mismatched key", CertificateRequest{Name: "c", PrivateKey: otherKey, LeafCertificate: leaf}, false},
+		{"key as leaf", CertificateRequest{Name: "c", PrivateKey: key, LeafCertificate: key}, false},
+		{"bad chain", CertificateRequest{Name: "c", PrivateKey: key, LeafCertificate: leaf, CertificateChain: "garbage"}, false},
+		{"trailing data", CertificateRequest{Name: "c", PrivateKey: key, LeafCertificate: leaf + "garbage"}, false},
+		{"lets encrypt", CertificateRequest{Name: "m", DNSNames: []string{"example.com"}, Type: CertificateTypeLetsEncrypt}, true},
+		{"lets encrypt without names", CertificateRequest{Name: "m", Type: CertificateTypeLetsEncrypt}, false},
+		{"lets encrypt with key", CertificateRequest{Name: "m", DNSNames: []string{"example.com"}, PrivateKey: key, Type: CertificateTypeLetsEncrypt}, false},
+		{"unknown type", CertificateRequest{Name: "m", Type: "other"}, false},
+	}
+
+	for _, c := range cases {
+		err := c.request.Validate()
+		if c.valid && err != nil {
+			t.Errorf("%q unexpected error: %v", c.name, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("%q expected error but none was returned", c.name)
+		}
+	}
+}
+
+func TestCertificates_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cID := "892071a0-bb95-49bc-8021-3afd67a210bf"
+	urlStr := fmt.Sprintf("/v2/certificates/%s", cID)
+	mux.HandleFunc(urlStr, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+	})
+
+	_, err := client.Certificates.Delete(ctx, cID)
+	if err != nil {
+		t.Errorf("Certificates.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var accountGroup = group{
+	name:    "account",
+	summary: "Show account details and limits",
+	commands: []command{
+		{
+			name:    "get",
+			summary: "Show the account the token belongs to",
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					account, _, err := client.Account.Get(ctx)
+					if err != nil {
+						return err
+					}
+					return a.render(account,
+						[]string{"Email", "UUID", "Status", "Server Limit", "Floating IP Limit", "Volume Limit"},
+						[]string{account.Email, account.UUID, account.Status, strconv.Itoa(account.ServerLimit),
+							strconv.Itoa(account.FloatingIPLimit), strconv.Itoa(account.VolumeLimit)})
+				}
+			},
+		},
+	},
+}
+
+var balanceGroup = group{
+	name:    "balance",
+	summary: "Show the account balance",
+	commands: []command{
+		{
+			name:    "get",
+			summary: "Show the account balance and month to date usage",
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					balance, _, err := client.Balance.Get(ctx)
+					if err != nil {
+						return err
+					}
+					return a.render(balance,
+						[]string{"Month To Date Balance", "Account Balance", "Month To Date Usage", "Generated At"},
+						[]string{balance.MonthToDateBalance, balance.AccountBalance, balance.MonthToDateUsage,
+							balance.GeneratedAt.Format("2006-01-02 15:04:05")})
+				}
+			},
+		},
+	},
+}
+
+var billingHistoryGroup = group{
+	name:    "billing-history",
+	summary: "List billing history",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List invoices, payments and credits",
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					var entries []binarylane.BillingHistoryEntry
+					err = listAll(ctx, func(ctx context.Context, opt *binarylane.ListOptions) (*binarylane.Response, error) {
+						page, resp, err := client.BillingHistory.List(ctx, opt)
+						if page != nil {
+							entries = append(entries, page.BillingHistory...)
+						}
+						return resp, err
+					})
+					if err != nil {
+						return err
+					}
+					rows := make([][]string, len(entries))
+					for i, e := range entries {
+						var invoice string
+						if e.InvoiceUUID != nil {
+							invoice = *e.InvoiceUUID
+						}
+						rows[i] = []string{e.Date.Format("2006-01-02"), e.Type, e.Description, e.Amount, invoice}
+					}
+					return a.render(entries, []string{"Date", "Type", "Description", "Amount", "Invoice"}, rows...)
+				}
+			},
+		},
+	},
+}
+
+var regionsGroup = group{
+	name:    "regions",
+	summary: "List regions",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List the regions servers can be created in",
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					regions, err := client.Regions.ListAll(ctx)
+					if err != nil {
+						return err
+					}
+					rows := make([][]string, len(regions))
+					for i, r := range regions {
+						rows[i] = []string{r.Slug, r.Name, yesNo(r.Available), strings.Join(r.Features, ",")}
+					}
+					return a.render(regions, []string{"Slug", "Name", "Available", "Features"}, rows...)
+				}
+			},
+		},
+	},
+}
+
+var sizesGroup = group{
+	name:    "sizes",
+	summary: "List server sizes",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List server sizes and prices",
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					sizes, err := client.Sizes.ListAll(ctx)
+					if err != nil {
+						return err
+					}
+					rows := make([][]string, len(sizes))
+					for i, s := range sizes {
+						rows[i] = []string{
+							s.Slug, strconv.Itoa(s.Vcpus), strconv.Itoa(s.Memory), strconv.Itoa(s.Disk),
+							fmt.Sprintf("%.2f", s.PriceMonthly), yesNo(s.Available),
+						}
+					}
+					return a.render(sizes, []string{"Slug", "VCPUs", "Memory (MB)", "Disk (GB)", "Price Monthly", "Available"}, rows...)
+				}
+			},
+		},
+	},
+}
+
+var actionsGroup = group{
+	name:    "actions",
+	summary: "List and wait for actions",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List every action on the account",
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					actions, err := client.Actions.ListAll(ctx)
+					if err != nil {
+						return err
+					}
+					list := make([]*binarylane.Action, len(actions))
+					for i := range actions {
+						list[i] = &actions[i]
+					}
+					return a.printActions(list...)
+				}
+			},
+		},
+		{
+			name:    "get",
+			args:    "<action-id>",
+			summary: "Show an action",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					id, err := parseID(args[0])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					return a.action(ctx)(client.Actions.Get(ctx, id))
+				}
+			},
+		},
+		{
+			name:    "wait",
+			args:    "<action-id>...",
+			summary: "Wait for actions to complete",
+			nargs:   variadic,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					if len(args) == 0 {
+						return errUsage
+					}
+					ids, err := parseIDs(args)
+					if err != nil {
+						return err
+					}
+					actions := make([]binarylane.Action, len(ids))
+					for i, id := range ids {
+						actions[i].ID = id
+					}
+					a.wait = true
+					return a.actions(ctx, actions, nil)
+				}
+			},
+		},
+	},
+}
@@ -0,0 +1,537 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+	"github.com/binarylane/go-binarylane/util"
+	"golang.org/x/oauth2"
+)
+
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+
+	defaultTimeout      = 10 * time.Minute
+	defaultPollInterval = 5 * time.Second
+	listPageSize        = 200
+
+	// variadic is the command.nargs of commands that take any number of
+	// arguments.
+	variadic = -1
+)
+
+// errUsage is returned by a command when it was given the wrong arguments.
+var errUsage = errors.New("usage")
+
+// runFunc runs a command with its positional arguments.
+type runFunc func(ctx context.Context, a *app, args []string) error
+
+// command is a verb on a resource, such as "servers list".
+type command struct {
+	name    string
+	args    string
+	summary string
+
+	// nargs is the number of positional arguments the command takes, or
+	// variadic if it takes any number.
+	nargs int
+
+	// setup defines the command's flags and returns the function that runs
+	// it.
+	setup func(fs *flag.FlagSet) runFunc
+}
+
+// group is a resource, such as "servers", and the commands it supports.
+type group struct {
+	name     string
+	summary  string
+	commands []command
+}
+
+// groups lists every resource bl supports, in the order shown by help.
+var groups = []group{
+	accountGroup,
+	actionsGroup,
+	balanceGroup,
+	billingHistoryGroup,
+	certificatesGroup,
+	domainsGroup,
+	firewallsGroup,
+	floatingIPsGroup,
+	imagesGroup,
+	invoicesGroup,
+	keysGroup,
+	loadBalancersGroup,
+	projectsGroup,
+	regionsGroup,
+	serverActionsGroup,
+	serversGroup,
+	sizesGroup,
+	snapshotsGroup,
+	tagsGroup,
+	volumesGroup,
+	vpcsGroup,
+}
+
+// app holds the global options and the API client for one invocation.
+type app struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	output       string
+	token        string
+	context      string
+	config       string
+	apiURL       string
+	wait         bool
+	debug        bool
+	timeout      time.Duration
+	pollInterval time.Duration
+
+	client *binarylane.Client
+}
+
+// globalFlags defines the flags accepted before the resource name and by
+// every command.
+func (a *app) globalFlags(fs *flag.FlagSet) {
+	fs.StringVar(&a.output, "output", a.output, "output `format`: table, json or yaml")
+	fs.StringVar(&a.output, "o", a.output, "shorthand for --output")
+	fs.StringVar(&a.token, "token", a.token, "API `token`, overriding the environment and config file")
+	fs.StringVar(&a.context, "context", a.context, "`name` of the config file context to use")
+	fs.StringVar(&a.config, "config", a.config, "`path` of the config file")
+	fs.StringVar(&a.apiURL, "api-url", a.apiURL, "base `URL` of the API")
+	fs.BoolVar(&a.wait, "wait", a.wait, "wait for actions to complete")
+	fs.BoolVar(&a.debug, "debug", a.debug, "log each request and response to standard error")
+	fs.DurationVar(&a.timeout, "timeout", a.timeout, "time limit for the command, including --wait")
+	fs.DurationVar(&a.pollInterval, "poll-interval", a.pollInterval, "delay between polls of an action with --wait")
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	a := &app{
+		stdin:        stdin,
+		stdout:       stdout,
+		stderr:       stderr,
+		output:       "table",
+		apiURL:       os.Getenv("BINARYLANE_API_URL"),
+		timeout:      defaultTimeout,
+		pollInterval: defaultPollInterval,
+	}
+
+	fs := flag.NewFlagSet("bl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	a.globalFlags(fs)
+	fs.Usage = func() { a.usage(fs) }
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	args = fs.Args()
+	if len(args) == 0 {
+		a.usage(fs)
+		return exitUsage
+	}
+	if args[0] == "help" {
+		if len(args) > 1 {
+			if g := findGroup(args[1]); g != nil {
+				a.groupUsage(g)
+				return exitOK
+			}
+		}
+		a.usage(fs)
+		return exitOK
+	}
+
+	g := findGroup(args[0])
+	if g == nil {
+		fmt.Fprintf(stderr, "bl: unknown resource %q\n", args[0])
+		return exitUsage
+	}
+	if len(args) == 1 {
+		a.groupUsage(g)
+		return exitUsage
+	}
+	cmd := g.find(args[1])
+	if cmd == nil {
+		fmt.Fprintf(stderr, "bl %s: unknown command %q\n", g.name, args[1])
+		a.groupUsage(g)
+		return exitUsage
+	}
+
+	return a.runCommand(g, cmd, args[2:])
+}
+
+func (a *app) runCommand(g *group, cmd *command, args []string) int {
+	name := g.name + " " + cmd.name
+	fs := flag.NewFlagSet("bl "+name, flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	a.globalFlags(fs)
+	runCmd := cmd.setup(fs)
+	fs.Usage = func() {
+		fmt.Fprintf(a.stderr, "Usage: bl %s [flags] %s\n\n%s\n\nFlags:\n", name, cmd.args, cmd.summary)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(interleave(fs, args)); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	args = fs.Args()
+	if cmd.nargs != variadic && len(args) != cmd.nargs {
+		fs.Usage()
+		return exitUsage
+	}
+	switch a.output {
+	case "table", "json", "yaml":
+	default:
+		fmt.Fprintf(a.stderr, "bl %s: unknown output format %q\n", name, a.output)
+		return exitUsage
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), a.timeout)
+	defer cancel()
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+	go func() {
+		select {
+		case <-interrupt:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if err := runCmd(ctx, a, args); err != nil {
+		if err == errUsage {
+			fs.Usage()
+			return exitUsage
+		}
+		fmt.Fprintf(a.stderr, "bl %s: %v\n", name, err)
+		return exitError
+	}
+	return exitOK
+}
+
+// interleave moves flags that follow positional arguments to the front, so
+// that "bl servers get 123 -o json" works as well as "bl servers get -o json
+// 123".
+func interleave(fs *flag.FlagSet, args []string) []string {
+	var flags, positional []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			positional = append(positional, args[i+1:]...)
+			break
+		}
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			positional = append(positional, arg)
+			continue
+		}
+
+		flags = append(flags, arg)
+		name := strings.TrimLeft(arg, "-")
+		if strings.Contains(name, "=") {
+			continue
+		}
+		// Non-boolean flags take the next argument as their value.
+		if f := fs.Lookup(name); f != nil && !isBoolFlag(f) && i+1 < len(args) {
+			i++
+			flags = append(flags, args[i])
+		}
+	}
+	return append(flags, positional...)
+}
+
+func isBoolFlag(f *flag.Flag) bool {
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
+}
+
+func findGroup(name string) *group {
+	for i := range groups {
+		if groups[i].name == name {
+			return &groups[i]
+		}
+	}
+	return nil
+}
+
+func (g *group) find(name string) *command {
+	for i := range g.commands {
+		if g.commands[i].name == name {
+			return &g.commands[i]
+		}
+	}
+	return nil
+}
+
+func (a *app) usage(fs *flag.FlagSet) {
+	fmt.Fprintf(a.stderr, "Usage: bl [flags] <resource> <command> [flags] [arguments]\n\nResources:\n")
+	w := tabwriter.NewWriter(a.stderr, 0, 0, 2, ' ', 0)
+	for _, g := range groups {
+		fmt.Fprintf(w, "  %s\t%s\n", g.name, g.summary)
+	}
+	w.Flush()
+	fmt.Fprintf(a.stderr, "\nRun \"bl help <resource>\" for its commands.\n\nFlags:\n")
+	fs.PrintDefaults()
+}
+
+func (a *app) groupUsage(g *group) {
+	fmt.Fprintf(a.stderr, "Usage: bl %s <command> [flags] [arguments]\n\n%s\n\nCommands:\n", g.name, g.summary)
+	w := tabwriter.NewWriter(a.stderr, 0, 0, 2, ' ', 0)
+	for _, c := range g.commands {
+		fmt.Fprintf(w, "  %s %s\t%s\n", c.name, c.args, c.summary)
+	}
+	w.Flush()
+}
+
+// api returns the API client, creating it on first use.
+func (a *app) api() (*binarylane.Client, error) {
+	if a.client != nil {
+		return a.client, nil
+	}
+
+	ts, err := a.tokenSource()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ts.Token(); err != nil {
+		return nil, err
+	}
+
+	var opts []binarylane.ClientOpt
+	if a.apiURL != "" {
+		opts = append(opts, binarylane.SetBaseURL(strings.TrimSuffix(a.apiURL, "/")+"/"))
+	}
+	opts = append(opts, binarylane.SetUserAgent("bl"), binarylane.WithRetryPolicy(binarylane.DefaultRetryPolicy))
+
+	if a.debug {
+		opts = append(opts, binarylane.WithDebugLogger(log.New(a.stderr, "", log.LstdFlags)))
+	}
+
+	c := binarylane.NewFromTokenSource(ts)
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+	a.client = c
+	return c, nil
+}
+
+// tokenSource chooses where the API token comes from. A token or context
+// given on the command line takes precedence over the environment.
+func (a *app) tokenSource() (oauth2.TokenSource, error) {
+	if a.token != "" {
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: a.token}), nil
+	}
+	if a.context == "" && a.config == "" {
+		return binarylane.EnvironmentTokenSource()
+	}
+
+	path := a.config
+	if path == "" {
+		path = os.Getenv(binarylane.ConfigEnvVar)
+	}
+	if path == "" {
+		var err error
+		if path, err = binarylane.DefaultConfigPath(); err != nil {
+			return nil, err
+		}
+	}
+	return binarylane.ConfigTokenSource(path, a.context), nil
+}
+
+func (a *app) waiter() (*util.Waiter, error) {
+	client, err := a.api()
+	if err != nil {
+		return nil, err
+	}
+	w := util.NewWaiter(client)
+	w.Interval = a.pollInterval
+	return w, nil
+}
+
+// action returns a function that prints the action returned by a service
+// method, first waiting for it to complete if --wait was given. It is used as
+//
+//	return a.action(ctx)(client.ServerActions.Reboot(ctx, id))
+func (a *app) action(ctx context.Context) func(*binarylane.Action, *binarylane.Response, error) error {
+	return func(action *binarylane.Action, resp *binarylane.Response, err error) error {
+		if err != nil {
+			return err
+		}
+		if a.wait {
+			w, err := a.waiter()
+			if err != nil {
+				return err
+			}
+			if resp != nil && resp.Monitor != "" {
+				action, err = w.WaitForURI(ctx, resp.Monitor)
+			} else {
+				action, err = w.Wait(ctx, action)
+			}
+			if err != nil {
+				if action != nil {
+					a.printActions(action)
+				}
+				return err
+			}
+		}
+		return a.printActions(action)
+	}
+}
+
+// actions prints actions started on several resources, such as those by
+// tag, first waiting for them all to complete if --wait was given.
+func (a *app) actions(ctx context.Context, actions []binarylane.Action, err error) error {
+	if err != nil {
+		return err
+	}
+	list := make([]*binarylane.Action, len(actions))
+	for i := range actions {
+		list[i] = &actions[i]
+	}
+	if a.wait {
+		w, werr := a.waiter()
+		if werr != nil {
+			return werr
+		}
+		final, werr := w.WaitAll(ctx, actions)
+		for i := range final {
+			if final[i] != nil {
+				list[i] = final[i]
+			}
+		}
+		err = werr
+	}
+	if perr := a.printActions(list...); err == nil {
+		err = perr
+	}
+	return err
+}
+
+// waitResponse waits, if --wait was given, for the actions started by a
+// create or delete request: the monitor URI if the API returned one, and
+// otherwise any actions linked from the response.
+func (a *app) waitResponse(ctx context.Context, resp *binarylane.Response) error {
+	if !a.wait || resp == nil {
+		return nil
+	}
+	w, err := a.waiter()
+	if err != nil {
+		return err
+	}
+	if resp.Monitor != "" {
+		_, err := w.WaitForURI(ctx, resp.Monitor)
+		return err
+	}
+	if resp.Links != nil {
+		for _, la := range resp.Links.Actions {
+			if _, err := w.WaitForLinkAction(ctx, la); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (a *app) printActions(actions ...*binarylane.Action) error {
+	rows := make([][]string, len(actions))
+	for i, action := range actions {
+		rows[i] = []string{
+			strconv.Itoa(action.ID), action.Status, action.Type,
+			action.ResourceType, strconv.Itoa(action.ResourceID),
+			timestamp(action.StartedAt), timestamp(action.CompletedAt),
+		}
+	}
+	return a.render(actions, []string{"ID", "Status", "Type", "Resource Type", "Resource ID", "Started", "Completed"}, rows...)
+}
+
+// listAll fetches every page of a list with fetch.
+func listAll(ctx context.Context, fetch binarylane.PageFunc) error {
+	return binarylane.Paginate(ctx, &binarylane.ListOptions{PerPage: listPageSize}, fetch)
+}
+
+// parseID parses a numeric resource ID.
+func parseID(s string) (int, error) {
+	id, err := strconv.Atoi(s)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid ID %q", s)
+	}
+	return id, nil
+}
+
+// numericID reports whether a resource given on the command line is an ID
+// rather than a slug, fingerprint or other name.
+func numericID(s string) (int, bool) {
+	id, err := strconv.Atoi(s)
+	return id, err == nil
+}
+
+// parseIDs parses several numeric resource IDs.
+func parseIDs(args []string) ([]int, error) {
+	ids := make([]int, len(args))
+	for i, arg := range args {
+		id, err := parseID(arg)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// stringsFlag is a flag that may be given more than once, or as a comma
+// separated list.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*f = append(*f, v)
+		}
+	}
+	return nil
+}
+
+// setFlags returns the names of the flags given on the command line, for
+// commands that only change what was asked for.
+func setFlags(fs *flag.FlagSet) map[string]bool {
+	set := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	return set
+}
+
+// sortedKeys returns the keys of m in order.
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/binarylane/go-binarylane"
+	"github.com/binarylane/go-binarylane/binarylanetest"
+)
+
+// setup starts a fake API and returns it with a client for seeding state.
+func setup(t *testing.T, opts ...binarylanetest.Option) (*binarylanetest.Server, *binarylane.Client) {
+	fake := binarylanetest.NewServer(opts...)
+	t.Cleanup(fake.Close)
+	client, err := fake.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fake, client
+}
+
+// bl runs the command against the fake and returns its exit code, standard
+// output and standard error.
+func bl(fake *binarylanetest.Server, stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	args = append([]string{"--token", "test", "--api-url", fake.URL, "--poll-interval", "1ms"}, args...)
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func createServer(t *testing.T, client *binarylane.Client, name string, tags ...string) *binarylane.Server {
+	server, _, err := client.Servers.Create(context.Background(), &binarylane.ServerCreateRequest{
+		Name:   name,
+		Region: "syd",
+		Size:   "std-min",
+		Image:  binarylane.ServerCreateImage{Slug: "ubuntu-20.04"},
+		Tags:   tags,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server
+}
+
+func TestRun_Usage(t *testing.T) {
+	fake, _ := setup(t)
+
+	tests := []struct {
+		args   []string
+		stderr string
+	}{
+		{nil, "Resources:"},
+		{[]string{"nothing"}, `unknown resource "nothing"`},
+		{[]string{"servers", "nothing"}, `unknown command "nothing"`},
+		{[]string{"servers", "get"}, "Usage: bl servers get"},
+		{[]string{"servers", "get", "1", "2"}, "Usage: bl servers get"},
+		{[]string{"-o", "xml", "servers", "list"}, `unknown output format "xml"`},
+		{[]string{"vpcs", "create", "office"}, "Usage: bl vpcs create"},
+	}
+	for _, tt := range tests {
+		code, _, stderr := bl(fake, "", tt.args...)
+		if code != exitUsage {
+			t.Errorf("bl %v exited with %d, expected %d", tt.args, code, exitUsage)
+		}
+		if !strings.Contains(stderr, tt.stderr) {
+			t.Errorf("bl %v wrote %q, expected it to contain %q", tt.args, stderr, tt.stderr)
+		}
+	}
+}
+
+func TestRun_Help(t *testing.T) {
+	fake, _ := setup(t)
+
+	code, _, stderr := bl(fake, "", "help", "servers")
+	if code != exitOK {
+		t.Errorf("bl help servers exited with %d", code)
+	}
+	for _, want := range []string{"list", "create <name>...", "delete <server-id>..."} {
+		if !strings.Contains(stderr, want) {
+			t.Errorf("bl help servers wrote %q, expected it to contain %q", stderr, want)
+		}
+	}
+}
+
+func TestRun_APIError(t *testing.T) {
+	fake, _ := setup(t)
+
+	code, _, stderr := bl(fake, "", "servers", "get", "999")
+	if code != exitError {
+		t.Errorf("bl servers get exited with %d, expected %d", code, exitError)
+	}
+	if !strings.HasPrefix(stderr, "bl servers get: ") {
+		t.Errorf("bl servers get wrote %q", stderr)
+	}
+}
+
+func TestServers_ListPaginates(t *testing.T) {
+	fake, client := setup(t, binarylanetest.WithPerPage(1))
+	createServer(t, client, "web-1")
+	createServer(t, client, "web-2")
+	createServer(t, client, "db-1")
+
+	code, stdout, stderr := bl(fake, "", "servers", "list", "-o", "json")
+	if code != exitOK {
+		t.Fatalf("bl servers list exited with %d: %s", code, stderr)
+	}
+	var servers []binarylane.Server
+	if err := json.Unmarshal([]byte(stdout), &servers); err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, s := range servers {
+		names = append(names, s.Name)
+	}
+	if expected := []string{"web-1", "web-2", "db-1"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("bl servers list returned %v, expected %v", names, expected)
+	}
+}
+
+func TestServers_ListTable(t *testing.T) {
+	fake, client := setup(t)
+	server := createServer(t, client, "web-1", "web")
+	createServer(t, client, "db-1")
+
+	code, stdout, stderr := bl(fake, "", "servers", "list", "--tag", "web")
+	if code != exitOK {
+		t.Fatalf("bl servers list exited with %d: %s", code, stderr)
+	}
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("bl servers list wrote %q, expected a header and one row", stdout)
+	}
+	if fields := strings.Fields(lines[1]); fields[0] != itoa(server.ID) || fields[1] != "web-1" {
+		t.Errorf("bl servers list wrote row %q", lines[1])
+	}
+}
+
+func TestServers_GetYAML(t *testing.T) {
+	fake, client := setup(t)
+	server := createServer(t, client, "web-1")
+
+	code, stdout, stderr := bl(fake, "", "-o", "yaml", "servers", "get", itoa(server.ID))
+	if code != exitOK {
+		t.Fatalf("bl servers get exited with %d: %s", code, stderr)
+	}
+	if !strings.Contains(stdout, "name: web-1\n") {
+		t.Errorf("bl servers get wrote %q, expected a YAML name field", stdout)
+	}
+	if strings.Contains(stdout, "{") {
+		t.Errorf("bl servers get wrote %q, expected block style YAML", stdout)
+	}
+}
+
+func TestServers_CreateWait(t *testing.T) {
+	fake, _ := setup(t, binarylanetest.WithActionPolls(2))
+
+	code, stdout, stderr := bl(fake, "", "servers", "create", "web-1",
+		"--region", "syd", "--size", "std-min", "--image", "ubuntu-20.04", "--wait", "-o", "json")
+	if code != exitOK {
+		t.Fatalf("bl servers create exited with %d: %s", code, stderr)
+	}
+	var servers []binarylane.Server
+	if err := json.Unmarshal([]byte(stdout), &servers); err != nil {
+		t.Fatal(err)
+	}
+	if len(servers) != 1 || servers[0].Status != "active" {
+		t.Errorf("bl servers create --wait returned %+v, expected one active server", servers)
+	}
+}
+
+func TestServerActions_Wait(t *testing.T) {
+	fake, client := setup(t, binarylanetest.WithActionPolls(2))
+	server := createServer(t, client, "web-1")
+
+	code, stdout, stderr := bl(fake, "", "server-actions", "power-off", itoa(server.ID), "--wait", "-o", "json")
+	if code != exitOK {
+		t.Fatalf("bl server-actions power-off exited with %d: %s", code, stderr)
+	}
+	var actions []binarylane.Action
+	if err := json.Unmarshal([]byte(stdout), &actions); err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 1 || actions[0].Status != binarylane.ActionCompleted {
+		t.Errorf("bl server-actions power-off --wait returned %+v", actions)
+	}
+}
+
+func TestDomains_ImportDryRun(t *testing.T) {
+	fake, client := setup(t)
+	if _, _, err := client.Domains.Create(context.Background(), &binarylane.DomainCreateRequest{Name: "example.com"}); err != nil {
+		t.Fatal(err)
+	}
+
+	zone := "www 300 IN A 192.0.2.1\n"
+	code, stdout, stderr := bl(fake, zone, "domains", "import", "example.com", "-", "--dry-run")
+	if code != exitOK {
+		t.Fatalf("bl domains import exited with %d: %s", code, stderr)
+	}
+	if !strings.Contains(stdout, "192.0.2.1") {
+		t.Errorf("bl domains import --dry-run wrote %q, expected the planned record", stdout)
+	}
+
+	records, _, err := client.Domains.Records(context.Background(), "example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range records {
+		if r.Data == "192.0.2.1" {
+			t.Errorf("bl domains import --dry-run created record %+v", r)
+		}
+	}
+}
+
+func TestVPCs_CreateFromFlags(t *testing.T) {
+	fake, _ := setup(t)
+
+	code, stdout, stderr := bl(fake, "", "vpcs", "create", "office", "--region", "syd", "--ip-range", "10.240.0.0/16", "-o", "json")
+	if code != exitOK {
+		t.Fatalf("bl vpcs create exited with %d: %s", code, stderr)
+	}
+	var vpcs []binarylane.VPC
+	if err := json.Unmarshal([]byte(stdout), &vpcs); err != nil {
+		t.Fatal(err)
+	}
+	if len(vpcs) != 1 || vpcs[0].Name != "office" || vpcs[0].IPRange != "10.240.0.0/16" {
+		t.Errorf("bl vpcs create returned %+v", vpcs)
+	}
+}
+
+func TestReadSpec(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	a := &app{stdin: strings.NewReader(`{"name": "web", "tags": ["a"]}`)}
+
+	path := filepath.Join(dir, "spec.yaml")
+	if err := ioutil.WriteFile(path, []byte("name: web\ntags:\n  - a\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	expected := binarylane.FirewallRequest{Name: "web", Tags: []string{"a"}}
+	for _, file := range []string{path, "-"} {
+		var req binarylane.FirewallRequest
+		if err := a.readSpec(file, &req); err != nil {
+			t.Fatalf("readSpec(%q) returned %v", file, err)
+		}
+		if !reflect.DeepEqual(req, expected) {
+			t.Errorf("readSpec(%q) returned %+v, expected %+v", file, req, expected)
+		}
+	}
+
+	if err := ioutil.WriteFile(path, []byte("nmae: web\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	var req binarylane.FirewallRequest
+	if err := a.readSpec(path, &req); err == nil {
+		t.Errorf("readSpec accepted an unknown field")
+	}
+}
+
+func TestParseResources(t *testing.T) {
+	resources, err := parseResources([]string{"server:12", "volume-snapshot:abc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []binarylane.Resource{
+		{ID: "12", Type: binarylane.ServerResourceType},
+		{ID: "abc", Type: binarylane.VolumeSnapshotResourceType},
+	}
+	if !reflect.DeepEqual(resources, expected) {
+		t.Errorf("parseResources returned %+v, expected %+v", resources, expected)
+	}
+
+	if _, err := parseResources([]string{"12"}); err == nil {
+		t.Errorf("parseResources accepted a resource without a type")
+	}
+}
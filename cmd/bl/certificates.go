@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strings"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var certificatesGroup = group{
+	name:    "certificates",
+	summary: "List and delete load balancer certificates",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List certificates",
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					certs, err := client.Certificates.ListAll(ctx)
+					if err != nil {
+						return err
+					}
+					return a.printCertificates(certs)
+				}
+			},
+		},
+		{
+			name:    "get",
+			args:    "<certificate-id>",
+			summary: "Show a certificate",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					cert, _, err := client.Certificates.Get(ctx, args[0])
+					if err != nil {
+						return err
+					}
+					return a.printCertificates([]binarylane.Certificate{*cert})
+				}
+			},
+		},
+		{
+			name:    "delete",
+			args:    "<certificate-id>",
+			summary: "Delete a certificate",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					_, err = client.Certificates.Delete(ctx, args[0])
+					return err
+				}
+			},
+		},
+	},
+}
+
+func (a *app) printCertificates(certs []binarylane.Certificate) error {
+	rows := make([][]string, len(certs))
+	for i, c := range certs {
+		rows[i] = []string{c.ID, c.Name, strings.Join(c.DNSNames, ","), c.NotAfter, c.State, c.Type}
+	}
+	return a.render(certs, []string{"ID", "Name", "DNS Names", "Expires", "State", "Type"}, rows...)
+}
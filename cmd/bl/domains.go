@@ -0,0 +1,372 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var domainsGroup = group{
+	name:    "domains",
+	summary: "Manage DNS domains and records",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List domains",
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					domains, err := client.Domains.ListAll(ctx)
+					if err != nil {
+						return err
+					}
+					return a.printDomains(domains)
+				}
+			},
+		},
+		{
+			name:    "get",
+			args:    "<domain>",
+			summary: "Show a domain",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					domain, _, err := client.Domains.Get(ctx, args[0])
+					if err != nil {
+						return err
+					}
+					return a.printDomains([]binarylane.Domain{*domain})
+				}
+			},
+		},
+		{
+			name:    "create",
+			args:    "<domain>",
+			summary: "Create a domain",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				ip := fs.String("ip", "", "`address` for an A record at the apex of the domain")
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					domain, _, err := client.Domains.Create(ctx, &binarylane.DomainCreateRequest{Name: args[0], IPAddress: *ip})
+					if err != nil {
+						return err
+					}
+					return a.printDomains([]binarylane.Domain{*domain})
+				}
+			},
+		},
+		{
+			name:    "delete",
+			args:    "<domain>",
+			summary: "Delete a domain and all its records",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					_, err = client.Domains.Delete(ctx, args[0])
+					return err
+				}
+			},
+		},
+		{
+			name:    "records",
+			args:    "<domain>",
+			summary: "List a domain's records",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				recordType := fs.String("type", "", "only list records of this `type`")
+				name := fs.String("name", "", "only list records with this fully qualified `name`")
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					var records []binarylane.DomainRecord
+					err = listAll(ctx, func(ctx context.Context, opt *binarylane.ListOptions) (*binarylane.Response, error) {
+						var (
+							page []binarylane.DomainRecord
+							resp *binarylane.Response
+							err  error
+						)
+						switch {
+						case *recordType != "" && *name != "":
+							page, resp, err = client.Domains.RecordsByTypeAndName(ctx, args[0], *recordType, *name, opt)
+						case *recordType != "":
+							page, resp, err = client.Domains.RecordsByType(ctx, args[0], *recordType, opt)
+						case *name != "":
+							page, resp, err = client.Domains.RecordsByName(ctx, args[0], *name, opt)
+						default:
+							page, resp, err = client.Domains.Records(ctx, args[0], opt)
+						}
+						records = append(records, page...)
+						return resp, err
+					})
+					if err != nil {
+						return err
+					}
+					return a.printRecords(records)
+				}
+			},
+		},
+		{
+			name:    "record-create",
+			args:    "<domain>",
+			summary: "Create a record",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				req := recordFlags(fs)
+				return func(ctx context.Context, a *app, args []string) error {
+					if req.Type == "" {
+						return errUsage
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					record, _, err := client.Domains.CreateRecord(ctx, args[0], req)
+					if err != nil {
+						return err
+					}
+					return a.printRecords([]binarylane.DomainRecord{*record})
+				}
+			},
+		},
+		{
+			name:    "record-edit",
+			args:    "<domain> <record-id>",
+			summary: "Change the record flags given; others are left as they are",
+			nargs:   2,
+			setup: func(fs *flag.FlagSet) runFunc {
+				req := recordFlags(fs)
+				return func(ctx context.Context, a *app, args []string) error {
+					id, err := parseID(args[1])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+
+					// The API replaces the whole record, so start from its
+					// current state.
+					current, _, err := client.Domains.Record(ctx, args[0], id)
+					if err != nil {
+						return err
+					}
+					set := setFlags(fs)
+					edit := &binarylane.DomainRecordEditRequest{
+						Type: current.Type, Name: current.Name, Data: current.Data,
+						Priority: current.Priority, Port: current.Port, TTL: current.TTL,
+						Weight: current.Weight, Flags: current.Flags, Tag: current.Tag,
+					}
+					applyRecordFlags(edit, req, set)
+
+					record, _, err := client.Domains.EditRecord(ctx, args[0], id, edit)
+					if err != nil {
+						return err
+					}
+					return a.printRecords([]binarylane.DomainRecord{*record})
+				}
+			},
+		},
+		{
+			name:    "record-delete",
+			args:    "<domain> <record-id>...",
+			summary: "Delete records",
+			nargs:   variadic,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					if len(args) < 2 {
+						return errUsage
+					}
+					ids, err := parseIDs(args[1:])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					for _, id := range ids {
+						if _, err := client.Domains.DeleteRecord(ctx, args[0], id); err != nil {
+							return err
+						}
+					}
+					return nil
+				}
+			},
+		},
+		{
+			name:    "export",
+			args:    "<domain>",
+			summary: "Write a domain's records as a zone file",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				out := fs.String("file", "", "`path` to write the zone file to instead of standard output")
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					var records []binarylane.DomainRecord
+					err = listAll(ctx, func(ctx context.Context, opt *binarylane.ListOptions) (*binarylane.Response, error) {
+						page, resp, err := client.Domains.Records(ctx, args[0], opt)
+						records = append(records, page...)
+						return resp, err
+					})
+					if err != nil {
+						return err
+					}
+					return a.writeFile(*out, []byte(binarylane.FormatZoneFile(args[0], records)))
+				}
+			},
+		},
+		{
+			name:    "import",
+			args:    "<domain> <zone-file>",
+			summary: "Make a domain's records match a zone file, creating, editing and deleting records",
+			nargs:   2,
+			setup: func(fs *flag.FlagSet) runFunc {
+				dryRun := fs.Bool("dry-run", false, "show the changes without making them")
+				return func(ctx context.Context, a *app, args []string) error {
+					f := a.stdin
+					if args[1] != "-" {
+						file, err := os.Open(args[1])
+						if err != nil {
+							return err
+						}
+						defer file.Close()
+						f = file
+					}
+					desired, err := binarylane.ParseZoneFile(f, args[0])
+					if err != nil {
+						return err
+					}
+
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					var plan *binarylane.DomainRecordsPlan
+					if *dryRun {
+						plan, err = client.Domains.PlanRecords(ctx, args[0], desired)
+					} else {
+						plan, err = client.Domains.SyncRecords(ctx, args[0], desired)
+					}
+					if plan != nil {
+						if perr := a.printRecordsPlan(plan); err == nil {
+							err = perr
+						}
+					}
+					return err
+				}
+			},
+		},
+	},
+}
+
+// recordFlags defines the flags describing a domain record.
+func recordFlags(fs *flag.FlagSet) *binarylane.DomainRecordEditRequest {
+	req := new(binarylane.DomainRecordEditRequest)
+	fs.StringVar(&req.Type, "type", "", "record `type`, such as A, CNAME or MX")
+	fs.StringVar(&req.Name, "name", "", "record `name`, relative to the domain; @ for the domain itself")
+	fs.StringVar(&req.Data, "data", "", "record `data`, such as an address or host name")
+	fs.IntVar(&req.Priority, "priority", 0, "`priority` of MX and SRV records")
+	fs.IntVar(&req.Port, "port", 0, "`port` of SRV records")
+	fs.IntVar(&req.TTL, "ttl", 0, "time to live in `seconds`")
+	fs.IntVar(&req.Weight, "weight", 0, "`weight` of SRV records")
+	fs.IntVar(&req.Flags, "flags", 0, "`flags` of CAA records")
+	fs.StringVar(&req.Tag, "tag", "", "`tag` of CAA records")
+	return req
+}
+
+// applyRecordFlags copies the fields of from whose flags were given to to.
+func applyRecordFlags(to, from *binarylane.DomainRecordEditRequest, set map[string]bool) {
+	for _, name := range sortedKeys(set) {
+		switch name {
+		case "type":
+			to.Type = from.Type
+		case "name":
+			to.Name = from.Name
+		case "data":
+			to.Data = from.Data
+		case "priority":
+			to.Priority = from.Priority
+		case "port":
+			to.Port = from.Port
+		case "ttl":
+			to.TTL = from.TTL
+		case "weight":
+			to.Weight = from.Weight
+		case "flags":
+			to.Flags = from.Flags
+		case "tag":
+			to.Tag = from.Tag
+		}
+	}
+}
+
+func (a *app) printDomains(domains []binarylane.Domain) error {
+	rows := make([][]string, len(domains))
+	for i, d := range domains {
+		rows[i] = []string{d.Name, itoa(d.TTL)}
+	}
+	return a.render(domains, []string{"Name", "TTL"}, rows...)
+}
+
+func (a *app) printRecords(records []binarylane.DomainRecord) error {
+	rows := make([][]string, len(records))
+	for i, r := range records {
+		rows[i] = []string{strconv.Itoa(r.ID), r.Type, r.Name, r.Data, itoa(r.Priority), itoa(r.Port), itoa(r.TTL), itoa(r.Weight)}
+	}
+	return a.render(records, []string{"ID", "Type", "Name", "Data", "Priority", "Port", "TTL", "Weight"}, rows...)
+}
+
+func (a *app) printRecordsPlan(plan *binarylane.DomainRecordsPlan) error {
+	var rows [][]string
+	for _, r := range plan.Create {
+		rows = append(rows, []string{"create", "", r.Type, r.Name, r.Data, itoa(r.TTL)})
+	}
+	for _, e := range plan.Edit {
+		rows = append(rows, []string{"edit", strconv.Itoa(e.Record.ID), e.Request.Type, e.Request.Name,
+			e.Request.Data, itoa(e.Request.TTL)})
+	}
+	for _, r := range plan.Delete {
+		rows = append(rows, []string{"delete", strconv.Itoa(r.ID), r.Type, r.Name, r.Data, itoa(r.TTL)})
+	}
+	if len(rows) == 0 && a.output == "table" {
+		_, err := a.stdout.Write([]byte("No changes\n"))
+		return err
+	}
+	return a.render(plan, []string{"Change", "ID", "Type", "Name", "Data", "TTL"}, rows...)
+}
+
+// joinNonEmpty joins the non-empty strings in s.
+func joinNonEmpty(s []string, sep string) string {
+	var out []string
+	for _, v := range s {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return strings.Join(out, sep)
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"strings"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var firewallsGroup = group{
+	name:    "firewalls",
+	summary: "Manage cloud firewalls",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List firewalls",
+			setup: func(fs *flag.FlagSet) runFunc {
+				server := fs.Int("server", 0, "only list firewalls applied to the server with this `ID`")
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					var firewalls []binarylane.Firewall
+					if *server == 0 {
+						firewalls, err = client.Firewalls.ListAll(ctx)
+					} else {
+						err = listAll(ctx, func(ctx context.Context, opt *binarylane.ListOptions) (*binarylane.Response, error) {
+							page, resp, err := client.Firewalls.ListByServer(ctx, *server, opt)
+							firewalls = append(firewalls, page...)
+							return resp, err
+						})
+					}
+					if err != nil {
+						return err
+					}
+					return a.printFirewalls(firewalls)
+				}
+			},
+		},
+		{
+			name:    "get",
+			args:    "<firewall-id>",
+			summary: "Show a firewall",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					firewall, _, err := client.Firewalls.Get(ctx, args[0])
+					if err != nil {
+						return err
+					}
+					return a.printFirewalls([]binarylane.Firewall{*firewall})
+				}
+			},
+		},
+		{
+			name:    "create",
+			summary: "Create a firewall from a JSON or YAML file",
+			setup: func(fs *flag.FlagSet) runFunc {
+				file := fs.String("file", "", "`path` of the firewall request, or - for standard input (required)")
+				return func(ctx context.Context, a *app, args []string) error {
+					if *file == "" {
+						return errUsage
+					}
+					req := new(binarylane.FirewallRequest)
+					if err := a.readSpec(*file, req); err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					firewall, _, err := client.Firewalls.Create(ctx, req)
+					if err != nil {
+						return err
+					}
+					return a.printFirewalls([]binarylane.Firewall{*firewall})
+				}
+			},
+		},
+		{
+			name:    "apply",
+			args:    "<firewall-id>",
+			summary: "Change a firewall to match a JSON or YAML file, adding and removing only what differs",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				file := fs.String("file", "", "`path` of the firewall request, or - for standard input (required)")
+				dryRun := fs.Bool("dry-run", false, "show the changes without making them")
+				return func(ctx context.Context, a *app, args []string) error {
+					if *file == "" {
+						return errUsage
+					}
+					req := new(binarylane.FirewallRequest)
+					if err := a.readSpec(*file, req); err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					var plan *binarylane.FirewallPlan
+					if *dryRun {
+						plan, _, err = client.Firewalls.Plan(ctx, args[0], req)
+					} else {
+						plan, _, err = client.Firewalls.Reconcile(ctx, args[0], req)
+					}
+					if plan != nil {
+						if perr := a.printFirewallPlan(plan); err == nil {
+							err = perr
+						}
+					}
+					return err
+				}
+			},
+		},
+		{
+			name:    "delete",
+			args:    "<firewall-id>",
+			summary: "Delete a firewall",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					_, err = client.Firewalls.Delete(ctx, args[0])
+					return err
+				}
+			},
+		},
+		firewallServersCommand("add-servers", "Apply a firewall to servers", binarylane.FirewallsService.AddServers),
+		firewallServersCommand("remove-servers", "Remove servers from a firewall", binarylane.FirewallsService.RemoveServers),
+		firewallTagsCommand("add-tags", "Apply a firewall to every server with tags", binarylane.FirewallsService.AddTags),
+		firewallTagsCommand("remove-tags", "Remove tags from a firewall", binarylane.FirewallsService.RemoveTags),
+	},
+}
+
+func firewallServersCommand(name, summary string, change func(binarylane.FirewallsService, context.Context, string, ...int) (*binarylane.Response, error)) command {
+	return command{
+		name:    name,
+		args:    "<firewall-id> <server-id>...",
+		summary: summary,
+		nargs:   variadic,
+		setup: func(fs *flag.FlagSet) runFunc {
+			return func(ctx context.Context, a *app, args []string) error {
+				if len(args) < 2 {
+					return errUsage
+				}
+				ids, err := parseIDs(args[1:])
+				if err != nil {
+					return err
+				}
+				client, err := a.api()
+				if err != nil {
+					return err
+				}
+				_, err = change(client.Firewalls, ctx, args[0], ids...)
+				return err
+			}
+		},
+	}
+}
+
+func firewallTagsCommand(name, summary string, change func(binarylane.FirewallsService, context.Context, string, ...string) (*binarylane.Response, error)) command {
+	return command{
+		name:    name,
+		args:    "<firewall-id> <tag>...",
+		summary: summary,
+		nargs:   variadic,
+		setup: func(fs *flag.FlagSet) runFunc {
+			return func(ctx context.Context, a *app, args []string) error {
+				if len(args) < 2 {
+					return errUsage
+				}
+				client, err := a.api()
+				if err != nil {
+					return err
+				}
+				_, err = change(client.Firewalls, ctx, args[0], args[1:]...)
+				return err
+			}
+		},
+	}
+}
+
+func (a *app) printFirewalls(firewalls []binarylane.Firewall) error {
+	rows := make([][]string, len(firewalls))
+	for i, f := range firewalls {
+		rows[i] = []string{
+			f.ID, f.Name, f.Status, strconv.Itoa(len(f.InboundRules)), strconv.Itoa(len(f.OutboundRules)),
+			ints(f.ServerIDs), strings.Join(f.Tags, ","),
+		}
+	}
+	return a.render(firewalls, []string{"ID", "Name", "Status", "Inbound Rules", "Outbound Rules", "Servers", "Tags"}, rows...)
+}
+
+func (a *app) printFirewallPlan(plan *binarylane.FirewallPlan) error {
+	var rows [][]string
+	add := func(change, what, detail string) {
+		rows = append(rows, []string{change, what, detail})
+	}
+	if plan.Name != "" {
+		add("rename", "name", plan.Name)
+	}
+	for _, r := range plan.AddInboundRules {
+		add("add", "inbound rule", ruleString(r.Protocol, r.PortRange, "from", (*binarylane.Destinations)(r.Sources)))
+	}
+	for _, r := range plan.RemoveInboundRules {
+		add("remove", "inbound rule", ruleString(r.Protocol, r.PortRange, "from", (*binarylane.Destinations)(r.Sources)))
+	}
+	for _, r := range plan.AddOutboundRules {
+		add("add", "outbound rule", ruleString(r.Protocol, r.PortRange, "to", r.Destinations))
+	}
+	for _, r := range plan.RemoveOutboundRules {
+		add("remove", "outbound rule", ruleString(r.Protocol, r.PortRange, "to", r.Destinations))
+	}
+	for _, id := range plan.AddServerIDs {
+		add("add", "server", strconv.Itoa(id))
+	}
+	for _, id := range plan.RemoveServerIDs {
+		add("remove", "server", strconv.Itoa(id))
+	}
+	for _, tag := range plan.AddTags {
+		add("add", "tag", tag)
+	}
+	for _, tag := range plan.RemoveTags {
+		add("remove", "tag", tag)
+	}
+
+	if len(rows) == 0 && a.output == "table" {
+		_, err := a.stdout.Write([]byte("No changes\n"))
+		return err
+	}
+	return a.render(plan, []string{"Change", "Item", "Detail"}, rows...)
+}
+
+// ruleString describes a firewall rule on one line, such as
+// "tcp 22 from 10.0.0.0/8".
+func ruleString(protocol, ports, direction string, targets *binarylane.Destinations) string {
+	if ports == "" {
+		ports = "all"
+	}
+	var parts []string
+	if targets != nil {
+		parts = append(parts, targets.Addresses...)
+		for _, tag := range targets.Tags {
+			parts = append(parts, "tag:"+tag)
+		}
+		for _, id := range targets.ServerIDs {
+			parts = append(parts, "server:"+strconv.Itoa(id))
+		}
+		for _, uid := range targets.LoadBalancerUIDs {
+			parts = append(parts, "load-balancer:"+uid)
+		}
+	}
+	return joinNonEmpty([]string{protocol, ports, direction, strings.Join(parts, ",")}, " ")
+}
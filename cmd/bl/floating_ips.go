@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var floatingIPsGroup = group{
+	name:    "floating-ips",
+	summary: "Manage floating IPs",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List floating IPs",
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					ips, err := client.FloatingIPs.ListAll(ctx)
+					if err != nil {
+						return err
+					}
+					return a.printFloatingIPs(ips)
+				}
+			},
+		},
+		{
+			name:    "get",
+			args:    "<ip>",
+			summary: "Show a floating IP",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					ip, _, err := client.FloatingIPs.Get(ctx, args[0])
+					if err != nil {
+						return err
+					}
+					return a.printFloatingIPs([]binarylane.FloatingIP{*ip})
+				}
+			},
+		},
+		{
+			name:    "create",
+			summary: "Reserve a floating IP in a region, or assigned to a server",
+			setup: func(fs *flag.FlagSet) runFunc {
+				req := new(binarylane.FloatingIPCreateRequest)
+				fs.StringVar(&req.Region, "region", "", "`slug` of the region to reserve the address in")
+				fs.IntVar(&req.ServerID, "server", 0, "`ID` of the server to assign the address to")
+				return func(ctx context.Context, a *app, args []string) error {
+					if (req.Region == "") == (req.ServerID == 0) {
+						return errUsage
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					ip, resp, err := client.FloatingIPs.Create(ctx, req)
+					if err != nil {
+						return err
+					}
+					if err := a.waitResponse(ctx, resp); err != nil {
+						return err
+					}
+					return a.printFloatingIPs([]binarylane.FloatingIP{*ip})
+				}
+			},
+		},
+		{
+			name:    "delete",
+			args:    "<ip>",
+			summary: "Release a floating IP",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					_, err = client.FloatingIPs.Delete(ctx, args[0])
+					return err
+				}
+			},
+		},
+		{
+			name:    "assign",
+			args:    "<ip> <server-id>",
+			summary: "Assign a floating IP to a server",
+			nargs:   2,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					id, err := parseID(args[1])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					return a.action(ctx)(client.FloatingIPActions.Assign(ctx, args[0], id))
+				}
+			},
+		},
+		{
+			name:    "unassign",
+			args:    "<ip>",
+			summary: "Unassign a floating IP from its server",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					return a.action(ctx)(client.FloatingIPActions.Unassign(ctx, args[0]))
+				}
+			},
+		},
+		{
+			name:    "actions",
+			args:    "<ip>",
+			summary: "List the actions run on a floating IP",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					var actions []*binarylane.Action
+					err = listAll(ctx, func(ctx context.Context, opt *binarylane.ListOptions) (*binarylane.Response, error) {
+						page, resp, err := client.FloatingIPActions.List(ctx, args[0], opt)
+						for i := range page {
+							actions = append(actions, &page[i])
+						}
+						return resp, err
+					})
+					if err != nil {
+						return err
+					}
+					return a.printActions(actions...)
+				}
+			},
+		},
+	},
+}
+
+func (a *app) printFloatingIPs(ips []binarylane.FloatingIP) error {
+	rows := make([][]string, len(ips))
+	for i, ip := range ips {
+		var serverID, serverName string
+		if ip.Server != nil {
+			serverID, serverName = strconv.Itoa(ip.Server.ID), ip.Server.Name
+		}
+		rows[i] = []string{ip.IP, regionSlug(ip.Region), serverID, serverName}
+	}
+	return a.render(ips, []string{"IP", "Region", "Server ID", "Server Name"}, rows...)
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var imagesGroup = group{
+	name:    "images",
+	summary: "List and manage images",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List images",
+			setup: func(fs *flag.FlagSet) runFunc {
+				kind := fs.String("type", "", "only list `type` distribution, application or user images")
+				tag := fs.String("tag", "", "only list images with this `tag`")
+				return func(ctx context.Context, a *app, args []string) error {
+					if *kind != "" && *tag != "" {
+						return errUsage
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+
+					var list func(context.Context, *binarylane.ListOptions) ([]binarylane.Image, *binarylane.Response, error)
+					switch *kind {
+					case "":
+						list = client.Images.List
+					case "distribution":
+						list = client.Images.ListDistribution
+					case "application":
+						list = client.Images.ListApplication
+					case "user":
+						list = client.Images.ListUser
+					default:
+						return fmt.Errorf("unknown image type %q", *kind)
+					}
+					if *tag != "" {
+						list = func(ctx context.Context, opt *binarylane.ListOptions) ([]binarylane.Image, *binarylane.Response, error) {
+							return client.Images.ListByTag(ctx, *tag, opt)
+						}
+					}
+
+					var images []binarylane.Image
+					err = listAll(ctx, func(ctx context.Context, opt *binarylane.ListOptions) (*binarylane.Response, error) {
+						page, resp, err := list(ctx, opt)
+						images = append(images, page...)
+						return resp, err
+					})
+					if err != nil {
+						return err
+					}
+					return a.printImages(images)
+				}
+			},
+		},
+		{
+			name:    "get",
+			args:    "<image-id|slug>",
+			summary: "Show an image",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					var image *binarylane.Image
+					if id, ok := numericID(args[0]); ok {
+						image, _, err = client.Images.GetByID(ctx, id)
+					} else {
+						image, _, err = client.Images.GetBySlug(ctx, args[0])
+					}
+					if err != nil {
+						return err
+					}
+					return a.printImages([]binarylane.Image{*image})
+				}
+			},
+		},
+		{
+			name:    "update",
+			args:    "<image-id>",
+			summary: "Rename an image",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				name := fs.String("name", "", "new `name` (required)")
+				return func(ctx context.Context, a *app, args []string) error {
+					if *name == "" {
+						return errUsage
+					}
+					id, err := parseID(args[0])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					image, _, err := client.Images.Update(ctx, id, &binarylane.ImageUpdateRequest{Name: *name})
+					if err != nil {
+						return err
+					}
+					return a.printImages([]binarylane.Image{*image})
+				}
+			},
+		},
+		{
+			name:    "delete",
+			args:    "<image-id>",
+			summary: "Delete an image",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					id, err := parseID(args[0])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					_, err = client.Images.Delete(ctx, id)
+					return err
+				}
+			},
+		},
+		{
+			name:    "transfer",
+			args:    "<image-id>",
+			summary: "Copy an image to another region",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				region := fs.String("region", "", "`slug` of the region to copy to (required)")
+				return func(ctx context.Context, a *app, args []string) error {
+					if *region == "" {
+						return errUsage
+					}
+					id, err := parseID(args[0])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					req := &binarylane.ActionRequest{"type": "transfer", "region": *region}
+					return a.action(ctx)(client.ImageActions.Transfer(ctx, id, req))
+				}
+			},
+		},
+		{
+			name:    "convert",
+			args:    "<image-id>",
+			summary: "Convert a backup to a snapshot",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					id, err := parseID(args[0])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					return a.action(ctx)(client.ImageActions.Convert(ctx, id))
+				}
+			},
+		},
+	},
+}
+
+func (a *app) printImages(images []binarylane.Image) error {
+	rows := make([][]string, len(images))
+	for i, img := range images {
+		rows[i] = []string{
+			strconv.Itoa(img.ID), img.Name, img.Type, img.Distribution, img.Slug,
+			strings.Join(img.Regions, ","), img.Status,
+		}
+	}
+	return a.render(images, []string{"ID", "Name", "Type", "Distribution", "Slug", "Regions", "Status"}, rows...)
+}
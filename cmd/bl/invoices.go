@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var invoicesGroup = group{
+	name:    "invoices",
+	summary: "List and download invoices",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List invoices",
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					var invoices []binarylane.InvoiceListItem
+					err = listAll(ctx, func(ctx context.Context, opt *binarylane.ListOptions) (*binarylane.Response, error) {
+						page, resp, err := client.Invoices.List(ctx, opt)
+						if page != nil {
+							invoices = append(invoices, page.Invoices...)
+						}
+						return resp, err
+					})
+					if err != nil {
+						return err
+					}
+					rows := make([][]string, len(invoices))
+					for i, inv := range invoices {
+						rows[i] = []string{inv.InvoiceUUID, inv.InvoicePeriod, inv.Amount, inv.UpdatedAt.Format("2006-01-02")}
+					}
+					return a.render(invoices, []string{"UUID", "Period", "Amount", "Updated"}, rows...)
+				}
+			},
+		},
+		{
+			name:    "get",
+			args:    "<invoice-uuid>",
+			summary: "List the items on an invoice",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					var items []binarylane.InvoiceItem
+					err = listAll(ctx, func(ctx context.Context, opt *binarylane.ListOptions) (*binarylane.Response, error) {
+						page, resp, err := client.Invoices.Get(ctx, args[0], opt)
+						if page != nil {
+							items = append(items, page.InvoiceItems...)
+						}
+						return resp, err
+					})
+					if err != nil {
+						return err
+					}
+					rows := make([][]string, len(items))
+					for i, item := range items {
+						rows[i] = []string{item.Product, item.ResourceID, item.Description, item.Duration, item.Amount}
+					}
+					return a.render(items, []string{"Product", "Resource ID", "Description", "Duration", "Amount"}, rows...)
+				}
+			},
+		},
+		{
+			name:    "summary",
+			args:    "<invoice-uuid>",
+			summary: "Show the totals of an invoice",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					s, _, err := client.Invoices.GetSummary(ctx, args[0])
+					if err != nil {
+						return err
+					}
+					return a.render(s,
+						[]string{"UUID", "Period", "Amount", "Product Charges", "Overages", "Taxes", "Credits"},
+						[]string{s.InvoiceUUID, s.BillingPeriod, s.Amount, s.ProductCharges.Amount, s.Overages.Amount,
+							s.Taxes.Amount, s.CreditsAndAdjustments.Amount})
+				}
+			},
+		},
+		invoiceDownloadCommand("pdf", "Download an invoice as a PDF", binarylane.InvoicesService.GetPDF),
+		invoiceDownloadCommand("csv", "Download an invoice as CSV", binarylane.InvoicesService.GetCSV),
+	},
+}
+
+func invoiceDownloadCommand(name, summary string, get func(binarylane.InvoicesService, context.Context, string) ([]byte, *binarylane.Response, error)) command {
+	return command{
+		name:    name,
+		args:    "<invoice-uuid>",
+		summary: summary,
+		nargs:   1,
+		setup: func(fs *flag.FlagSet) runFunc {
+			out := fs.String("file", "", "`path` to save the invoice to instead of standard output")
+			return func(ctx context.Context, a *app, args []string) error {
+				client, err := a.api()
+				if err != nil {
+					return err
+				}
+				data, _, err := get(client.Invoices, ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return a.writeFile(*out, data)
+			}
+		},
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"strings"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var keysGroup = group{
+	name:    "keys",
+	summary: "Manage SSH keys",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List SSH keys",
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					keys, err := client.Keys.ListAll(ctx)
+					if err != nil {
+						return err
+					}
+					return a.printKeys(keys)
+				}
+			},
+		},
+		{
+			name:    "get",
+			args:    "<key-id|fingerprint>",
+			summary: "Show an SSH key",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					var key *binarylane.Key
+					if id, ok := numericID(args[0]); ok {
+						key, _, err = client.Keys.GetByID(ctx, id)
+					} else {
+						key, _, err = client.Keys.GetByFingerprint(ctx, args[0])
+					}
+					if err != nil {
+						return err
+					}
+					return a.printKeys([]binarylane.Key{*key})
+				}
+			},
+		},
+		{
+			name:    "create",
+			args:    "<name>",
+			summary: "Add an SSH public key",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				path := fs.String("public-key-file", "", "`path` of the public key, such as ~/.ssh/id_ed25519.pub (required)")
+				return func(ctx context.Context, a *app, args []string) error {
+					if *path == "" {
+						return errUsage
+					}
+					publicKey, err := a.readFile(*path)
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					key, _, err := client.Keys.Create(ctx, &binarylane.KeyCreateRequest{
+						Name:      args[0],
+						PublicKey: strings.TrimSpace(publicKey),
+					})
+					if err != nil {
+						return err
+					}
+					return a.printKeys([]binarylane.Key{*key})
+				}
+			},
+		},
+		{
+			name:    "update",
+			args:    "<key-id|fingerprint>",
+			summary: "Rename an SSH key",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				name := fs.String("name", "", "new `name` (required)")
+				return func(ctx context.Context, a *app, args []string) error {
+					if *name == "" {
+						return errUsage
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					req := &binarylane.KeyUpdateRequest{Name: *name}
+					var key *binarylane.Key
+					if id, ok := numericID(args[0]); ok {
+						key, _, err = client.Keys.UpdateByID(ctx, id, req)
+					} else {
+						key, _, err = client.Keys.UpdateByFingerprint(ctx, args[0], req)
+					}
+					if err != nil {
+						return err
+					}
+					return a.printKeys([]binarylane.Key{*key})
+				}
+			},
+		},
+		{
+			name:    "delete",
+			args:    "<key-id|fingerprint>",
+			summary: "Delete an SSH key",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					if id, ok := numericID(args[0]); ok {
+						_, err = client.Keys.DeleteByID(ctx, id)
+					} else {
+						_, err = client.Keys.DeleteByFingerprint(ctx, args[0])
+					}
+					return err
+				}
+			},
+		},
+	},
+}
+
+func (a *app) printKeys(keys []binarylane.Key) error {
+	rows := make([][]string, len(keys))
+	for i, k := range keys {
+		rows[i] = []string{strconv.Itoa(k.ID), k.Name, k.Fingerprint}
+	}
+	return a.render(keys, []string{"ID", "Name", "Fingerprint"}, rows...)
+}
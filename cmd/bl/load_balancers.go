@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var loadBalancersGroup = group{
+	name:    "load-balancers",
+	summary: "Manage load balancers",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List load balancers",
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					lbs, err := client.LoadBalancers.ListAll(ctx)
+					if err != nil {
+						return err
+					}
+					return a.printLoadBalancers(lbs)
+				}
+			},
+		},
+		{
+			name:    "get",
+			args:    "<load-balancer-id>",
+			summary: "Show a load balancer",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					id, err := parseID(args[0])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					lb, _, err := client.LoadBalancers.Get(ctx, id)
+					if err != nil {
+						return err
+					}
+					return a.printLoadBalancers([]binarylane.LoadBalancer{*lb})
+				}
+			},
+		},
+		{
+			name:    "create",
+			summary: "Create a load balancer from a JSON or YAML file",
+			setup: func(fs *flag.FlagSet) runFunc {
+				file := fs.String("file", "", "`path` of the load balancer request, or - for standard input (required)")
+				return func(ctx context.Context, a *app, args []string) error {
+					if *file == "" {
+						return errUsage
+					}
+					req := new(binarylane.LoadBalancerRequest)
+					if err := a.readSpec(*file, req); err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					lb, resp, err := client.LoadBalancers.Create(ctx, req)
+					if err != nil {
+						return err
+					}
+					if err := a.waitResponse(ctx, resp); err != nil {
+						return err
+					}
+					return a.printLoadBalancers([]binarylane.LoadBalancer{*lb})
+				}
+			},
+		},
+		{
+			name:    "update",
+			args:    "<load-balancer-id>",
+			summary: "Replace a load balancer's configuration with a JSON or YAML file",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				file := fs.String("file", "", "`path` of the load balancer request, or - for standard input (required)")
+				return func(ctx context.Context, a *app, args []string) error {
+					if *file == "" {
+						return errUsage
+					}
+					id, err := parseID(args[0])
+					if err != nil {
+						return err
+					}
+					req := new(binarylane.LoadBalancerRequest)
+					if err := a.readSpec(*file, req); err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					lb, _, err := client.LoadBalancers.Update(ctx, id, req)
+					if err != nil {
+						return err
+					}
+					return a.printLoadBalancers([]binarylane.LoadBalancer{*lb})
+				}
+			},
+		},
+		{
+			name:    "delete",
+			args:    "<load-balancer-id>",
+			summary: "Delete a load balancer",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					id, err := parseID(args[0])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					_, err = client.LoadBalancers.Delete(ctx, id)
+					return err
+				}
+			},
+		},
+		loadBalancerServersCommand("add-servers", "Add servers to a load balancer", binarylane.LoadBalancersService.AddServers),
+		loadBalancerServersCommand("remove-servers", "Remove servers from a load balancer", binarylane.LoadBalancersService.RemoveServers),
+	},
+}
+
+func loadBalancerServersCommand(name, summary string, change func(binarylane.LoadBalancersService, context.Context, int, ...int) (*binarylane.Response, error)) command {
+	return command{
+		name:    name,
+		args:    "<load-balancer-id> <server-id>...",
+		summary: summary,
+		nargs:   variadic,
+		setup: func(fs *flag.FlagSet) runFunc {
+			return func(ctx context.Context, a *app, args []string) error {
+				if len(args) < 2 {
+					return errUsage
+				}
+				ids, err := parseIDs(args)
+				if err != nil {
+					return err
+				}
+				client, err := a.api()
+				if err != nil {
+					return err
+				}
+				_, err = change(client.LoadBalancers, ctx, ids[0], ids[1:]...)
+				return err
+			}
+		},
+	}
+}
+
+func (a *app) printLoadBalancers(lbs []binarylane.LoadBalancer) error {
+	rows := make([][]string, len(lbs))
+	for i, lb := range lbs {
+		rules := make([]string, len(lb.ForwardingRules))
+		for j, r := range lb.ForwardingRules {
+			rules[j] = fmt.Sprintf("%s:%d->%s:%d", r.EntryProtocol, r.EntryPort, r.TargetProtocol, r.TargetPort)
+		}
+		rows[i] = []string{
+			strconv.Itoa(lb.ID), lb.Name, lb.IP, lb.Status, regionSlug(lb.Region),
+			strings.Join(rules, ","), ints(lb.ServerIDs),
+		}
+	}
+	return a.render(lbs, []string{"ID", "Name", "IP", "Status", "Region", "Forwarding Rules", "Servers"}, rows...)
+}
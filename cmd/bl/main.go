@@ -0,0 +1,19 @@
+// Command bl is a command-line client for the BinaryLane API.
+//
+// Usage:
+//
+//	bl [flags] <resource> <command> [flags] [arguments]
+//
+// Run "bl help" for the list of resources and "bl help <resource>" for the
+// commands each supports. The API token is taken from the --token flag, the
+// --context flag, or the environment as described by
+// binarylane.NewFromEnvironment.
+package main
+
+import (
+	"os"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/binarylane/go-binarylane"
+	"gopkg.in/yaml.v2"
+)
+
+// render writes v in the chosen output format. For table output, header and
+// rows are written as aligned columns; JSON and YAML output use v, with the
+// field names of the API.
+func (a *app) render(v interface{}, header []string, rows ...[]string) error {
+	switch a.output {
+	case "json":
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(a.stdout, "%s\n", data)
+		return err
+	case "yaml":
+		data, err := toYAML(v)
+		if err != nil {
+			return err
+		}
+		_, err = a.stdout.Write(data)
+		return err
+	}
+
+	w := tabwriter.NewWriter(a.stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// toYAML converts v to YAML by way of its JSON encoding, so that field names
+// match the API and the JSON output.
+func toYAML(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var generic interface{}
+	if err := dec.Decode(&generic); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(yamlValue(generic))
+}
+
+// yamlValue replaces the json.Number values produced by decoding with
+// UseNumber, which yaml.v2 would otherwise quote, with ints or floats.
+func yamlValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := yaml.MapSlice{}
+		for _, k := range sortedKeys(keySet(v)) {
+			out = append(out, yaml.MapItem{Key: k, Value: yamlValue(v[k])})
+		}
+		return out
+	case []interface{}:
+		for i := range v {
+			v[i] = yamlValue(v[i])
+		}
+		return v
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	}
+	return v
+}
+
+func keySet(m map[string]interface{}) map[string]bool {
+	set := make(map[string]bool, len(m))
+	for k := range m {
+		set[k] = true
+	}
+	return set
+}
+
+// readSpec decodes a JSON or YAML file into v, which should be one of the
+// library's request types. A path of "-" reads standard input.
+func (a *app) readSpec(path string, v interface{}) error {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		data, err = ioutil.ReadAll(a.stdin)
+	} else {
+		data, err = ioutil.ReadFile(path)
+	}
+	if err != nil {
+		return err
+	}
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		return decodeJSON(data, v, path)
+	}
+
+	var generic interface{}
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	generic, err = jsonValue(generic)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if data, err = json.Marshal(generic); err != nil {
+		return err
+	}
+	return decodeJSON(data, v, path)
+}
+
+func decodeJSON(data []byte, v interface{}, path string) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil && err != io.EOF {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// jsonValue converts the maps decoded by yaml.v2, which may have keys of any
+// type, to maps that encoding/json can marshal.
+func jsonValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			key, ok := k.(string)
+			if !ok {
+				return nil, fmt.Errorf("key %v is not a string", k)
+			}
+			converted, err := jsonValue(val)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = converted
+		}
+		return out, nil
+	case []interface{}:
+		for i := range v {
+			converted, err := jsonValue(v[i])
+			if err != nil {
+				return nil, err
+			}
+			v[i] = converted
+		}
+		return v, nil
+	}
+	return v, nil
+}
+
+// readFile reads a file named by a flag, with "-" for standard input and
+// "@" prefixes accepted for familiarity with curl.
+func (a *app) readFile(path string) (string, error) {
+	path = strings.TrimPrefix(path, "@")
+	if path == "-" {
+		data, err := ioutil.ReadAll(a.stdin)
+		return string(data), err
+	}
+	data, err := ioutil.ReadFile(path)
+	return string(data), err
+}
+
+// writeFile writes data to path, or to standard output if path is "" or
+// "-".
+func (a *app) writeFile(path string, data []byte) error {
+	if path == "" || path == "-" {
+		_, err := a.stdout.Write(data)
+		return err
+	}
+	return ioutil.WriteFile(path, data, os.FileMode(0644))
+}
+
+func timestamp(t *binarylane.Timestamp) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
+
+func itoa(i int) string {
+	if i == 0 {
+		return ""
+	}
+	return strconv.Itoa(i)
+}
+
+func ints(ids []int) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.Itoa(id)
+	}
+	return strings.Join(s, ",")
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func regionSlug(r *binarylane.Region) string {
+	if r == nil {
+		return ""
+	}
+	return r.Slug
+}
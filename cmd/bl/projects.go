@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var projectsGroup = group{
+	name:    "projects",
+	summary: "Manage projects and the resources assigned to them",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List projects",
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					projects, err := client.Projects.ListAll(ctx)
+					if err != nil {
+						return err
+					}
+					return a.printProjects(projects)
+				}
+			},
+		},
+		{
+			name:    "get",
+			args:    "<project-id|default>",
+			summary: "Show a project",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					var project *binarylane.Project
+					if args[0] == "default" {
+						project, _, err = client.Projects.GetDefault(ctx)
+					} else {
+						project, _, err = client.Projects.Get(ctx, args[0])
+					}
+					if err != nil {
+						return err
+					}
+					return a.printProjects([]binarylane.Project{*project})
+				}
+			},
+		},
+		{
+			name:    "create",
+			args:    "<name>",
+			summary: "Create a project",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				req := new(binarylane.CreateProjectRequest)
+				fs.StringVar(&req.Purpose, "purpose", "", "`purpose` of the project (required)")
+				fs.StringVar(&req.Description, "description", "", "`description` of the project")
+				fs.StringVar(&req.Environment, "environment", "", "`environment`: Development, Staging or Production")
+				return func(ctx context.Context, a *app, args []string) error {
+					if req.Purpose == "" {
+						return errUsage
+					}
+					req.Name = args[0]
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					project, _, err := client.Projects.Create(ctx, req)
+					if err != nil {
+						return err
+					}
+					return a.printProjects([]binarylane.Project{*project})
+				}
+			},
+		},
+		{
+			name:    "update",
+			args:    "<project-id>",
+			summary: "Change the project fields given; others are left as they are",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				name := fs.String("name", "", "new `name`")
+				purpose := fs.String("purpose", "", "new `purpose`")
+				description := fs.String("description", "", "new `description`")
+				environment := fs.String("environment", "", "new `environment`")
+				isDefault := fs.Bool("default", false, "make this the default project")
+				return func(ctx context.Context, a *app, args []string) error {
+					set := setFlags(fs)
+					req := new(binarylane.UpdateProjectRequest)
+					if set["name"] {
+						req.Name = *name
+					}
+					if set["purpose"] {
+						req.Purpose = *purpose
+					}
+					if set["description"] {
+						req.Description = *description
+					}
+					if set["environment"] {
+						req.Environment = *environment
+					}
+					if set["default"] {
+						req.IsDefault = *isDefault
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					project, _, err := client.Projects.Update(ctx, args[0], req)
+					if err != nil {
+						return err
+					}
+					return a.printProjects([]binarylane.Project{*project})
+				}
+			},
+		},
+		{
+			name:    "delete",
+			args:    "<project-id>",
+			summary: "Delete a project",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					_, err = client.Projects.Delete(ctx, args[0])
+					return err
+				}
+			},
+		},
+		{
+			name:    "resources",
+			args:    "<project-id>",
+			summary: "List the resources assigned to a project",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					var resources []binarylane.ProjectResource
+					err = listAll(ctx, func(ctx context.Context, opt *binarylane.ListOptions) (*binarylane.Response, error) {
+						page, resp, err := client.Projects.ListResources(ctx, args[0], opt)
+						resources = append(resources, page...)
+						return resp, err
+					})
+					if err != nil {
+						return err
+					}
+					return a.printProjectResources(resources)
+				}
+			},
+		},
+		{
+			name:    "assign",
+			args:    "<project-id> <urn>...",
+			summary: "Assign resources, given as URNs such as bl:server:1234, to a project",
+			nargs:   variadic,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					if len(args) < 2 {
+						return errUsage
+					}
+					urns := make([]interface{}, len(args)-1)
+					for i, urn := range args[1:] {
+						urns[i] = urn
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					resources, _, err := client.Projects.AssignResources(ctx, args[0], urns...)
+					if err != nil {
+						return err
+					}
+					return a.printProjectResources(resources)
+				}
+			},
+		},
+	},
+}
+
+func (a *app) printProjects(projects []binarylane.Project) error {
+	rows := make([][]string, len(projects))
+	for i, p := range projects {
+		rows[i] = []string{p.ID, p.Name, p.Purpose, p.Environment, yesNo(p.IsDefault), p.Description}
+	}
+	return a.render(projects, []string{"ID", "Name", "Purpose", "Environment", "Default", "Description"}, rows...)
+}
+
+func (a *app) printProjectResources(resources []binarylane.ProjectResource) error {
+	rows := make([][]string, len(resources))
+	for i, r := range resources {
+		rows[i] = []string{r.URN, r.AssignedAt, r.Status}
+	}
+	return a.render(resources, []string{"URN", "Assigned At", "Status"}, rows...)
+}
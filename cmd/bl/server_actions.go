@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+// serverActionFunc starts an action on one server.
+type serverActionFunc func(binarylane.ServerActionsService, context.Context, int) (*binarylane.Action, *binarylane.Response, error)
+
+// serverTagActionFunc starts an action on every server with a tag.
+type serverTagActionFunc func(binarylane.ServerActionsService, context.Context, string) ([]binarylane.Action, *binarylane.Response, error)
+
+var serverActionsGroup = group{
+	name:    "server-actions",
+	summary: "Power, resize, rebuild and snapshot servers",
+	commands: []command{
+		serverActionCommand("power-on", "Power servers on", binarylane.ServerActionsService.PowerOn, binarylane.ServerActionsService.PowerOnByTag),
+		serverActionCommand("power-off", "Power servers off", binarylane.ServerActionsService.PowerOff, binarylane.ServerActionsService.PowerOffByTag),
+		serverActionCommand("power-cycle", "Power cycle servers", binarylane.ServerActionsService.PowerCycle, binarylane.ServerActionsService.PowerCycleByTag),
+		serverActionCommand("shutdown", "Shut servers down gracefully", binarylane.ServerActionsService.Shutdown, binarylane.ServerActionsService.ShutdownByTag),
+		serverActionCommand("reboot", "Reboot servers", binarylane.ServerActionsService.Reboot, nil),
+		serverActionCommand("enable-backups", "Enable backups", binarylane.ServerActionsService.EnableBackups, binarylane.ServerActionsService.EnableBackupsByTag),
+		serverActionCommand("disable-backups", "Disable backups", binarylane.ServerActionsService.DisableBackups, binarylane.ServerActionsService.DisableBackupsByTag),
+		serverActionCommand("enable-ipv6", "Enable IPv6", binarylane.ServerActionsService.EnableIPv6, binarylane.ServerActionsService.EnableIPv6ByTag),
+		serverActionCommand("enable-private-networking", "Enable private networking", binarylane.ServerActionsService.EnablePrivateNetworking, binarylane.ServerActionsService.EnablePrivateNetworkingByTag),
+		serverActionCommand("password-reset", "Reset the root password", binarylane.ServerActionsService.PasswordReset, nil),
+		{
+			name:    "snapshot",
+			args:    "<server-id>",
+			summary: "Snapshot a server, or with --tag every server with a tag",
+			nargs:   variadic,
+			setup: func(fs *flag.FlagSet) runFunc {
+				name := fs.String("name", "", "`name` of the snapshot (required)")
+				tag := fs.String("tag", "", "snapshot every server with this `tag`")
+				return func(ctx context.Context, a *app, args []string) error {
+					if *name == "" || (*tag == "") == (len(args) == 0) || len(args) > 1 {
+						return errUsage
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					if *tag != "" {
+						actions, _, err := client.ServerActions.SnapshotByTag(ctx, *tag, *name)
+						return a.actions(ctx, actions, err)
+					}
+					id, err := parseID(args[0])
+					if err != nil {
+						return err
+					}
+					return a.action(ctx)(client.ServerActions.Snapshot(ctx, id, *name))
+				}
+			},
+		},
+		{
+			name:    "resize",
+			args:    "<server-id>",
+			summary: "Change a server's size",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				size := fs.String("size", "", "`slug` of the new size (required)")
+				disk := fs.Bool("resize-disk", false, "also grow the disk; this cannot be undone")
+				return func(ctx context.Context, a *app, args []string) error {
+					if *size == "" {
+						return errUsage
+					}
+					return a.serverAction(ctx, args[0], func(actions binarylane.ServerActionsService, id int) (*binarylane.Action, *binarylane.Response, error) {
+						return actions.Resize(ctx, id, *size, *disk)
+					})
+				}
+			},
+		},
+		{
+			name:    "rename",
+			args:    "<server-id>",
+			summary: "Rename a server",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				name := fs.String("name", "", "new `name` (required)")
+				return func(ctx context.Context, a *app, args []string) error {
+					if *name == "" {
+						return errUsage
+					}
+					return a.serverAction(ctx, args[0], func(actions binarylane.ServerActionsService, id int) (*binarylane.Action, *binarylane.Response, error) {
+						return actions.Rename(ctx, id, *name)
+					})
+				}
+			},
+		},
+		{
+			name:    "rebuild",
+			args:    "<server-id>",
+			summary: "Rebuild a server from an image, erasing its disk",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				image := fs.String("image", "", "`slug or ID` of the image (required)")
+				return func(ctx context.Context, a *app, args []string) error {
+					if *image == "" {
+						return errUsage
+					}
+					return a.serverAction(ctx, args[0], func(actions binarylane.ServerActionsService, id int) (*binarylane.Action, *binarylane.Response, error) {
+						if img := serverImage(*image); img.ID != 0 {
+							return actions.RebuildByImageID(ctx, id, img.ID)
+						}
+						return actions.RebuildByImageSlug(ctx, id, *image)
+					})
+				}
+			},
+		},
+		{
+			name:    "restore",
+			args:    "<server-id>",
+			summary: "Restore a server from a backup or snapshot",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				image := fs.Int("image", 0, "`ID` of the backup or snapshot (required)")
+				return func(ctx context.Context, a *app, args []string) error {
+					if *image == 0 {
+						return errUsage
+					}
+					return a.serverAction(ctx, args[0], func(actions binarylane.ServerActionsService, id int) (*binarylane.Action, *binarylane.Response, error) {
+						return actions.Restore(ctx, id, *image)
+					})
+				}
+			},
+		},
+		{
+			name:    "change-kernel",
+			args:    "<server-id>",
+			summary: "Change the kernel a server boots",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				kernel := fs.Int("kernel", 0, "`ID` of the kernel (required)")
+				return func(ctx context.Context, a *app, args []string) error {
+					if *kernel == 0 {
+						return errUsage
+					}
+					return a.serverAction(ctx, args[0], func(actions binarylane.ServerActionsService, id int) (*binarylane.Action, *binarylane.Response, error) {
+						return actions.ChangeKernel(ctx, id, *kernel)
+					})
+				}
+			},
+		},
+		{
+			name:    "get",
+			args:    "<server-id> <action-id>",
+			summary: "Show an action run on a server",
+			nargs:   2,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					ids, err := parseIDs(args)
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					return a.action(ctx)(client.ServerActions.Get(ctx, ids[0], ids[1]))
+				}
+			},
+		},
+	},
+}
+
+// serverActionCommand builds a command that runs an action taking no
+// arguments on one or more servers, or if byTag is set on every server with
+// a tag.
+func serverActionCommand(name, summary string, one serverActionFunc, byTag serverTagActionFunc) command {
+	if byTag != nil {
+		summary += ", or with --tag every server with a tag"
+	}
+	return command{
+		name:    name,
+		args:    "<server-id>...",
+		summary: summary,
+		nargs:   variadic,
+		setup: func(fs *flag.FlagSet) runFunc {
+			var tag *string
+			if byTag != nil {
+				tag = fs.String("tag", "", "act on every server with this `tag`")
+			}
+			return func(ctx context.Context, a *app, args []string) error {
+				byTagged := tag != nil && *tag != ""
+				if byTagged == (len(args) > 0) {
+					return errUsage
+				}
+				ids, err := parseIDs(args)
+				if err != nil {
+					return err
+				}
+				client, err := a.api()
+				if err != nil {
+					return err
+				}
+
+				if byTagged {
+					actions, _, err := byTag(client.ServerActions, ctx, *tag)
+					return a.actions(ctx, actions, err)
+				}
+				if len(ids) == 1 {
+					return a.action(ctx)(one(client.ServerActions, ctx, ids[0]))
+				}
+
+				var actions []binarylane.Action
+				for _, id := range ids {
+					action, _, err := one(client.ServerActions, ctx, id)
+					if err != nil {
+						return a.actions(ctx, actions, err)
+					}
+					actions = append(actions, *action)
+				}
+				return a.actions(ctx, actions, nil)
+			}
+		},
+	}
+}
+
+// serverAction parses a server ID and runs an action on the server.
+func (a *app) serverAction(ctx context.Context, arg string, start func(binarylane.ServerActionsService, int) (*binarylane.Action, *binarylane.Response, error)) error {
+	id, err := parseID(arg)
+	if err != nil {
+		return err
+	}
+	client, err := a.api()
+	if err != nil {
+		return err
+	}
+	return a.action(ctx)(start(client.ServerActions, id))
+}
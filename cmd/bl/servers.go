@@ -0,0 +1,351 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"strings"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var serversGroup = group{
+	name:    "servers",
+	summary: "Create, list and delete servers",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List servers",
+			setup: func(fs *flag.FlagSet) runFunc {
+				tag := fs.String("tag", "", "only list servers with this `tag`")
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					var servers []binarylane.Server
+					if *tag == "" {
+						servers, err = client.Servers.ListAll(ctx)
+					} else {
+						err = listAll(ctx, func(ctx context.Context, opt *binarylane.ListOptions) (*binarylane.Response, error) {
+							page, resp, err := client.Servers.ListByTag(ctx, *tag, opt)
+							servers = append(servers, page...)
+							return resp, err
+						})
+					}
+					if err != nil {
+						return err
+					}
+					return a.printServers(servers)
+				}
+			},
+		},
+		{
+			name:    "get",
+			args:    "<server-id>",
+			summary: "Show a server",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					id, err := parseID(args[0])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					server, _, err := client.Servers.Get(ctx, id)
+					if err != nil {
+						return err
+					}
+					return a.printServers([]binarylane.Server{*server})
+				}
+			},
+		},
+		{
+			name:    "create",
+			args:    "<name>...",
+			summary: "Create one or more servers",
+			nargs:   variadic,
+			setup: func(fs *flag.FlagSet) runFunc {
+				var (
+					region   = fs.String("region", "", "`slug` of the region (required)")
+					size     = fs.String("size", "", "`slug` of the size (required)")
+					image    = fs.String("image", "", "`slug or ID` of the image (required)")
+					userData = fs.String("user-data-file", "", "`path` of a cloud-init user data file")
+					vpcID    = fs.Int("vpc-id", 0, "`ID` of the VPC to place the server in")
+					backups  = fs.Bool("backups", false, "enable backups")
+					ipv6     = fs.Bool("ipv6", false, "enable IPv6")
+					private  = fs.Bool("private-networking", false, "enable private networking")
+					keys     stringsFlag
+					tags     stringsFlag
+				)
+				fs.Var(&keys, "ssh-key", "`ID or fingerprint` of an SSH key to install; may be repeated")
+				fs.Var(&tags, "tag", "`tag` to apply; may be repeated")
+
+				return func(ctx context.Context, a *app, args []string) error {
+					if len(args) == 0 || *region == "" || *size == "" || *image == "" {
+						return errUsage
+					}
+					req := &binarylane.ServerMultiCreateRequest{
+						Names:             args,
+						Region:            *region,
+						Size:              *size,
+						Image:             serverImage(*image),
+						Backups:           *backups,
+						IPv6:              *ipv6,
+						PrivateNetworking: *private,
+						Tags:              tags,
+						VPCID:             *vpcID,
+					}
+					for _, k := range keys {
+						req.SSHKeys = append(req.SSHKeys, sshKey(k))
+					}
+					if *userData != "" {
+						data, err := a.readFile(*userData)
+						if err != nil {
+							return err
+						}
+						req.UserData = data
+					}
+
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+
+					var (
+						servers []binarylane.Server
+						resp    *binarylane.Response
+					)
+					if len(args) == 1 {
+						var server *binarylane.Server
+						server, resp, err = client.Servers.Create(ctx, singleServer(req))
+						if server != nil {
+							servers = []binarylane.Server{*server}
+						}
+					} else {
+						servers, resp, err = client.Servers.CreateMultiple(ctx, req)
+					}
+					if err != nil {
+						return err
+					}
+
+					if a.wait {
+						if err := a.waitResponse(ctx, resp); err != nil {
+							return err
+						}
+						for i := range servers {
+							if server, _, err := client.Servers.Get(ctx, servers[i].ID); err == nil {
+								servers[i] = *server
+							}
+						}
+					}
+					return a.printServers(servers)
+				}
+			},
+		},
+		{
+			name:    "delete",
+			args:    "<server-id>...",
+			summary: "Delete servers, or with --tag every server with a tag",
+			nargs:   variadic,
+			setup: func(fs *flag.FlagSet) runFunc {
+				tag := fs.String("tag", "", "delete every server with this `tag`")
+				return func(ctx context.Context, a *app, args []string) error {
+					if (*tag == "") == (len(args) == 0) {
+						return errUsage
+					}
+					ids, err := parseIDs(args)
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					if *tag != "" {
+						_, err := client.Servers.DeleteByTag(ctx, *tag)
+						return err
+					}
+					for _, id := range ids {
+						if _, err := client.Servers.Delete(ctx, id); err != nil {
+							return err
+						}
+					}
+					return nil
+				}
+			},
+		},
+		{
+			name:    "actions",
+			args:    "<server-id>",
+			summary: "List the actions run on a server",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					id, err := parseID(args[0])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					var actions []*binarylane.Action
+					err = listAll(ctx, func(ctx context.Context, opt *binarylane.ListOptions) (*binarylane.Response, error) {
+						page, resp, err := client.Servers.Actions(ctx, id, opt)
+						for i := range page {
+							actions = append(actions, &page[i])
+						}
+						return resp, err
+					})
+					if err != nil {
+						return err
+					}
+					return a.printActions(actions...)
+				}
+			},
+		},
+		serverImagesCommand("snapshots", "List a server's snapshots", binarylane.ServersService.Snapshots),
+		serverImagesCommand("backups", "List a server's backups", binarylane.ServersService.Backups),
+		{
+			name:    "kernels",
+			args:    "<server-id>",
+			summary: "List the kernels available to a server",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					id, err := parseID(args[0])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					var kernels []binarylane.Kernel
+					err = listAll(ctx, func(ctx context.Context, opt *binarylane.ListOptions) (*binarylane.Response, error) {
+						page, resp, err := client.Servers.Kernels(ctx, id, opt)
+						kernels = append(kernels, page...)
+						return resp, err
+					})
+					if err != nil {
+						return err
+					}
+					rows := make([][]string, len(kernels))
+					for i, k := range kernels {
+						rows[i] = []string{strconv.Itoa(k.ID), k.Name, k.Version}
+					}
+					return a.render(kernels, []string{"ID", "Name", "Version"}, rows...)
+				}
+			},
+		},
+		{
+			name:    "neighbors",
+			args:    "<server-id>",
+			summary: "List servers on the same physical host as a server",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					id, err := parseID(args[0])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					servers, _, err := client.Servers.Neighbors(ctx, id)
+					if err != nil {
+						return err
+					}
+					return a.printServers(servers)
+				}
+			},
+		},
+	},
+}
+
+// serverImagesCommand builds a command listing images that belong to a
+// server, such as its snapshots or backups.
+func serverImagesCommand(name, summary string, list func(binarylane.ServersService, context.Context, int, *binarylane.ListOptions) ([]binarylane.Image, *binarylane.Response, error)) command {
+	return command{
+		name:    name,
+		args:    "<server-id>",
+		summary: summary,
+		nargs:   1,
+		setup: func(fs *flag.FlagSet) runFunc {
+			return func(ctx context.Context, a *app, args []string) error {
+				id, err := parseID(args[0])
+				if err != nil {
+					return err
+				}
+				client, err := a.api()
+				if err != nil {
+					return err
+				}
+				var images []binarylane.Image
+				err = listAll(ctx, func(ctx context.Context, opt *binarylane.ListOptions) (*binarylane.Response, error) {
+					page, resp, err := list(client.Servers, ctx, id, opt)
+					images = append(images, page...)
+					return resp, err
+				})
+				if err != nil {
+					return err
+				}
+				return a.printImages(images)
+			}
+		},
+	}
+}
+
+func (a *app) printServers(servers []binarylane.Server) error {
+	rows := make([][]string, len(servers))
+	for i := range servers {
+		s := &servers[i]
+		ipv4, _ := s.PublicIPv4()
+		rows[i] = []string{
+			strconv.Itoa(s.ID), s.Name, s.Status, s.SizeSlug, regionSlug(s.Region),
+			ipv4, strings.Join(s.Tags, ","),
+		}
+	}
+	return a.render(servers, []string{"ID", "Name", "Status", "Size", "Region", "Public IPv4", "Tags"}, rows...)
+}
+
+// serverImage interprets an image given on the command line as an ID if it
+// is numeric, and otherwise as a slug.
+func serverImage(image string) binarylane.ServerCreateImage {
+	if id, err := strconv.Atoi(image); err == nil {
+		return binarylane.ServerCreateImage{ID: id}
+	}
+	return binarylane.ServerCreateImage{Slug: image}
+}
+
+// sshKey interprets an SSH key given on the command line as an ID if it is
+// numeric, and otherwise as a fingerprint.
+func sshKey(key string) binarylane.ServerCreateSSHKey {
+	if id, err := strconv.Atoi(key); err == nil {
+		return binarylane.ServerCreateSSHKey{ID: id}
+	}
+	return binarylane.ServerCreateSSHKey{Fingerprint: key}
+}
+
+// singleServer converts a request for several servers to one for a single
+// server.
+func singleServer(req *binarylane.ServerMultiCreateRequest) *binarylane.ServerCreateRequest {
+	return &binarylane.ServerCreateRequest{
+		Name:              req.Names[0],
+		Region:            req.Region,
+		Size:              req.Size,
+		Image:             req.Image,
+		SSHKeys:           req.SSHKeys,
+		Backups:           req.Backups,
+		IPv6:              req.IPv6,
+		PrivateNetworking: req.PrivateNetworking,
+		UserData:          req.UserData,
+		Tags:              req.Tags,
+		VPCID:             req.VPCID,
+	}
+}
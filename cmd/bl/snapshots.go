@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var snapshotsGroup = group{
+	name:    "snapshots",
+	summary: "List and delete server and volume snapshots",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List snapshots",
+			setup: func(fs *flag.FlagSet) runFunc {
+				resource := fs.String("resource", "", "only list snapshots of `type` server or volume")
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+
+					var list func(context.Context, *binarylane.ListOptions) ([]binarylane.Snapshot, *binarylane.Response, error)
+					switch *resource {
+					case "":
+						list = client.Snapshots.List
+					case "server":
+						list = client.Snapshots.ListServer
+					case "volume":
+						list = client.Snapshots.ListVolume
+					default:
+						return fmt.Errorf("unknown resource type %q", *resource)
+					}
+
+					var snapshots []binarylane.Snapshot
+					err = listAll(ctx, func(ctx context.Context, opt *binarylane.ListOptions) (*binarylane.Response, error) {
+						page, resp, err := list(ctx, opt)
+						snapshots = append(snapshots, page...)
+						return resp, err
+					})
+					if err != nil {
+						return err
+					}
+					return a.printSnapshots(snapshots)
+				}
+			},
+		},
+		{
+			name:    "get",
+			args:    "<snapshot-id>",
+			summary: "Show a snapshot",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					snapshot, _, err := client.Snapshots.Get(ctx, args[0])
+					if err != nil {
+						return err
+					}
+					return a.printSnapshots([]binarylane.Snapshot{*snapshot})
+				}
+			},
+		},
+		{
+			name:    "delete",
+			args:    "<snapshot-id>...",
+			summary: "Delete snapshots",
+			nargs:   variadic,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					if len(args) == 0 {
+						return errUsage
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					for _, id := range args {
+						if _, err := client.Snapshots.Delete(ctx, id); err != nil {
+							return err
+						}
+					}
+					return nil
+				}
+			},
+		},
+	},
+}
+
+func (a *app) printSnapshots(snapshots []binarylane.Snapshot) error {
+	rows := make([][]string, len(snapshots))
+	for i, s := range snapshots {
+		rows[i] = []string{
+			s.ID, s.Name, s.ResourceType, s.ResourceID, strings.Join(s.Regions, ","),
+			fmt.Sprintf("%.2f", s.SizeGigaBytes), s.Created,
+		}
+	}
+	return a.render(snapshots, []string{"ID", "Name", "Resource Type", "Resource ID", "Regions", "Size (GB)", "Created"}, rows...)
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var tagsGroup = group{
+	name:    "tags",
+	summary: "Manage tags and the resources they are applied to",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List tags",
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					tags, err := client.Tags.ListAll(ctx)
+					if err != nil {
+						return err
+					}
+					return a.printTags(tags)
+				}
+			},
+		},
+		{
+			name:    "get",
+			args:    "<tag>",
+			summary: "Show a tag",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					tag, _, err := client.Tags.Get(ctx, args[0])
+					if err != nil {
+						return err
+					}
+					return a.printTags([]binarylane.Tag{*tag})
+				}
+			},
+		},
+		{
+			name:    "create",
+			args:    "<tag>",
+			summary: "Create a tag",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					tag, _, err := client.Tags.Create(ctx, &binarylane.TagCreateRequest{Name: args[0]})
+					if err != nil {
+						return err
+					}
+					return a.printTags([]binarylane.Tag{*tag})
+				}
+			},
+		},
+		{
+			name:    "delete",
+			args:    "<tag>",
+			summary: "Delete a tag, removing it from every resource",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					_, err = client.Tags.Delete(ctx, args[0])
+					return err
+				}
+			},
+		},
+		{
+			name:    "apply",
+			args:    "<tag> <type:id>...",
+			summary: "Tag resources, given as type and ID such as server:1234",
+			nargs:   variadic,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					if len(args) < 2 {
+						return errUsage
+					}
+					resources, err := parseResources(args[1:])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					_, err = client.Tags.TagResources(ctx, args[0], &binarylane.TagResourcesRequest{Resources: resources})
+					return err
+				}
+			},
+		},
+		{
+			name:    "remove",
+			args:    "<tag> <type:id>...",
+			summary: "Untag resources, given as type and ID such as server:1234",
+			nargs:   variadic,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					if len(args) < 2 {
+						return errUsage
+					}
+					resources, err := parseResources(args[1:])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					_, err = client.Tags.UntagResources(ctx, args[0], &binarylane.UntagResourcesRequest{Resources: resources})
+					return err
+				}
+			},
+		},
+	},
+}
+
+// parseResources parses resources given on the command line as type:id.
+func parseResources(args []string) ([]binarylane.Resource, error) {
+	resources := make([]binarylane.Resource, len(args))
+	for i, arg := range args {
+		parts := strings.SplitN(arg, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid resource %q, expected type:id", arg)
+		}
+		resources[i] = binarylane.Resource{ID: parts[1], Type: binarylane.ResourceType(strings.Replace(parts[0], "-", "_", -1))}
+	}
+	return resources, nil
+}
+
+func (a *app) printTags(tags []binarylane.Tag) error {
+	rows := make([][]string, len(tags))
+	for i, t := range tags {
+		var count string
+		if t.Resources != nil {
+			count = strconv.Itoa(t.Resources.Count)
+		}
+		rows[i] = []string{t.Name, count}
+	}
+	return a.render(tags, []string{"Name", "Resources"}, rows...)
+}
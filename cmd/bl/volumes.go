@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"strings"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var volumesGroup = group{
+	name:    "volumes",
+	summary: "Manage block storage volumes",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List volumes",
+			setup: func(fs *flag.FlagSet) runFunc {
+				region := fs.String("region", "", "only list volumes in the region with this `slug`")
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					var volumes []binarylane.Volume
+					err = listAll(ctx, func(ctx context.Context, opt *binarylane.ListOptions) (*binarylane.Response, error) {
+						page, resp, err := client.Volumes.List(ctx, &binarylane.ListVolumeParams{Region: *region, ListOptions: opt})
+						volumes = append(volumes, page...)
+						return resp, err
+					})
+					if err != nil {
+						return err
+					}
+					return a.printVolumes(volumes)
+				}
+			},
+		},
+		{
+			name:    "get",
+			args:    "<volume-id>",
+			summary: "Show a volume",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					volume, _, err := client.Volumes.Get(ctx, args[0])
+					if err != nil {
+						return err
+					}
+					return a.printVolumes([]binarylane.Volume{*volume})
+				}
+			},
+		},
+		{
+			name:    "delete",
+			args:    "<volume-id>",
+			summary: "Delete a volume",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					_, err = client.Volumes.Delete(ctx, args[0])
+					return err
+				}
+			},
+		},
+		{
+			name:    "attach",
+			args:    "<volume-id> <server-id>",
+			summary: "Attach a volume to a server",
+			nargs:   2,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					id, err := parseID(args[1])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					return a.action(ctx)(client.VolumeActions.Attach(ctx, args[0], id))
+				}
+			},
+		},
+		{
+			name:    "detach",
+			args:    "<volume-id> <server-id>",
+			summary: "Detach a volume from a server",
+			nargs:   2,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					id, err := parseID(args[1])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					return a.action(ctx)(client.VolumeActions.Detach(ctx, args[0], id))
+				}
+			},
+		},
+	},
+}
+
+func (a *app) printVolumes(volumes []binarylane.Volume) error {
+	rows := make([][]string, len(volumes))
+	for i, v := range volumes {
+		rows[i] = []string{
+			v.ID, v.Name, strconv.Itoa(v.SizeGigaBytes), regionSlug(v.Region),
+			ints(v.ServerIDs), strings.Join(v.Tags, ","),
+		}
+	}
+	return a.render(volumes, []string{"ID", "Name", "Size (GB)", "Region", "Servers", "Tags"}, rows...)
+}
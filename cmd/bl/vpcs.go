@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+var vpcsGroup = group{
+	name:    "vpcs",
+	summary: "Manage virtual private clouds",
+	commands: []command{
+		{
+			name:    "list",
+			summary: "List VPCs",
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					vpcs, err := client.VPCs.ListAll(ctx)
+					if err != nil {
+						return err
+					}
+					return a.printVPCs(vpcs)
+				}
+			},
+		},
+		{
+			name:    "get",
+			args:    "<vpc-id>",
+			summary: "Show a VPC",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					id, err := parseID(args[0])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					vpc, _, err := client.VPCs.Get(ctx, id)
+					if err != nil {
+						return err
+					}
+					return a.printVPCs([]*binarylane.VPC{vpc})
+				}
+			},
+		},
+		{
+			name:    "create",
+			args:    "<name>",
+			summary: "Create a VPC",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				req := new(binarylane.VPCCreateRequest)
+				fs.StringVar(&req.RegionSlug, "region", "", "`slug` of the region (required)")
+				fs.StringVar(&req.IPRange, "ip-range", "", "private address `range` in CIDR notation")
+				fs.StringVar(&req.Description, "description", "", "`description` of the VPC")
+				return func(ctx context.Context, a *app, args []string) error {
+					if req.RegionSlug == "" {
+						return errUsage
+					}
+					req.Name = args[0]
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					vpc, _, err := client.VPCs.Create(ctx, req)
+					if err != nil {
+						return err
+					}
+					return a.printVPCs([]*binarylane.VPC{vpc})
+				}
+			},
+		},
+		{
+			name:    "update",
+			args:    "<vpc-id>",
+			summary: "Change the name, description or default status of a VPC",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				name := fs.String("name", "", "new `name`")
+				description := fs.String("description", "", "new `description`")
+				isDefault := fs.Bool("default", false, "make this the default VPC for its region")
+				return func(ctx context.Context, a *app, args []string) error {
+					id, err := parseID(args[0])
+					if err != nil {
+						return err
+					}
+					set := setFlags(fs)
+					var fields []binarylane.VPCSetField
+					if set["name"] {
+						fields = append(fields, binarylane.VPCSetName(*name))
+					}
+					if set["description"] {
+						fields = append(fields, binarylane.VPCSetDescription(*description))
+					}
+					if set["default"] && *isDefault {
+						fields = append(fields, binarylane.VPCSetDefault())
+					}
+					if len(fields) == 0 {
+						return errUsage
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					vpc, _, err := client.VPCs.Set(ctx, id, fields...)
+					if err != nil {
+						return err
+					}
+					return a.printVPCs([]*binarylane.VPC{vpc})
+				}
+			},
+		},
+		{
+			name:    "delete",
+			args:    "<vpc-id>",
+			summary: "Delete a VPC",
+			nargs:   1,
+			setup: func(fs *flag.FlagSet) runFunc {
+				return func(ctx context.Context, a *app, args []string) error {
+					id, err := parseID(args[0])
+					if err != nil {
+						return err
+					}
+					client, err := a.api()
+					if err != nil {
+						return err
+					}
+					_, err = client.VPCs.Delete(ctx, id)
+					return err
+				}
+			},
+		},
+	},
+}
+
+func (a *app) printVPCs(vpcs []*binarylane.VPC) error {
+	rows := make([][]string, len(vpcs))
+	for i, v := range vpcs {
+		rows[i] = []string{strconv.Itoa(v.ID), v.Name, v.RegionSlug, v.IPRange, yesNo(v.Default), v.Description}
+	}
+	return a.render(vpcs, []string{"ID", "Name", "Region", "IP Range", "Default", "Description"}, rows...)
+}
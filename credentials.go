@@ -0,0 +1,310 @@
+package binarylane
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// TokenEnvVar is the environment variable read for an API token.
+	TokenEnvVar = "BINARYLANE_API_TOKEN"
+
+	// TokenFileEnvVar names a file holding the API token.
+	TokenFileEnvVar = "BINARYLANE_API_TOKEN_FILE"
+
+	// TokenCommandEnvVar is a command, split on spaces, that prints the API
+	// token.
+	TokenCommandEnvVar = "BINARYLANE_API_TOKEN_COMMAND"
+
+	// ConfigEnvVar overrides the path of the config file.
+	ConfigEnvVar = "BINARYLANE_CONFIG"
+
+	// ContextEnvVar selects the context used from the config file.
+	ContextEnvVar = "BINARYLANE_CONTEXT"
+
+	defaultCommandTokenTTL = 5 * time.Minute
+)
+
+// ErrNoCredentials is returned by a token source that has no token to
+// offer, such as an unset environment variable or a missing config file.
+// ChainTokenSource moves on to its next source when it sees this error.
+var ErrNoCredentials = errors.New("binarylane: no credentials found")
+
+// NewFromTokenSource returns a new BinaryLane API client that authenticates
+// each request with a token from ts. Unlike oauth2.NewClient, tokens without
+// an expiry are not reused indefinitely, so sources that pick up rotated
+// tokens, such as FileTokenSource, take effect on the next request.
+func NewFromTokenSource(ts oauth2.TokenSource) *Client {
+	return NewClient(&http.Client{Transport: &oauth2.Transport{Source: ts}})
+}
+
+// NewFromEnvironment returns a new BinaryLane API client using the first
+// token found in, in order:
+//
+//   - the BINARYLANE_API_TOKEN environment variable
+//   - the file named by BINARYLANE_API_TOKEN_FILE
+//   - the output of the command in BINARYLANE_API_TOKEN_COMMAND
+//   - the context named by BINARYLANE_CONTEXT in the config file at
+//     BINARYLANE_CONFIG, or DefaultConfigPath
+//
+// It returns an error wrapping ErrNoCredentials if none of these provide a
+// token.
+func NewFromEnvironment(opts ...ClientOpt) (*Client, error) {
+	ts, err := EnvironmentTokenSource()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ts.Token(); err != nil {
+		return nil, err
+	}
+
+	c := NewFromTokenSource(ts)
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// EnvironmentTokenSource returns the chain of token sources used by
+// NewFromEnvironment. If BINARYLANE_CONFIG is not set and DefaultConfigPath
+// cannot be determined, as when $HOME is not set, the config file is left
+// out of the chain.
+func EnvironmentTokenSource() (oauth2.TokenSource, error) {
+	sources := []oauth2.TokenSource{EnvTokenSource(TokenEnvVar)}
+	if path := os.Getenv(TokenFileEnvVar); path != "" {
+		sources = append(sources, FileTokenSource(path))
+	}
+	if command := strings.Fields(os.Getenv(TokenCommandEnvVar)); len(command) > 0 {
+		sources = append(sources, CommandTokenSource(0, command[0], command[1:]...))
+	}
+
+	path := os.Getenv(ConfigEnvVar)
+	if path == "" {
+		path, _ = DefaultConfigPath()
+	}
+	if path != "" {
+		sources = append(sources, ConfigTokenSource(path, os.Getenv(ContextEnvVar)))
+	}
+
+	return ChainTokenSource(sources...), nil
+}
+
+// DefaultConfigPath returns the default location of the config file,
+// binarylane/config.yaml in the user's configuration directory.
+func DefaultConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "binarylane", "config.yaml"), nil
+}
+
+// tokenSourceFunc adapts a function to oauth2.TokenSource.
+type tokenSourceFunc func() (*oauth2.Token, error)
+
+func (f tokenSourceFunc) Token() (*oauth2.Token, error) {
+	return f()
+}
+
+func accessToken(token string) *oauth2.Token {
+	return &oauth2.Token{AccessToken: token, TokenType: "Bearer"}
+}
+
+// EnvTokenSource returns a token source that reads the token from the first
+// of the named environment variables that is set.
+func EnvTokenSource(names ...string) oauth2.TokenSource {
+	return tokenSourceFunc(func() (*oauth2.Token, error) {
+		for _, name := range names {
+			if token := strings.TrimSpace(os.Getenv(name)); token != "" {
+				return accessToken(token), nil
+			}
+		}
+		return nil, fmt.Errorf("environment variable %s not set: %w", strings.Join(names, " or "), ErrNoCredentials)
+	})
+}
+
+// fileWatcher reads a file, reparsing it only when its size or modification
+// time changes.
+type fileWatcher struct {
+	path  string
+	parse func([]byte) (string, error)
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	token   string
+}
+
+func (w *fileWatcher) Token() (*oauth2.Token, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	info, err := os.Stat(w.path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%s does not exist: %w", w.path, ErrNoCredentials)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if w.token == "" || !info.ModTime().Equal(w.modTime) || info.Size() != w.size {
+		data, err := ioutil.ReadFile(w.path)
+		if err != nil {
+			return nil, err
+		}
+		token, err := w.parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", w.path, err)
+		}
+		w.token, w.modTime, w.size = token, info.ModTime(), info.Size()
+	}
+	return accessToken(w.token), nil
+}
+
+// FileTokenSource returns a token source that reads the token from a file,
+// such as a mounted secret. The file is read again whenever it changes, so a
+// rotated token is used without restarting.
+func FileTokenSource(path string) oauth2.TokenSource {
+	return &fileWatcher{path: path, parse: func(data []byte) (string, error) {
+		token := strings.TrimSpace(string(data))
+		if token == "" {
+			return "", fmt.Errorf("file is empty: %w", ErrNoCredentials)
+		}
+		return token, nil
+	}}
+}
+
+// Config is the layout of the config file read by ConfigTokenSource:
+//
+//	current-context: default
+//	contexts:
+//	  default:
+//	    access-token: my-binarylane-api-token
+//	  staging:
+//	    access-token: my-staging-api-token
+type Config struct {
+	CurrentContext string                   `yaml:"current-context"`
+	Contexts       map[string]ConfigContext `yaml:"contexts"`
+}
+
+// ConfigContext holds the credentials for one context in a Config.
+type ConfigContext struct {
+	AccessToken string `yaml:"access-token"`
+}
+
+// ConfigTokenSource returns a token source that reads the token for the named
+// context from a YAML config file. If name is empty, the file's
+// current-context is used, or failing that "default". The file is read again
+// whenever it changes.
+func ConfigTokenSource(path, name string) oauth2.TokenSource {
+	return &fileWatcher{path: path, parse: func(data []byte) (string, error) {
+		var config Config
+		if err := yaml.Unmarshal(data, &config); err != nil {
+			return "", err
+		}
+
+		current := name
+		if current == "" {
+			current = config.CurrentContext
+		}
+		if current == "" {
+			current = "default"
+		}
+
+		c, ok := config.Contexts[current]
+		if !ok {
+			return "", fmt.Errorf("context %q not found: %w", current, ErrNoCredentials)
+		}
+		if c.AccessToken == "" {
+			return "", fmt.Errorf("context %q has no access-token: %w", current, ErrNoCredentials)
+		}
+		return c.AccessToken, nil
+	}}
+}
+
+// commandTokenSource runs a command for the token, caching its output.
+type commandTokenSource struct {
+	name string
+	args []string
+	ttl  time.Duration
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// CommandTokenSource returns a token source that runs a command, such as a
+// password manager CLI, and uses its trimmed standard output as the token.
+// The token is cached for ttl before the command is run again; a ttl of 0
+// caches it for 5 minutes.
+func CommandTokenSource(ttl time.Duration, name string, args ...string) oauth2.TokenSource {
+	if ttl <= 0 {
+		ttl = defaultCommandTokenTTL
+	}
+	return &commandTokenSource{name: name, args: args, ttl: ttl}
+}
+
+func (s *commandTokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Now().Before(s.expires) {
+		return accessToken(s.token), nil
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(s.name, s.args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("token command %s: %w: %s", s.name, err, msg)
+		}
+		return nil, fmt.Errorf("token command %s: %w", s.name, err)
+	}
+
+	token := strings.TrimSpace(string(out))
+	if token == "" {
+		return nil, fmt.Errorf("token command %s printed nothing: %w", s.name, ErrNoCredentials)
+	}
+	s.token, s.expires = token, time.Now().Add(s.ttl)
+	return accessToken(token), nil
+}
+
+// ChainTokenSource returns a token source that tries each of sources in
+// turn, returning the first token found. A source that fails with
+// ErrNoCredentials is skipped; any other error is returned, so that a
+// misconfigured source is reported rather than silently passed over.
+func ChainTokenSource(sources ...oauth2.TokenSource) oauth2.TokenSource {
+	return tokenSourceFunc(func() (*oauth2.Token, error) {
+		var missing []string
+		for _, ts := range sources {
+			token, err := ts.Token()
+			if err == nil {
+				return token, nil
+			}
+			if !errors.Is(err, ErrNoCredentials) {
+				return nil, err
+			}
+			missing = append(missing, strings.TrimSuffix(err.Error(), ": "+ErrNoCredentials.Error()))
+		}
+		if len(missing) == 0 {
+			return nil, ErrNoCredentials
+		}
+		return nil, fmt.Errorf("%w: %s", ErrNoCredentials, strings.Join(missing, "; "))
+	})
+}
@@ -0,0 +1,209 @@
+package binarylane
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "binarylane-credentials")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+func writeFile(t *testing.T, path, content string) {
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func setEnv(t *testing.T, name, value string) {
+	old, ok := os.LookupEnv(name)
+	os.Setenv(name, value)
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(name, old)
+		} else {
+			os.Unsetenv(name)
+		}
+	})
+}
+
+func testToken(t *testing.T, ts oauth2.TokenSource, expected string) {
+	token, err := ts.Token()
+	if err != nil {
+		t.Fatalf("Token returned error: %v", err)
+	}
+	if token.AccessToken != expected {
+		t.Errorf("Token = %q, expected %q", token.AccessToken, expected)
+	}
+}
+
+func TestEnvTokenSource(t *testing.T) {
+	setEnv(t, "BL_TEST_TOKEN_A", "")
+	setEnv(t, "BL_TEST_TOKEN_B", "token-b\n")
+
+	testToken(t, EnvTokenSource("BL_TEST_TOKEN_A", "BL_TEST_TOKEN_B"), "token-b")
+
+	_, err := EnvTokenSource("BL_TEST_TOKEN_A").Token()
+	if !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("Token returned %v, expected ErrNoCredentials", err)
+	}
+}
+
+func TestFileTokenSource_rotation(t *testing.T) {
+	path := filepath.Join(tempDir(t), "token")
+	ts := FileTokenSource(path)
+
+	if _, err := ts.Token(); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("Token of a missing file returned %v, expected ErrNoCredentials", err)
+	}
+
+	writeFile(t, path, "first\n")
+	testToken(t, ts, "first")
+
+	writeFile(t, path, "second-token\n")
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+	testToken(t, ts, "second-token")
+}
+
+func TestConfigTokenSource(t *testing.T) {
+	path := filepath.Join(tempDir(t), "config.yaml")
+	writeFile(t, path, `
+current-context: staging
+contexts:
+  default:
+    access-token: default-token
+  staging:
+    access-token: staging-token
+`)
+
+	testToken(t, ConfigTokenSource(path, ""), "staging-token")
+	testToken(t, ConfigTokenSource(path, "default"), "default-token")
+
+	_, err := ConfigTokenSource(path, "production").Token()
+	if !errors.Is(err, ErrNoCredentials) || !strings.Contains(err.Error(), `"production"`) {
+		t.Errorf("Token of a missing context returned %v", err)
+	}
+}
+
+func TestCommandTokenSource(t *testing.T) {
+	if _, err := os.Stat("/bin/sh"); err != nil {
+		t.Skip("no shell")
+	}
+	counter := filepath.Join(tempDir(t), "count")
+
+	ts := CommandTokenSource(time.Hour, "/bin/sh", "-c", "echo run >> "+counter+"; echo command-token")
+	testToken(t, ts, "command-token")
+	testToken(t, ts, "command-token")
+
+	data, _ := ioutil.ReadFile(counter)
+	if runs := strings.Count(string(data), "run"); runs != 1 {
+		t.Errorf("Command ran %d times, expected 1", runs)
+	}
+
+	_, err := CommandTokenSource(0, "/bin/sh", "-c", "echo denied >&2; exit 1").Token()
+	if err == nil || errors.Is(err, ErrNoCredentials) || !strings.Contains(err.Error(), "denied") {
+		t.Errorf("Token of a failing command returned %v", err)
+	}
+}
+
+func TestChainTokenSource(t *testing.T) {
+	missing := tokenSourceFunc(func() (*oauth2.Token, error) {
+		return nil, fmt.Errorf("nothing here: %w", ErrNoCredentials)
+	})
+	broken := tokenSourceFunc(func() (*oauth2.Token, error) {
+		return nil, errors.New("broken")
+	})
+	found := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "found"})
+
+	testToken(t, ChainTokenSource(missing, found, broken), "found")
+
+	if _, err := ChainTokenSource(missing, broken, found).Token(); err == nil || err.Error() != "broken" {
+		t.Errorf("Token returned %v, expected the broken source's error", err)
+	}
+
+	_, err := ChainTokenSource(missing, missing).Token()
+	if !errors.Is(err, ErrNoCredentials) || !strings.Contains(err.Error(), "nothing here") {
+		t.Errorf("Token returned %v", err)
+	}
+}
+
+func TestNewFromEnvironment(t *testing.T) {
+	setup()
+	defer teardown()
+
+	dir := tempDir(t)
+	writeFile(t, filepath.Join(dir, "config.yaml"), "contexts:\n  default:\n    access-token: config-token\n")
+	setEnv(t, TokenEnvVar, "")
+	setEnv(t, TokenFileEnvVar, "")
+	setEnv(t, TokenCommandEnvVar, "")
+	setEnv(t, ContextEnvVar, "")
+	setEnv(t, ConfigEnvVar, filepath.Join(dir, "config.yaml"))
+
+	var auth string
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		fmt.Fprint(w, `{"account":{}}`)
+	})
+
+	c, err := NewFromEnvironment(SetBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewFromEnvironment returned error: %v", err)
+	}
+	if _, _, err := c.Account.Get(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if auth != "Bearer config-token" {
+		t.Errorf("Authorization = %q, expected the config file token", auth)
+	}
+
+	setEnv(t, TokenEnvVar, "env-token")
+	if _, _, err := c.Account.Get(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if auth != "Bearer env-token" {
+		t.Errorf("Authorization = %q, expected the environment token", auth)
+	}
+
+	setEnv(t, TokenEnvVar, "")
+	setEnv(t, ConfigEnvVar, filepath.Join(dir, "missing.yaml"))
+	if _, err := NewFromEnvironment(); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("NewFromEnvironment returned %v, expected ErrNoCredentials", err)
+	}
+}
+
+func TestNewFromEnvironment_noConfigDir(t *testing.T) {
+	setEnv(t, TokenEnvVar, "env-token")
+	setEnv(t, TokenFileEnvVar, "")
+	setEnv(t, TokenCommandEnvVar, "")
+	setEnv(t, ConfigEnvVar, "")
+	setEnv(t, "XDG_CONFIG_HOME", "")
+	setEnv(t, "HOME", "")
+
+	ts, err := EnvironmentTokenSource()
+	if err != nil {
+		t.Fatalf("EnvironmentTokenSource returned error: %v", err)
+	}
+	testToken(t, ts, "env-token")
+
+	setEnv(t, TokenEnvVar, "")
+	if _, err := NewFromEnvironment(); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("NewFromEnvironment returned %v, expected ErrNoCredentials", err)
+	}
+}
@@ -13,11 +13,13 @@ const domainsBasePath = "v2/domains"
 // https://api.binarylane.com.au/reference#domain-records
 type DomainsService interface {
 	List(context.Context, *ListOptions) ([]Domain, *Response, error)
+	ListAll(context.Context) ([]Domain, error)
 	Get(context.Context, string) (*Domain, *Response, error)
 	Create(context.Context, *DomainCreateRequest) (*Domain, *Response, error)
 	Delete(context.Context, string) (*Response, error)
 
 	Records(context.Context, string, *ListOptions) ([]DomainRecord, *Response, error)
+	RecordsAll(context.Context, string) ([]DomainRecord, error)
 	RecordsByType(context.Context, string, string, *ListOptions) ([]DomainRecord, *Response, error)
 	RecordsByName(context.Context, string, string, *ListOptions) ([]DomainRecord, *Response, error)
 	RecordsByTypeAndName(context.Context, string, string, string, *ListOptions) ([]DomainRecord, *Response, error)
@@ -25,6 +27,8 @@ type DomainsService interface {
 	DeleteRecord(context.Context, string, int) (*Response, error)
 	EditRecord(context.Context, string, int, *DomainRecordEditRequest) (*DomainRecord, *Response, error)
 	CreateRecord(context.Context, string, *DomainRecordEditRequest) (*DomainRecord, *Response, error)
+	PlanRecords(context.Context, string, []DomainRecordEditRequest) (*DomainRecordsPlan, error)
+	SyncRecords(context.Context, string, []DomainRecordEditRequest) (*DomainRecordsPlan, error)
 }
 
 // DomainsServiceOp handles communication with the domain related methods of the
@@ -108,6 +112,7 @@ func (d Domain) URN() string {
 
 // List all domains.
 func (s DomainsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Domain, *Response, error) {
+	ctx = withOperation(ctx, "Domains.List")
 	path := domainsBasePath
 	path, err := addOptions(path, opt)
 	if err != nil {
@@ -134,8 +139,24 @@ func (s DomainsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Domain,
 	return root.Domains, resp, err
 }
 
+// ListAll lists all domains, fetching every page of results.
+func (s *DomainsServiceOp) ListAll(ctx context.Context) ([]Domain, error) {
+	var list []Domain
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := s.List(ctx, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
 // Get individual domain. It requires a non-empty domain name.
 func (s *DomainsServiceOp) Get(ctx context.Context, name string) (*Domain, *Response, error) {
+	ctx = withOperation(ctx, "Domains.Get")
 	if len(name) < 1 {
 		return nil, nil, NewArgError("name", "cannot be an empty string")
 	}
@@ -158,6 +179,7 @@ func (s *DomainsServiceOp) Get(ctx context.Context, name string) (*Domain, *Resp
 
 // Create a new domain
 func (s *DomainsServiceOp) Create(ctx context.Context, createRequest *DomainCreateRequest) (*Domain, *Response, error) {
+	ctx = withOperation(ctx, "Domains.Create")
 	if createRequest == nil {
 		return nil, nil, NewArgError("createRequest", "cannot be nil")
 	}
@@ -179,6 +201,7 @@ func (s *DomainsServiceOp) Create(ctx context.Context, createRequest *DomainCrea
 
 // Delete domain
 func (s *DomainsServiceOp) Delete(ctx context.Context, name string) (*Response, error) {
+	ctx = withOperation(ctx, "Domains.Delete")
 	if len(name) < 1 {
 		return nil, NewArgError("name", "cannot be an empty string")
 	}
@@ -207,6 +230,7 @@ func (d DomainRecordEditRequest) String() string {
 
 // Records returns a slice of DomainRecord for a domain.
 func (s *DomainsServiceOp) Records(ctx context.Context, domain string, opt *ListOptions) ([]DomainRecord, *Response, error) {
+	ctx = withOperation(ctx, "Domains.Records")
 	if len(domain) < 1 {
 		return nil, nil, NewArgError("domain", "cannot be an empty string")
 	}
@@ -220,8 +244,25 @@ func (s *DomainsServiceOp) Records(ctx context.Context, domain string, opt *List
 	return s.records(ctx, path)
 }
 
+// RecordsAll returns all the records of a domain, fetching every page of
+// results.
+func (s *DomainsServiceOp) RecordsAll(ctx context.Context, domain string) ([]DomainRecord, error) {
+	var list []DomainRecord
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := s.Records(ctx, domain, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
 // RecordsByType returns a slice of DomainRecord for a domain matched by record type.
 func (s *DomainsServiceOp) RecordsByType(ctx context.Context, domain, ofType string, opt *ListOptions) ([]DomainRecord, *Response, error) {
+	ctx = withOperation(ctx, "Domains.RecordsByType")
 	if len(domain) < 1 {
 		return nil, nil, NewArgError("domain", "cannot be an empty string")
 	}
@@ -241,6 +282,7 @@ func (s *DomainsServiceOp) RecordsByType(ctx context.Context, domain, ofType str
 
 // RecordsByName returns a slice of DomainRecord for a domain matched by record name.
 func (s *DomainsServiceOp) RecordsByName(ctx context.Context, domain, name string, opt *ListOptions) ([]DomainRecord, *Response, error) {
+	ctx = withOperation(ctx, "Domains.RecordsByName")
 	if len(domain) < 1 {
 		return nil, nil, NewArgError("domain", "cannot be an empty string")
 	}
@@ -260,6 +302,7 @@ func (s *DomainsServiceOp) RecordsByName(ctx context.Context, domain, name strin
 
 // RecordsByTypeAndName returns a slice of DomainRecord for a domain matched by record type and name.
 func (s *DomainsServiceOp) RecordsByTypeAndName(ctx context.Context, domain, ofType, name string, opt *ListOptions) ([]DomainRecord, *Response, error) {
+	ctx = withOperation(ctx, "Domains.RecordsByTypeAndName")
 	if len(domain) < 1 {
 		return nil, nil, NewArgError("domain", "cannot be an empty string")
 	}
@@ -283,6 +326,7 @@ func (s *DomainsServiceOp) RecordsByTypeAndName(ctx context.Context, domain, ofT
 
 // Record returns the record id from a domain
 func (s *DomainsServiceOp) Record(ctx context.Context, domain string, id int) (*DomainRecord, *Response, error) {
+	ctx = withOperation(ctx, "Domains.Record")
 	if len(domain) < 1 {
 		return nil, nil, NewArgError("domain", "cannot be an empty string")
 	}
@@ -309,6 +353,7 @@ func (s *DomainsServiceOp) Record(ctx context.Context, domain string, id int) (*
 
 // DeleteRecord deletes a record from a domain identified by id
 func (s *DomainsServiceOp) DeleteRecord(ctx context.Context, domain string, id int) (*Response, error) {
+	ctx = withOperation(ctx, "Domains.DeleteRecord")
 	if len(domain) < 1 {
 		return nil, NewArgError("domain", "cannot be an empty string")
 	}
@@ -335,6 +380,7 @@ func (s *DomainsServiceOp) EditRecord(ctx context.Context,
 	id int,
 	editRequest *DomainRecordEditRequest,
 ) (*DomainRecord, *Response, error) {
+	ctx = withOperation(ctx, "Domains.EditRecord")
 	if len(domain) < 1 {
 		return nil, nil, NewArgError("domain", "cannot be an empty string")
 	}
@@ -347,6 +393,12 @@ func (s *DomainsServiceOp) EditRecord(ctx context.Context,
 		return nil, nil, NewArgError("editRequest", "cannot be nil")
 	}
 
+	if s.client.validateRecords {
+		if err := editRequest.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	path := fmt.Sprintf("%s/%s/records/%d", domainsBasePath, domain, id)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, path, editRequest)
@@ -367,6 +419,7 @@ func (s *DomainsServiceOp) EditRecord(ctx context.Context,
 func (s *DomainsServiceOp) CreateRecord(ctx context.Context,
 	domain string,
 	createRequest *DomainRecordEditRequest) (*DomainRecord, *Response, error) {
+	ctx = withOperation(ctx, "Domains.CreateRecord")
 	if len(domain) < 1 {
 		return nil, nil, NewArgError("domain", "cannot be empty string")
 	}
@@ -375,6 +428,12 @@ func (s *DomainsServiceOp) CreateRecord(ctx context.Context,
 		return nil, nil, NewArgError("createRequest", "cannot be nil")
 	}
 
+	if s.client.validateRecords {
+		if err := createRequest.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	path := fmt.Sprintf("%s/%s/records", domainsBasePath, domain)
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, createRequest)
 
@@ -0,0 +1,228 @@
+package binarylane
+
+import (
+	"context"
+	"net"
+	"strings"
+)
+
+// DomainRecordsPlan describes the changes needed to bring the records of a
+// domain in line with a desired record set.
+type DomainRecordsPlan struct {
+	Domain string                    `json:"domain"`
+	Create []DomainRecordEditRequest `json:"create,omitempty"`
+	Edit   []DomainRecordEdit        `json:"edit,omitempty"`
+	Delete []DomainRecord            `json:"delete,omitempty"`
+}
+
+// DomainRecordEdit is a change to an existing record in a DomainRecordsPlan.
+type DomainRecordEdit struct {
+	Record  DomainRecord            `json:"record"`
+	Request DomainRecordEditRequest `json:"request"`
+}
+
+// String creates a human-readable description of a DomainRecordsPlan.
+func (p DomainRecordsPlan) String() string {
+	return Stringify(p)
+}
+
+// Empty reports whether the plan makes no changes.
+func (p *DomainRecordsPlan) Empty() bool {
+	return len(p.Create) == 0 && len(p.Edit) == 0 && len(p.Delete) == 0
+}
+
+// DiffDomainRecords computes the plan that makes the live records of domain
+// match desired.
+//
+// Records are matched on their type, name and data, or for CAA records also
+// their tag, so that a record whose TTL, MX or SRV priority, SRV weight or
+// port, or CAA flags differ is edited in place. Remaining records of the same
+// type and name are then paired up and edited to change their data, and any
+// left over are created or deleted. A desired TTL of 0 matches any TTL.
+//
+// SOA records are never changed, and nor are NS records for the domain itself
+// unless desired includes some, as they are normally managed by BinaryLane.
+func DiffDomainRecords(domain string, live []DomainRecord, desired []DomainRecordEditRequest) *DomainRecordsPlan {
+	plan := &DomainRecordsPlan{Domain: domain}
+	origin := fqdn(domain)
+
+	manageApexNS := false
+	for _, r := range desired {
+		if strings.EqualFold(r.Type, "NS") && recordName(r.Name) == "@" {
+			manageApexNS = true
+		}
+	}
+
+	var unmatched []DomainRecord
+	for _, r := range live {
+		recordType := strings.ToUpper(r.Type)
+		if recordType == "SOA" || (recordType == "NS" && recordName(r.Name) == "@" && !manageApexNS) {
+			continue
+		}
+		unmatched = append(unmatched, r)
+	}
+
+	// First match desired records to live records with the same identity.
+	var pending []DomainRecordEditRequest
+	for _, want := range desired {
+		if strings.EqualFold(want.Type, "SOA") {
+			continue
+		}
+
+		// Prefer a record that needs no changes, in case several share the
+		// same identity.
+		key := desiredRecordKey(want, origin)
+		found := -1
+		for i, have := range unmatched {
+			if liveRecordKey(have, origin) != key {
+				continue
+			}
+			if found < 0 {
+				found = i
+			}
+			if recordAttributesMatch(have, want) {
+				found = i
+				break
+			}
+		}
+		if found < 0 {
+			pending = append(pending, want)
+			continue
+		}
+
+		have := unmatched[found]
+		unmatched = append(unmatched[:found], unmatched[found+1:]...)
+		if !recordAttributesMatch(have, want) {
+			plan.Edit = append(plan.Edit, DomainRecordEdit{Record: have, Request: want})
+		}
+	}
+
+	// Then pair the rest up by type and name, editing their data in place.
+	for _, want := range pending {
+		found := -1
+		for i, have := range unmatched {
+			if strings.EqualFold(have.Type, want.Type) && recordName(have.Name) == recordName(want.Name) {
+				found = i
+				break
+			}
+		}
+		if found < 0 {
+			plan.Create = append(plan.Create, want)
+			continue
+		}
+
+		plan.Edit = append(plan.Edit, DomainRecordEdit{Record: unmatched[found], Request: want})
+		unmatched = append(unmatched[:found], unmatched[found+1:]...)
+	}
+
+	plan.Delete = unmatched
+	return plan
+}
+
+// PlanRecords fetches the records of domain and returns the changes that
+// SyncRecords would make to them, without applying them.
+func (s *DomainsServiceOp) PlanRecords(ctx context.Context, domain string, desired []DomainRecordEditRequest) (*DomainRecordsPlan, error) {
+	if len(domain) < 1 {
+		return nil, NewArgError("domain", "cannot be an empty string")
+	}
+
+	live, err := s.RecordsAll(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffDomainRecords(domain, live, desired), nil
+}
+
+// SyncRecords brings the records of domain in line with desired, as described
+// by DiffDomainRecords, and returns the plan that was applied. Records are
+// created first, then edited, then deleted, so that names keep resolving
+// while the records change.
+//
+// If a call fails, SyncRecords returns the error without undoing the changes
+// already made. Running it again completes the remaining changes.
+func (s *DomainsServiceOp) SyncRecords(ctx context.Context, domain string, desired []DomainRecordEditRequest) (*DomainRecordsPlan, error) {
+	plan, err := s.PlanRecords(ctx, domain, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range plan.Create {
+		if _, _, err := s.CreateRecord(ctx, domain, &plan.Create[i]); err != nil {
+			return plan, err
+		}
+	}
+	for i := range plan.Edit {
+		edit := &plan.Edit[i]
+		if _, _, err := s.EditRecord(ctx, domain, edit.Record.ID, &edit.Request); err != nil {
+			return plan, err
+		}
+	}
+	for _, r := range plan.Delete {
+		if _, err := s.DeleteRecord(ctx, domain, r.ID); err != nil {
+			return plan, err
+		}
+	}
+
+	return plan, nil
+}
+
+// recordName returns the canonical form of a record name relative to its
+// domain.
+func recordName(name string) string {
+	if name == "" {
+		return "@"
+	}
+	return strings.ToLower(name)
+}
+
+// recordData returns the canonical form of record data, so that equivalent
+// addresses and host names compare equal.
+func recordData(recordType, data, origin string) string {
+	switch strings.ToUpper(recordType) {
+	case "A", "AAAA":
+		if ip := net.ParseIP(data); ip != nil {
+			return ip.String()
+		}
+	case "CNAME", "MX", "NS", "PTR", "SRV":
+		if data == "@" {
+			return origin
+		}
+		return fqdn(data)
+	}
+	return data
+}
+
+func liveRecordKey(r DomainRecord, origin string) string {
+	return recordKey(r.Type, r.Name, r.Data, r.Tag, origin)
+}
+
+func desiredRecordKey(r DomainRecordEditRequest, origin string) string {
+	return recordKey(r.Type, r.Name, r.Data, r.Tag, origin)
+}
+
+func recordKey(recordType, name, data, tag, origin string) string {
+	key := []string{strings.ToUpper(recordType), recordName(name), recordData(recordType, data, origin)}
+	if strings.EqualFold(recordType, "CAA") {
+		key = append(key, strings.ToLower(tag))
+	}
+	return strings.Join(key, " ")
+}
+
+// recordAttributesMatch reports whether the attributes of a record other than
+// its identity match the desired record.
+func recordAttributesMatch(have DomainRecord, want DomainRecordEditRequest) bool {
+	if want.TTL != 0 && have.TTL != want.TTL {
+		return false
+	}
+
+	switch strings.ToUpper(want.Type) {
+	case "MX":
+		return have.Priority == want.Priority
+	case "SRV":
+		return have.Priority == want.Priority && have.Weight == want.Weight && have.Port == want.Port
+	case "CAA":
+		return have.Flags == want.Flags
+	}
+	return true
+}
@@ -0,0 +1,109 @@
+package binarylane
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var liveDomainRecords = []DomainRecord{
+	{ID: 1, Type: "SOA", Name: "@", Data: "1800", TTL: 1800},
+	{ID: 2, Type: "NS", Name: "@", Data: "ns1.binarylane.com.au", TTL: 1800},
+	{ID: 3, Type: "A", Name: "@", Data: "192.0.2.1", TTL: 3600},
+	{ID: 4, Type: "A", Name: "www", Data: "192.0.2.2", TTL: 3600},
+	{ID: 5, Type: "MX", Name: "@", Data: "mx1.example.com", Priority: 10, TTL: 3600},
+	{ID: 6, Type: "SRV", Name: "_sip._tcp", Data: "sip.example.com", Priority: 10, Weight: 60, Port: 5060, TTL: 3600},
+	{ID: 7, Type: "CAA", Name: "@", Data: "letsencrypt.org", Tag: "issue", TTL: 3600},
+	{ID: 8, Type: "TXT", Name: "old", Data: "stale", TTL: 3600},
+}
+
+func TestDomains_DiffDomainRecords(t *testing.T) {
+	desired := []DomainRecordEditRequest{
+		{Type: "A", Name: "@", Data: "192.0.2.1", TTL: 3600},
+		{Type: "A", Name: "www", Data: "192.0.2.3", TTL: 3600},
+		{Type: "MX", Name: "@", Data: "mx1.example.com.", Priority: 20, TTL: 3600},
+		{Type: "SRV", Name: "_sip._tcp", Data: "sip.example.com.", Priority: 10, Weight: 60, Port: 5061, TTL: 3600},
+		{Type: "CAA", Name: "@", Data: "letsencrypt.org", Flags: 128, Tag: "issue", TTL: 3600},
+		{Type: "CAA", Name: "@", Data: "letsencrypt.org", Tag: "issuewild", TTL: 3600},
+	}
+
+	plan := DiffDomainRecords("example.com", liveDomainRecords, desired)
+
+	expected := &DomainRecordsPlan{
+		Domain: "example.com",
+		Create: []DomainRecordEditRequest{desired[5]},
+		Edit: []DomainRecordEdit{
+			{Record: liveDomainRecords[4], Request: desired[2]},
+			{Record: liveDomainRecords[5], Request: desired[3]},
+			{Record: liveDomainRecords[6], Request: desired[4]},
+			{Record: liveDomainRecords[3], Request: desired[1]},
+		},
+		Delete: []DomainRecord{liveDomainRecords[7]},
+	}
+	if !reflect.DeepEqual(plan, expected) {
+		t.Errorf("DiffDomainRecords returned %+v, expected %+v", plan, expected)
+	}
+}
+
+func TestDomains_DiffDomainRecordsApexNS(t *testing.T) {
+	desired := []DomainRecordEditRequest{
+		{Type: "NS", Name: "@", Data: "ns1.example.net."},
+	}
+
+	plan := DiffDomainRecords("example.com", liveDomainRecords[:2], desired)
+	if len(plan.Edit) != 1 || plan.Edit[0].Record.ID != 2 {
+		t.Errorf("DiffDomainRecords returned %+v, expected the apex NS record to be edited", plan)
+	}
+}
+
+func TestDomains_SyncRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	mux.HandleFunc("/v2/domains/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			json.NewEncoder(w).Encode(&domainRecordsRoot{DomainRecords: liveDomainRecords})
+			return
+		}
+		testMethod(t, r, http.MethodPost)
+		calls = append(calls, "POST")
+		fmt.Fprint(w, `{"domain_record":{"id":9}}`)
+	})
+	mux.HandleFunc("/v2/domains/example.com/records/", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"domain_record":{"id":4}}`)
+	})
+
+	desired := []DomainRecordEditRequest{
+		{Type: "A", Name: "@", Data: "192.0.2.1"},
+		{Type: "A", Name: "www", Data: "192.0.2.3"},
+		{Type: "MX", Name: "@", Data: "mx1.example.com.", Priority: 10},
+		{Type: "SRV", Name: "_sip._tcp", Data: "sip.example.com.", Priority: 10, Weight: 60, Port: 5060},
+		{Type: "CAA", Name: "@", Data: "letsencrypt.org", Tag: "issue"},
+		{Type: "TXT", Name: "new", Data: "fresh"},
+	}
+
+	plan, err := client.Domains.SyncRecords(ctx, "example.com", desired)
+	if err != nil {
+		t.Fatalf("Domains.SyncRecords returned error: %v", err)
+	}
+	if plan.Empty() {
+		t.Error("Domains.SyncRecords returned an empty plan")
+	}
+
+	expected := []string{
+		"POST",
+		"PUT /v2/domains/example.com/records/4",
+		"DELETE /v2/domains/example.com/records/8",
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Domains.SyncRecords made calls %q, expected %q", calls, expected)
+	}
+}
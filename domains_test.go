@@ -189,6 +189,30 @@ func TestDomains_AllRecordsForDomainName(t *testing.T) {
 	}
 }
 
+func TestDomains_RecordsAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/domains/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"domain_records":[{"id":3}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"domain_records":[{"id":1},{"id":2}], "links":{"pages":{"next":"http://example.com/v2/domains/example.com/records?page=2"}}}`)
+	})
+
+	records, err := client.Domains.RecordsAll(ctx, "example.com")
+	if err != nil {
+		t.Errorf("Domains.RecordsAll returned error: %v", err)
+	}
+
+	expected := []DomainRecord{{ID: 1}, {ID: 2}, {ID: 3}}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("Domains.RecordsAll returned %+v, expected %+v", records, expected)
+	}
+}
+
 func TestDomains_AllRecordsForDomainName_PerPage(t *testing.T) {
 	setup()
 	defer teardown()
@@ -0,0 +1,176 @@
+package binarylane
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+const (
+	// minRecordTTL and maxRecordTTL bound the TTL of a domain record. A TTL of
+	// 0 uses the domain's default.
+	minRecordTTL = 30
+	maxRecordTTL = 1<<31 - 1
+
+	// maxTXTData is the longest TXT record data that fits in a record once it
+	// has been split into 255 byte strings, each with a length prefix.
+	maxTXTData = 65535 - 65535/(maxTXTString+1)
+
+	maxHostnameLength = 253
+	maxLabelLength    = 63
+)
+
+// WithRecordValidation is a client option that makes
+// DomainsService.CreateRecord and EditRecord, and so SyncRecords, validate
+// each request with DomainRecordEditRequest.Validate before sending it.
+func WithRecordValidation() ClientOpt {
+	return func(c *Client) error {
+		c.validateRecords = true
+		return nil
+	}
+}
+
+// Validate checks the request against the rules for its record type,
+// returning ArgErrors that lists every problem found, or nil if there are
+// none. Host names in Data may be fully qualified with a trailing dot, or
+// "@" for the domain itself.
+func (r *DomainRecordEditRequest) Validate() error {
+	var errs ArgErrors
+	invalid := func(arg, format string, args ...interface{}) {
+		errs = append(errs, NewArgError(arg, fmt.Sprintf(format, args...)))
+	}
+
+	if r.Name == "" {
+		invalid("Name", "cannot be empty")
+	} else if r.Name != "@" && !validHostname(r.Name, true) {
+		invalid("Name", "%q is not a valid record name", r.Name)
+	}
+
+	if r.TTL != 0 && (r.TTL < minRecordTTL || r.TTL > maxRecordTTL) {
+		invalid("TTL", "must be between %d and %d seconds", minRecordTTL, maxRecordTTL)
+	}
+
+	switch strings.ToUpper(r.Type) {
+	case "":
+		invalid("Type", "cannot be empty")
+	case "A":
+		if ip := net.ParseIP(r.Data); ip == nil || ip.To4() == nil || strings.Contains(r.Data, ":") {
+			invalid("Data", "%q is not an IPv4 address", r.Data)
+		}
+	case "AAAA":
+		if ip := net.ParseIP(r.Data); ip == nil || !strings.Contains(r.Data, ":") {
+			invalid("Data", "%q is not an IPv6 address", r.Data)
+		}
+	case "CNAME", "NS", "PTR":
+		if !validTarget(r.Data) {
+			invalid("Data", "%q is not a valid host name", r.Data)
+		}
+	case "MX":
+		if !validTarget(r.Data) {
+			invalid("Data", "%q is not a valid host name", r.Data)
+		}
+		if !validUint16(r.Priority) {
+			invalid("Priority", "must be between 0 and 65535")
+		}
+	case "SRV":
+		if r.Name != "" && !validSRVName(r.Name) {
+			invalid("Name", "%q must start with _service._protocol", r.Name)
+		}
+		// A target of "." means the service is not available.
+		if r.Data != "." && !validTarget(r.Data) {
+			invalid("Data", "%q is not a valid host name", r.Data)
+		}
+		if !validUint16(r.Priority) {
+			invalid("Priority", "must be between 0 and 65535")
+		}
+		if !validUint16(r.Weight) {
+			invalid("Weight", "must be between 0 and 65535")
+		}
+		if !validUint16(r.Port) {
+			invalid("Port", "must be between 0 and 65535")
+		}
+	case "TXT":
+		if r.Data == "" {
+			invalid("Data", "cannot be empty")
+		} else if len(r.Data) > maxTXTData {
+			invalid("Data", "cannot be longer than %d bytes", maxTXTData)
+		}
+	case "CAA":
+		if r.Flags != 0 && r.Flags != 128 {
+			invalid("Flags", "must be 0, or 128 for a critical record")
+		}
+		switch r.Tag {
+		case "issue", "issuewild":
+		case "iodef":
+			if u, err := url.Parse(r.Data); err != nil || (u.Scheme != "mailto" && u.Scheme != "http" && u.Scheme != "https") {
+				invalid("Data", "%q must be a mailto, http or https URL for an iodef record", r.Data)
+			}
+		default:
+			invalid("Tag", "must be issue, issuewild or iodef")
+		}
+		if r.Data == "" {
+			invalid("Data", "cannot be empty")
+		}
+	default:
+		invalid("Type", "%q is not a supported record type", r.Type)
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// validTarget reports whether data is a valid host name for a record to
+// point at.
+func validTarget(data string) bool {
+	return data == "@" || validHostname(data, false)
+}
+
+// validHostname reports whether name is a valid DNS name, optionally
+// relative, with or without a trailing dot. Record names may also contain
+// underscores and start with a "*" wildcard label.
+func validHostname(name string, recordName bool) bool {
+	name = strings.TrimSuffix(name, ".")
+	if name == "" || len(name) > maxHostnameLength {
+		return false
+	}
+
+	for i, label := range strings.Split(name, ".") {
+		if recordName && i == 0 && label == "*" {
+			continue
+		}
+		if label == "" || len(label) > maxLabelLength || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, c := range label {
+			switch {
+			case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-':
+			case c == '_' && recordName:
+			default:
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// validSRVName reports whether name starts with the _service._protocol labels
+// required by RFC 2782.
+func validSRVName(name string) bool {
+	labels := strings.Split(name, ".")
+	if len(labels) < 2 || len(labels[0]) < 2 || labels[0][0] != '_' {
+		return false
+	}
+
+	switch strings.ToLower(labels[1]) {
+	case "_tcp", "_udp", "_tls", "_sctp":
+		return true
+	}
+	return false
+}
+
+func validUint16(n int) bool {
+	return n >= 0 && n <= 65535
+}
@@ -0,0 +1,101 @@
+package binarylane
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDomainRecordEditRequest_Validate(t *testing.T) {
+	valid := []DomainRecordEditRequest{
+		{Type: "A", Name: "@", Data: "192.0.2.1"},
+		{Type: "AAAA", Name: "www", Data: "2001:db8::1", TTL: 3600},
+		{Type: "CNAME", Name: "*.apps", Data: "@"},
+		{Type: "NS", Name: "sub", Data: "ns1.example.net."},
+		{Type: "MX", Name: "@", Data: "mx1.example.com", Priority: 10},
+		{Type: "SRV", Name: "_sip._tcp", Data: "sip.example.com.", Priority: 10, Weight: 60, Port: 5060},
+		{Type: "SRV", Name: "_imap._tcp.mail", Data: "."},
+		{Type: "TXT", Name: "@", Data: strings.Repeat("a", 1000)},
+		{Type: "CAA", Name: "@", Data: "letsencrypt.org", Tag: "issue"},
+		{Type: "CAA", Name: "@", Data: "mailto:security@example.com", Flags: 128, Tag: "iodef"},
+	}
+	for _, r := range valid {
+		if err := r.Validate(); err != nil {
+			t.Errorf("Validate(%v) returned %v", r, err)
+		}
+	}
+
+	invalid := map[string]DomainRecordEditRequest{
+		"A with IPv6":       {Type: "A", Name: "@", Data: "2001:db8::1"},
+		"AAAA with IPv4":    {Type: "AAAA", Name: "@", Data: "192.0.2.1"},
+		"CNAME to address":  {Type: "CNAME", Name: "www", Data: "http://example.com"},
+		"MX priority":       {Type: "MX", Name: "@", Data: "mx1.example.com", Priority: 70000},
+		"SRV name":          {Type: "SRV", Name: "sip", Data: "sip.example.com", Port: 5060},
+		"SRV port":          {Type: "SRV", Name: "_sip._tcp", Data: "sip.example.com", Port: -1},
+		"TXT empty":         {Type: "TXT", Name: "@"},
+		"TXT too long":      {Type: "TXT", Name: "@", Data: strings.Repeat("a", maxTXTData+1)},
+		"CAA flags":         {Type: "CAA", Name: "@", Data: "letsencrypt.org", Tag: "issue", Flags: 1},
+		"CAA tag":           {Type: "CAA", Name: "@", Data: "letsencrypt.org", Tag: "other"},
+		"CAA iodef":         {Type: "CAA", Name: "@", Data: "security@example.com", Tag: "iodef"},
+		"TTL":               {Type: "A", Name: "@", Data: "192.0.2.1", TTL: 1},
+		"name":              {Type: "A", Name: "bad name", Data: "192.0.2.1"},
+		"unsupported type":  {Type: "HINFO", Name: "@", Data: "cpu os"},
+		"missing type":      {Name: "@", Data: "192.0.2.1"},
+		"missing name":      {Type: "A", Data: "192.0.2.1"},
+		"label too long":    {Type: "A", Name: strings.Repeat("a", 64), Data: "192.0.2.1"},
+		"hyphenated target": {Type: "CNAME", Name: "www", Data: "-example.com"},
+	}
+	for name, r := range invalid {
+		err := r.Validate()
+		if _, ok := err.(ArgErrors); !ok {
+			t.Errorf("%s: Validate(%v) returned %#v, expected ArgErrors", name, r, err)
+		}
+	}
+}
+
+func TestDomainRecordEditRequest_ValidateListsEveryProblem(t *testing.T) {
+	r := &DomainRecordEditRequest{Type: "SRV", Name: "sip", Data: "", Priority: -1, Weight: 65536, Port: 70000, TTL: 5}
+
+	err := r.Validate()
+	errs, ok := err.(ArgErrors)
+	if !ok {
+		t.Fatalf("Validate returned %#v, expected ArgErrors", err)
+	}
+
+	var args []string
+	for _, e := range errs {
+		args = append(args, e.arg)
+	}
+	expected := []string{"TTL", "Name", "Data", "Priority", "Weight", "Port"}
+	if !reflect.DeepEqual(args, expected) {
+		t.Errorf("Validate reported problems with %v, expected %v", args, expected)
+	}
+	if !strings.Contains(err.Error(), "; Port is invalid because") {
+		t.Errorf("ArgErrors.Error() = %q", err.Error())
+	}
+}
+
+func TestDomains_CreateRecordWithValidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := WithRecordValidation()(client); err != nil {
+		t.Fatalf("WithRecordValidation(): %v", err)
+	}
+
+	mux.HandleFunc("/v2/domains/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Invalid record was sent to the API")
+	})
+	mux.HandleFunc("/v2/domains/example.com/records/1", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Invalid record was sent to the API")
+	})
+
+	bad := &DomainRecordEditRequest{Type: "A", Name: "@", Data: "not an address"}
+	if _, _, err := client.Domains.CreateRecord(ctx, "example.com", bad); err == nil {
+		t.Error("Domains.CreateRecord: expected validation error")
+	}
+	if _, _, err := client.Domains.EditRecord(ctx, "example.com", 1, bad); err == nil {
+		t.Error("Domains.EditRecord: expected validation error")
+	}
+}
@@ -0,0 +1,422 @@
+package binarylane
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// maxTXTString is the longest character-string allowed in a TXT record.
+// Longer values are split into several strings.
+const maxTXTString = 255
+
+// zoneToken is a single field of a zone file entry.
+type zoneToken struct {
+	text   string
+	quoted bool
+}
+
+// zoneEntry is a logical line of a zone file, which may span several
+// physical lines when parentheses are used.
+type zoneEntry struct {
+	line int
+	// blankOwner is true when the entry starts with whitespace, meaning it
+	// belongs to the previous owner name.
+	blankOwner bool
+	tokens     []zoneToken
+}
+
+// ParseZoneFile parses an RFC 1035 zone file for the domain origin into
+// record requests that can be passed to DomainsService.CreateRecord or
+// SyncRecords.
+//
+// Record names are returned relative to origin, with "@" for the origin
+// itself, and host names in record data are returned fully qualified with a
+// trailing dot. The $ORIGIN and $TTL directives are supported; SOA records
+// are skipped as they are managed by BinaryLane. A, AAAA, CAA, CNAME, MX, NS,
+// PTR, SRV and TXT records are supported.
+func ParseZoneFile(r io.Reader, origin string) ([]DomainRecordEditRequest, error) {
+	if origin == "" {
+		return nil, NewArgError("origin", "cannot be an empty string")
+	}
+
+	entries, err := scanZone(r)
+	if err != nil {
+		return nil, err
+	}
+
+	origin = fqdn(origin)
+	zoneOrigin := origin
+	var records []DomainRecordEditRequest
+	var owner string
+	defaultTTL, lastTTL := 0, 0
+
+	for _, e := range entries {
+		tokens := e.tokens
+		if !e.blankOwner && !tokens[0].quoted && strings.HasPrefix(tokens[0].text, "$") {
+			if len(tokens) < 2 {
+				return nil, zoneError(e.line, "%s requires an argument", tokens[0].text)
+			}
+			switch strings.ToUpper(tokens[0].text) {
+			case "$ORIGIN":
+				origin = absoluteName(tokens[1].text, origin)
+			case "$TTL":
+				ttl, ok := parseZoneTTL(tokens[1].text)
+				if !ok {
+					return nil, zoneError(e.line, "invalid TTL %q", tokens[1].text)
+				}
+				defaultTTL = ttl
+			default:
+				return nil, zoneError(e.line, "unsupported directive %s", tokens[0].text)
+			}
+			continue
+		}
+
+		if !e.blankOwner {
+			owner = absoluteName(tokens[0].text, origin)
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, zoneError(e.line, "record has no owner name")
+		}
+
+		ttl := 0
+		for len(tokens) > 0 && !tokens[0].quoted {
+			if isZoneClass(tokens[0].text) {
+				tokens = tokens[1:]
+			} else if t, ok := parseZoneTTL(tokens[0].text); ok {
+				ttl = t
+				tokens = tokens[1:]
+			} else {
+				break
+			}
+		}
+		if len(tokens) == 0 {
+			return nil, zoneError(e.line, "record has no type")
+		}
+
+		switch {
+		case ttl != 0:
+			lastTTL = ttl
+		case defaultTTL != 0:
+			ttl = defaultTTL
+		default:
+			ttl = lastTTL
+		}
+
+		recordType := strings.ToUpper(tokens[0].text)
+		if recordType == "SOA" {
+			continue
+		}
+
+		name, ok := relativeName(owner, zoneOrigin)
+		if !ok {
+			return nil, zoneError(e.line, "%s is outside the zone %s", owner, zoneOrigin)
+		}
+
+		record := DomainRecordEditRequest{Type: recordType, Name: name, TTL: ttl}
+		if err := parseZoneData(&record, tokens[1:], origin); err != nil {
+			return nil, zoneError(e.line, "%s record: %v", recordType, err)
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// parseZoneData fills in the data fields of record from the RDATA of a zone
+// file entry.
+func parseZoneData(record *DomainRecordEditRequest, rdata []zoneToken, origin string) error {
+	want := map[string]int{
+		"A": 1, "AAAA": 1, "CNAME": 1, "NS": 1, "PTR": 1,
+		"MX": 2, "SRV": 4, "CAA": 3,
+	}
+	if n, ok := want[record.Type]; ok && len(rdata) != n {
+		return fmt.Errorf("expected %d fields, found %d", n, len(rdata))
+	}
+
+	var err error
+	switch record.Type {
+	case "A", "AAAA":
+		record.Data = rdata[0].text
+	case "CNAME", "NS", "PTR":
+		record.Data = absoluteName(rdata[0].text, origin)
+	case "MX":
+		if record.Priority, err = strconv.Atoi(rdata[0].text); err != nil {
+			return fmt.Errorf("invalid priority %q", rdata[0].text)
+		}
+		record.Data = absoluteName(rdata[1].text, origin)
+	case "SRV":
+		fields := []*int{&record.Priority, &record.Weight, &record.Port}
+		for i, field := range fields {
+			if *field, err = strconv.Atoi(rdata[i].text); err != nil {
+				return fmt.Errorf("invalid number %q", rdata[i].text)
+			}
+		}
+		record.Data = absoluteName(rdata[3].text, origin)
+	case "CAA":
+		if record.Flags, err = strconv.Atoi(rdata[0].text); err != nil {
+			return fmt.Errorf("invalid flags %q", rdata[0].text)
+		}
+		record.Tag = rdata[1].text
+		record.Data = rdata[2].text
+	case "TXT":
+		if len(rdata) == 0 {
+			return fmt.Errorf("expected at least 1 field")
+		}
+		var b strings.Builder
+		for _, t := range rdata {
+			b.WriteString(t.text)
+		}
+		record.Data = b.String()
+	default:
+		return fmt.Errorf("unsupported record type")
+	}
+	return nil
+}
+
+// FormatZoneFile renders records of the domain origin as an RFC 1035 zone
+// file. It is the inverse of ParseZoneFile, and can be used with the records
+// returned by DomainsService.Records to export a domain.
+func FormatZoneFile(origin string, records []DomainRecord) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s\n", fqdn(origin))
+
+	for _, r := range records {
+		recordType := strings.ToUpper(r.Type)
+		if recordType == "SOA" {
+			continue
+		}
+
+		name := r.Name
+		if name == "" {
+			name = "@"
+		}
+		ttl := ""
+		if r.TTL > 0 {
+			ttl = strconv.Itoa(r.TTL)
+		}
+
+		var data string
+		switch recordType {
+		case "CNAME", "NS", "PTR":
+			data = zoneHost(r.Data)
+		case "MX":
+			data = fmt.Sprintf("%d %s", r.Priority, zoneHost(r.Data))
+		case "SRV":
+			data = fmt.Sprintf("%d %d %d %s", r.Priority, r.Weight, r.Port, zoneHost(r.Data))
+		case "CAA":
+			data = fmt.Sprintf("%d %s %s", r.Flags, r.Tag, quoteZoneString(r.Data))
+		case "TXT":
+			data = formatTXT(r.Data)
+		default:
+			data = r.Data
+		}
+
+		fmt.Fprintf(&b, "%s\t%s\tIN\t%s\t%s\n", name, ttl, recordType, data)
+	}
+
+	return b.String()
+}
+
+// scanZone splits a zone file into logical entries, removing comments and
+// joining lines grouped with parentheses.
+func scanZone(r io.Reader) ([]zoneEntry, error) {
+	var entries []zoneEntry
+	var current *zoneEntry
+	depth := 0
+
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		if current == nil {
+			current = &zoneEntry{line: lineNo, blankOwner: line != "" && (line[0] == ' ' || line[0] == '\t')}
+		}
+
+		for i := 0; i < len(line); {
+			c := line[i]
+			switch {
+			case c == ';':
+				i = len(line)
+			case c == ' ' || c == '\t' || c == '\r':
+				i++
+			case c == '(':
+				depth++
+				i++
+			case c == ')':
+				if depth == 0 {
+					return nil, zoneError(lineNo, "unbalanced parentheses")
+				}
+				depth--
+				i++
+			case c == '"':
+				text, n, err := scanQuoted(line[i+1:])
+				if err != nil {
+					return nil, zoneError(lineNo, "%v", err)
+				}
+				current.tokens = append(current.tokens, zoneToken{text: text, quoted: true})
+				i += n + 2
+			default:
+				start := i
+				for i < len(line) && !strings.ContainsRune(" \t\r;()\"", rune(line[i])) {
+					i++
+				}
+				current.tokens = append(current.tokens, zoneToken{text: line[start:i]})
+			}
+		}
+
+		if depth == 0 {
+			if len(current.tokens) > 0 {
+				entries = append(entries, *current)
+			}
+			current = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if depth != 0 {
+		return nil, zoneError(current.line, "unbalanced parentheses")
+	}
+
+	return entries, nil
+}
+
+// scanQuoted reads a quoted string up to its closing quote, returning the
+// unescaped text and the number of bytes consumed, excluding the quotes.
+func scanQuoted(s string) (string, int, error) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+3 < len(s) && isDigits(s[i+1:i+4]) {
+				n, _ := strconv.Atoi(s[i+1 : i+4])
+				if n > 255 {
+					return "", 0, fmt.Errorf("invalid escape \\%s", s[i+1:i+4])
+				}
+				b.WriteByte(byte(n))
+				i += 3
+				continue
+			}
+			if i+1 < len(s) {
+				i++
+			}
+			b.WriteByte(s[i])
+		case '"':
+			return b.String(), i, nil
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated quoted string")
+}
+
+// isDigits reports whether s consists only of decimal digits.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func zoneError(line int, format string, args ...interface{}) error {
+	return fmt.Errorf("zone file line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+func isZoneClass(s string) bool {
+	switch strings.ToUpper(s) {
+	case "IN", "CH", "HS", "CS":
+		return true
+	}
+	return false
+}
+
+// parseZoneTTL parses a TTL in seconds, also accepting the BIND style of
+// units such as "1h30m".
+func parseZoneTTL(s string) (int, bool) {
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return 0, false
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, n := 0, 0
+	digits := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= '0' && c <= '9' {
+			n = n*10 + int(c-'0')
+			digits = true
+			continue
+		}
+		unit, ok := units[c|0x20]
+		if !ok || !digits {
+			return 0, false
+		}
+		total += n * unit
+		n, digits = 0, false
+	}
+	return total + n, true
+}
+
+// fqdn returns name fully qualified, in lower case with a trailing dot.
+func fqdn(name string) string {
+	name = strings.ToLower(name)
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+	return name
+}
+
+// absoluteName resolves a possibly relative zone file name against origin.
+func absoluteName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name)
+	default:
+		return strings.ToLower(name) + "." + origin
+	}
+}
+
+// relativeName returns name relative to origin, as used by the API, or false
+// if name is not within origin.
+func relativeName(name, origin string) (string, bool) {
+	if name == origin {
+		return "@", true
+	}
+	if strings.HasSuffix(name, "."+origin) {
+		return strings.TrimSuffix(name, "."+origin), true
+	}
+	return "", false
+}
+
+// zoneHost formats a host name from record data for a zone file. The API
+// returns host names without a trailing dot, so they are treated as fully
+// qualified.
+func zoneHost(host string) string {
+	if host == "@" || strings.HasSuffix(host, ".") {
+		return host
+	}
+	return host + "."
+}
+
+func quoteZoneString(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	return `"` + strings.Replace(s, `"`, `\"`, -1) + `"`
+}
+
+// formatTXT quotes TXT record data, splitting it into strings of at most
+// 255 bytes.
+func formatTXT(data string) string {
+	var parts []string
+	for len(data) > maxTXTString {
+		parts = append(parts, quoteZoneString(data[:maxTXTString]))
+		data = data[maxTXTString:]
+	}
+	parts = append(parts, quoteZoneString(data))
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,126 @@
+package binarylane
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testZoneFile = `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.binarylane.com.au. hostmaster.example.com. (
+		2021010101 ; serial
+		3600 1800 604800 300 )
+@		IN	A	192.0.2.1
+www	300	IN	CNAME	@
+		IN	TXT	"v=spf1 " "include:example.net -all" ; split string
+mail		MX	10 mx1
+@		IN	MX	20 mx2.example.net.
+_sip._tcp	IN	SRV	10 60 5060 sip
+@		IN	CAA	0 issue "letsencrypt.org"
+$ORIGIN sub.example.com.
+host	IN	AAAA	2001:db8::1
+`
+
+func TestParseZoneFile(t *testing.T) {
+	records, err := ParseZoneFile(strings.NewReader(testZoneFile), "example.com")
+	if err != nil {
+		t.Fatalf("ParseZoneFile returned error: %v", err)
+	}
+
+	expected := []DomainRecordEditRequest{
+		{Type: "A", Name: "@", Data: "192.0.2.1", TTL: 3600},
+		{Type: "CNAME", Name: "www", Data: "example.com.", TTL: 300},
+		{Type: "TXT", Name: "www", Data: "v=spf1 include:example.net -all", TTL: 3600},
+		{Type: "MX", Name: "mail", Data: "mx1.example.com.", Priority: 10, TTL: 3600},
+		{Type: "MX", Name: "@", Data: "mx2.example.net.", Priority: 20, TTL: 3600},
+		{Type: "SRV", Name: "_sip._tcp", Data: "sip.example.com.", Priority: 10, Weight: 60, Port: 5060, TTL: 3600},
+		{Type: "CAA", Name: "@", Data: "letsencrypt.org", Flags: 0, Tag: "issue", TTL: 3600},
+		{Type: "AAAA", Name: "host.sub", Data: "2001:db8::1", TTL: 3600},
+	}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("ParseZoneFile returned %+v, expected %+v", records, expected)
+	}
+}
+
+func TestParseZoneFile_escapes(t *testing.T) {
+	zone := `@ IN TXT "v=DKIM1\059 k=rsa\059 p=abc" "\"q\" \\ \\059"` + "\n"
+	records, err := ParseZoneFile(strings.NewReader(zone), "example.com")
+	if err != nil {
+		t.Fatalf("ParseZoneFile returned error: %v", err)
+	}
+
+	expected := `v=DKIM1; k=rsa; p=abc"q" \ \059`
+	if len(records) != 1 || records[0].Data != expected {
+		t.Errorf("ParseZoneFile returned %+v, expected TXT data %q", records, expected)
+	}
+}
+
+func TestParseZoneFile_errors(t *testing.T) {
+	tests := map[string]string{
+		"unbalanced":     "@ IN SOA a. b. ( 1 2 3 4 5\n",
+		"unterminated":   "@ IN TXT \"abc\n",
+		"outside origin": "www.example.net. IN A 192.0.2.1\n",
+		"unsupported":    "@ IN HINFO cpu os\n",
+		"missing fields": "@ IN MX mx1\n",
+		"no owner":       "  IN A 192.0.2.1\n",
+		"include":        "$INCLUDE other.zone\n",
+		"bad escape":     "@ IN TXT \"a\\256b\"\n",
+	}
+
+	for name, zone := range tests {
+		if _, err := ParseZoneFile(strings.NewReader(zone), "example.com"); err == nil {
+			t.Errorf("%s: expected error parsing %q", name, zone)
+		}
+	}
+}
+
+func TestFormatZoneFile(t *testing.T) {
+	records := []DomainRecord{
+		{Type: "SOA", Name: "@", Data: "1800"},
+		{Type: "A", Name: "@", Data: "192.0.2.1", TTL: 3600},
+		{Type: "CNAME", Name: "www", Data: "@"},
+		{Type: "MX", Name: "@", Data: "mx1.example.com", Priority: 10, TTL: 3600},
+		{Type: "SRV", Name: "_sip._tcp", Data: "sip.example.com", Priority: 10, Weight: 60, Port: 5060, TTL: 3600},
+		{Type: "CAA", Name: "@", Data: "letsencrypt.org", Tag: "issue", TTL: 3600},
+		{Type: "TXT", Name: "@", Data: `say "hi"`, TTL: 3600},
+	}
+
+	zone := FormatZoneFile("example.com", records)
+	expected := `$ORIGIN example.com.
+@	3600	IN	A	192.0.2.1
+www		IN	CNAME	@
+@	3600	IN	MX	10 mx1.example.com.
+_sip._tcp	3600	IN	SRV	10 60 5060 sip.example.com.
+@	3600	IN	CAA	0 issue "letsencrypt.org"
+@	3600	IN	TXT	"say \"hi\""
+`
+	if zone != expected {
+		t.Errorf("FormatZoneFile returned\n%s\nexpected\n%s", zone, expected)
+	}
+
+	parsed, err := ParseZoneFile(strings.NewReader(zone), "example.com")
+	if err != nil {
+		t.Fatalf("ParseZoneFile returned error: %v", err)
+	}
+	if len(parsed) != len(records)-1 || parsed[5].Data != `say "hi"` {
+		t.Errorf("ParseZoneFile(FormatZoneFile) returned %+v", parsed)
+	}
+}
+
+func TestFormatZoneFile_longTXT(t *testing.T) {
+	data := strings.Repeat("a", 300)
+	zone := FormatZoneFile("example.com", []DomainRecord{{Type: "TXT", Name: "@", Data: data}})
+
+	if !strings.Contains(zone, `"`+strings.Repeat("a", 255)+`" "`+strings.Repeat("a", 45)+`"`) {
+		t.Errorf("FormatZoneFile did not split long TXT data:\n%s", zone)
+	}
+
+	parsed, err := ParseZoneFile(strings.NewReader(zone), "example.com")
+	if err != nil {
+		t.Fatalf("ParseZoneFile returned error: %v", err)
+	}
+	if parsed[0].Data != data {
+		t.Errorf("ParseZoneFile returned TXT data %q, expected %q", parsed[0].Data, data)
+	}
+}
@@ -1,6 +1,15 @@
 package binarylane
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
 
 // ArgError is an error that represents an error with an input. It
 // identifies the argument and the cause (if possible).
@@ -22,3 +31,169 @@ func NewArgError(arg, reason string) *ArgError {
 func (e *ArgError) Error() string {
 	return fmt.Sprintf("%s is invalid because %s", e.arg, e.reason)
 }
+
+// ArgErrors is a list of errors with an input, returned when every problem
+// with it is reported at once.
+type ArgErrors []*ArgError
+
+var _ error = ArgErrors{}
+
+func (e ArgErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Sentinel errors classifying an *ErrorResponse by its HTTP status. Use them
+// with errors.Is:
+//
+//	if errors.Is(err, binarylane.ErrNotFound) {
+//		// the resource has already been deleted
+//	}
+var (
+	// ErrNotFound is returned for 404 Not Found and 410 Gone responses.
+	ErrNotFound = errors.New("binarylane: resource not found")
+
+	// ErrUnauthorized is returned for 401 Unauthorized responses, usually
+	// caused by a missing or invalid token.
+	ErrUnauthorized = errors.New("binarylane: unauthorized")
+
+	// ErrForbidden is returned for 403 Forbidden responses.
+	ErrForbidden = errors.New("binarylane: forbidden")
+
+	// ErrRateLimited is returned for 429 Too Many Requests responses. Use
+	// errors.As with a *RateLimitError for the reset time.
+	ErrRateLimited = errors.New("binarylane: rate limited")
+
+	// ErrConflict is returned for 409 Conflict and 423 Locked responses,
+	// such as when a resource is busy with another action.
+	ErrConflict = errors.New("binarylane: conflict")
+
+	// ErrValidation is returned for 400 Bad Request and 422 Unprocessable
+	// Entity responses. Use errors.As with a *ValidationError for the
+	// failures of individual fields.
+	ErrValidation = errors.New("binarylane: validation failed")
+
+	// ErrServer is returned for 5xx responses.
+	ErrServer = errors.New("binarylane: server error")
+)
+
+// RateLimitError describes a 429 Too Many Requests response. It wraps
+// ErrRateLimited.
+type RateLimitError struct {
+	// Rate is the rate limit reported with the response.
+	Rate Rate
+
+	// RetryAfter is how long the API asked the client to wait before trying
+	// again, taken from the Retry-After or RateLimit-Reset header. It is zero
+	// if the response did not say.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("%v, retry after %v", ErrRateLimited, e.RetryAfter)
+	}
+	return ErrRateLimited.Error()
+}
+
+// Unwrap returns ErrRateLimited.
+func (e *RateLimitError) Unwrap() error {
+	return ErrRateLimited
+}
+
+// ValidationError describes a 400 Bad Request or 422 Unprocessable Entity
+// response. It wraps ErrValidation.
+type ValidationError struct {
+	// Fields maps each invalid field to the reasons it was rejected. It is
+	// empty if the API did not identify the fields at fault.
+	Fields map[string][]string
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Fields) == 0 {
+		return ErrValidation.Error()
+	}
+
+	names := make([]string, 0, len(e.Fields))
+	for name := range e.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s: %s", name, strings.Join(e.Fields[name], ", "))
+	}
+	return fmt.Sprintf("%v: %s", ErrValidation, strings.Join(parts, "; "))
+}
+
+// Unwrap returns ErrValidation.
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
+
+// Unwrap classifies the response by its status code, returning one of the
+// sentinel errors, a *RateLimitError or a *ValidationError. It returns nil
+// if the status has no classification.
+func (r *ErrorResponse) Unwrap() error {
+	if r.Response == nil {
+		return nil
+	}
+
+	switch code := r.Response.StatusCode; {
+	case code == http.StatusNotFound, code == http.StatusGone:
+		return ErrNotFound
+	case code == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case code == http.StatusForbidden:
+		return ErrForbidden
+	case code == http.StatusTooManyRequests:
+		retryAfter, _ := serverBackoff(r.Response)
+		return &RateLimitError{Rate: parseRate(r.Response.Header), RetryAfter: retryAfter}
+	case code == http.StatusConflict, code == http.StatusLocked:
+		return ErrConflict
+	case code == http.StatusBadRequest, code == http.StatusUnprocessableEntity:
+		return &ValidationError{Fields: r.Errors}
+	case code >= 500 && code <= 599:
+		return ErrServer
+	}
+	return nil
+}
+
+// IsRetryable reports whether the request that returned err may succeed if
+// it is sent again: it was rate limited, failed with a transient server
+// error (500, 502, 503 or 504), or failed in transport before a response was
+// received. Cancelled requests and other API errors are not retryable.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) {
+		return errResp.Response != nil && retryableStatus(errResp.Response.StatusCode)
+	}
+	if errors.Is(err, ErrRateLimited) || errors.Is(err, ErrServer) {
+		return true
+	}
+
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// RetryAfter returns how long the API asked the client to wait before
+// retrying the request that returned err. The second return value is false
+// if err is not a rate limit error with a known delay.
+func RetryAfter(err error) (time.Duration, bool) {
+	var rateErr *RateLimitError
+	if errors.As(err, &rateErr) && rateErr.RetryAfter > 0 {
+		return rateErr.RetryAfter, true
+	}
+	return 0, false
+}
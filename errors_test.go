@@ -1,6 +1,17 @@
 package binarylane
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
 
 func TestArgError(t *testing.T) {
 	expected := "foo is invalid because bar"
@@ -9,3 +20,127 @@ func TestArgError(t *testing.T) {
 		t.Errorf("ArgError().Error() = %q; expected %q", got, expected)
 	}
 }
+
+func errorResponseFor(code int, header http.Header, body string) error {
+	if header == nil {
+		header = http.Header{}
+	}
+	res := &http.Response{
+		Request:    &http.Request{Method: http.MethodGet, URL: &url.URL{Path: "/v2/servers"}},
+		StatusCode: code,
+		Header:     header,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+	return CheckResponse(res)
+}
+
+func TestErrorResponse_Is(t *testing.T) {
+	cases := []struct {
+		code     int
+		expected error
+	}{
+		{http.StatusBadRequest, ErrValidation},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusGone, ErrNotFound},
+		{http.StatusConflict, ErrConflict},
+		{http.StatusLocked, ErrConflict},
+		{http.StatusUnprocessableEntity, ErrValidation},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusInternalServerError, ErrServer},
+		{http.StatusServiceUnavailable, ErrServer},
+	}
+	sentinels := []error{ErrNotFound, ErrUnauthorized, ErrForbidden, ErrRateLimited, ErrConflict, ErrValidation, ErrServer}
+
+	for _, c := range cases {
+		err := errorResponseFor(c.code, nil, "")
+		for _, sentinel := range sentinels {
+			if got := errors.Is(err, sentinel); got != (sentinel == c.expected) {
+				t.Errorf("errors.Is(%d, %v) = %v", c.code, sentinel, got)
+			}
+		}
+	}
+
+	if err := errorResponseFor(http.StatusTeapot, nil, ""); errors.Unwrap(err) != nil {
+		t.Errorf("Unclassified status unwrapped to %v", errors.Unwrap(err))
+	}
+}
+
+func TestErrorResponse_ValidationError(t *testing.T) {
+	body := `{"title":"One or more validation errors occurred.","status":400,"errors":{"Name":["The Name field is required."],"Size":["Unknown size.","Size is not available."]}}`
+	err := errorResponseFor(http.StatusBadRequest, nil, body)
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Message != "One or more validation errors occurred." {
+		t.Errorf("Expected problem title as message, got %#v", err)
+	}
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected *ValidationError, got %#v", err)
+	}
+	expected := map[string][]string{
+		"Name": {"The Name field is required."},
+		"Size": {"Unknown size.", "Size is not available."},
+	}
+	if !reflect.DeepEqual(validationErr.Fields, expected) {
+		t.Errorf("ValidationError.Fields = %v, expected %v", validationErr.Fields, expected)
+	}
+
+	expectedMessage := "binarylane: validation failed: Name: The Name field is required.; Size: Unknown size., Size is not available."
+	if got := validationErr.Error(); got != expectedMessage {
+		t.Errorf("ValidationError.Error() = %q, expected %q", got, expectedMessage)
+	}
+}
+
+func TestErrorResponse_RateLimitError(t *testing.T) {
+	header := http.Header{}
+	header.Set(headerRateLimit, "60")
+	header.Set(headerRateRemaining, "0")
+	header.Set(headerRetryAfter, "7")
+	err := errorResponseFor(http.StatusTooManyRequests, header, `{"message":"slow down"}`)
+
+	var rateErr *RateLimitError
+	if !errors.As(err, &rateErr) {
+		t.Fatalf("Expected *RateLimitError, got %#v", err)
+	}
+	if rateErr.Rate.Limit != 60 || rateErr.Rate.Remaining != 0 || rateErr.RetryAfter != 7*time.Second {
+		t.Errorf("RateLimitError = %+v", rateErr)
+	}
+
+	if wait, ok := RetryAfter(err); !ok || wait != 7*time.Second {
+		t.Errorf("RetryAfter() = %v, %v, expected 7s", wait, ok)
+	}
+	if _, ok := RetryAfter(errorResponseFor(http.StatusNotFound, nil, "")); ok {
+		t.Error("RetryAfter() reported a delay for a 404")
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	cases := []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{"nil", nil, false},
+		{"rate limited", errorResponseFor(http.StatusTooManyRequests, nil, ""), true},
+		{"internal server error", errorResponseFor(http.StatusInternalServerError, nil, ""), true},
+		{"bad gateway", errorResponseFor(http.StatusBadGateway, nil, ""), true},
+		{"not implemented", errorResponseFor(http.StatusNotImplemented, nil, ""), false},
+		{"not found", errorResponseFor(http.StatusNotFound, nil, ""), false},
+		{"validation", errorResponseFor(http.StatusUnprocessableEntity, nil, ""), false},
+		{"wrapped server error", fmt.Errorf("listing servers: %w", errorResponseFor(http.StatusServiceUnavailable, nil, "")), true},
+		{"sentinel", ErrServer, true},
+		{"transport", &url.Error{Op: "Get", URL: "https://api.binarylane.com.au", Err: errors.New("connection reset")}, true},
+		{"cancelled", &url.Error{Op: "Get", URL: "https://api.binarylane.com.au", Err: context.Canceled}, false},
+		{"deadline", context.DeadlineExceeded, false},
+		{"argument", NewArgError("id", "cannot be empty"), false},
+	}
+
+	for _, c := range cases {
+		if got := IsRetryable(c.err); got != c.retryable {
+			t.Errorf("IsRetryable(%s) = %v, expected %v", c.name, got, c.retryable)
+		}
+	}
+}
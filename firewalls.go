@@ -17,6 +17,7 @@ type FirewallsService interface {
 	Update(context.Context, string, *FirewallRequest) (*Firewall, *Response, error)
 	Delete(context.Context, string) (*Response, error)
 	List(context.Context, *ListOptions) ([]Firewall, *Response, error)
+	ListAll(context.Context) ([]Firewall, error)
 	ListByServer(context.Context, int, *ListOptions) ([]Firewall, *Response, error)
 	AddServers(context.Context, string, ...int) (*Response, error)
 	RemoveServers(context.Context, string, ...int) (*Response, error)
@@ -24,6 +25,8 @@ type FirewallsService interface {
 	RemoveTags(context.Context, string, ...string) (*Response, error)
 	AddRules(context.Context, string, *FirewallRulesRequest) (*Response, error)
 	RemoveRules(context.Context, string, *FirewallRulesRequest) (*Response, error)
+	Plan(context.Context, string, *FirewallRequest) (*FirewallPlan, *Response, error)
+	Reconcile(context.Context, string, *FirewallRequest) (*FirewallPlan, *Response, error)
 }
 
 // FirewallsServiceOp handles communication with Firewalls methods of the BinaryLane API.
@@ -110,6 +113,7 @@ var _ FirewallsService = &FirewallsServiceOp{}
 
 // Get an existing Firewall by its identifier.
 func (fw *FirewallsServiceOp) Get(ctx context.Context, fID string) (*Firewall, *Response, error) {
+	ctx = withOperation(ctx, "Firewalls.Get")
 	path := path.Join(firewallsBasePath, fID)
 
 	req, err := fw.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -128,6 +132,7 @@ func (fw *FirewallsServiceOp) Get(ctx context.Context, fID string) (*Firewall, *
 
 // Create a new Firewall with a given configuration.
 func (fw *FirewallsServiceOp) Create(ctx context.Context, fr *FirewallRequest) (*Firewall, *Response, error) {
+	ctx = withOperation(ctx, "Firewalls.Create")
 	req, err := fw.client.NewRequest(ctx, http.MethodPost, firewallsBasePath, fr)
 	if err != nil {
 		return nil, nil, err
@@ -144,6 +149,7 @@ func (fw *FirewallsServiceOp) Create(ctx context.Context, fr *FirewallRequest) (
 
 // Update an existing Firewall with new configuration.
 func (fw *FirewallsServiceOp) Update(ctx context.Context, fID string, fr *FirewallRequest) (*Firewall, *Response, error) {
+	ctx = withOperation(ctx, "Firewalls.Update")
 	path := path.Join(firewallsBasePath, fID)
 
 	req, err := fw.client.NewRequest(ctx, "PUT", path, fr)
@@ -162,12 +168,14 @@ func (fw *FirewallsServiceOp) Update(ctx context.Context, fID string, fr *Firewa
 
 // Delete a Firewall by its identifier.
 func (fw *FirewallsServiceOp) Delete(ctx context.Context, fID string) (*Response, error) {
+	ctx = withOperation(ctx, "Firewalls.Delete")
 	path := path.Join(firewallsBasePath, fID)
 	return fw.createAndDoReq(ctx, http.MethodDelete, path, nil)
 }
 
 // List Firewalls.
 func (fw *FirewallsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Firewall, *Response, error) {
+	ctx = withOperation(ctx, "Firewalls.List")
 	path, err := addOptions(firewallsBasePath, opt)
 	if err != nil {
 		return nil, nil, err
@@ -176,8 +184,24 @@ func (fw *FirewallsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Fir
 	return fw.listHelper(ctx, path)
 }
 
+// ListAll lists all firewalls, fetching every page of results.
+func (fw *FirewallsServiceOp) ListAll(ctx context.Context) ([]Firewall, error) {
+	var list []Firewall
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := fw.List(ctx, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
 // ListByServer Firewalls.
 func (fw *FirewallsServiceOp) ListByServer(ctx context.Context, sID int, opt *ListOptions) ([]Firewall, *Response, error) {
+	ctx = withOperation(ctx, "Firewalls.ListByServer")
 	basePath := path.Join(serverBasePath, strconv.Itoa(sID), "firewalls")
 	path, err := addOptions(basePath, opt)
 	if err != nil {
@@ -189,36 +213,42 @@ func (fw *FirewallsServiceOp) ListByServer(ctx context.Context, sID int, opt *Li
 
 // AddServers to a Firewall.
 func (fw *FirewallsServiceOp) AddServers(ctx context.Context, fID string, serverIDs ...int) (*Response, error) {
+	ctx = withOperation(ctx, "Firewalls.AddServers")
 	path := path.Join(firewallsBasePath, fID, "servers")
 	return fw.createAndDoReq(ctx, http.MethodPost, path, &serversRequest{IDs: serverIDs})
 }
 
 // RemoveServers from a Firewall.
 func (fw *FirewallsServiceOp) RemoveServers(ctx context.Context, fID string, serverIDs ...int) (*Response, error) {
+	ctx = withOperation(ctx, "Firewalls.RemoveServers")
 	path := path.Join(firewallsBasePath, fID, "servers")
 	return fw.createAndDoReq(ctx, http.MethodDelete, path, &serversRequest{IDs: serverIDs})
 }
 
 // AddTags to a Firewall.
 func (fw *FirewallsServiceOp) AddTags(ctx context.Context, fID string, tags ...string) (*Response, error) {
+	ctx = withOperation(ctx, "Firewalls.AddTags")
 	path := path.Join(firewallsBasePath, fID, "tags")
 	return fw.createAndDoReq(ctx, http.MethodPost, path, &tagsRequest{Tags: tags})
 }
 
 // RemoveTags from a Firewall.
 func (fw *FirewallsServiceOp) RemoveTags(ctx context.Context, fID string, tags ...string) (*Response, error) {
+	ctx = withOperation(ctx, "Firewalls.RemoveTags")
 	path := path.Join(firewallsBasePath, fID, "tags")
 	return fw.createAndDoReq(ctx, http.MethodDelete, path, &tagsRequest{Tags: tags})
 }
 
 // AddRules to a Firewall.
 func (fw *FirewallsServiceOp) AddRules(ctx context.Context, fID string, rr *FirewallRulesRequest) (*Response, error) {
+	ctx = withOperation(ctx, "Firewalls.AddRules")
 	path := path.Join(firewallsBasePath, fID, "rules")
 	return fw.createAndDoReq(ctx, http.MethodPost, path, rr)
 }
 
 // RemoveRules from a Firewall.
 func (fw *FirewallsServiceOp) RemoveRules(ctx context.Context, fID string, rr *FirewallRulesRequest) (*Response, error) {
+	ctx = withOperation(ctx, "Firewalls.RemoveRules")
 	path := path.Join(firewallsBasePath, fID, "rules")
 	return fw.createAndDoReq(ctx, http.MethodDelete, path, rr)
 }
@@ -0,0 +1,300 @@
+package binarylane
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// FirewallPlan describes the changes needed to bring a live Firewall in line
+// with a desired FirewallRequest. Rules to add are taken from the desired
+// configuration and rules to remove from the live Firewall, in their
+// original form.
+type FirewallPlan struct {
+	FirewallID string `json:"firewall_id"`
+
+	// Name is the new name of the firewall, or empty if it is unchanged.
+	Name string `json:"name,omitempty"`
+
+	AddInboundRules     []InboundRule  `json:"add_inbound_rules,omitempty"`
+	RemoveInboundRules  []InboundRule  `json:"remove_inbound_rules,omitempty"`
+	AddOutboundRules    []OutboundRule `json:"add_outbound_rules,omitempty"`
+	RemoveOutboundRules []OutboundRule `json:"remove_outbound_rules,omitempty"`
+	AddServerIDs        []int          `json:"add_server_ids,omitempty"`
+	RemoveServerIDs     []int          `json:"remove_server_ids,omitempty"`
+	AddTags             []string       `json:"add_tags,omitempty"`
+	RemoveTags          []string       `json:"remove_tags,omitempty"`
+}
+
+// String creates a human-readable description of a FirewallPlan.
+func (p FirewallPlan) String() string {
+	return Stringify(p)
+}
+
+// Empty reports whether the plan makes no changes.
+func (p *FirewallPlan) Empty() bool {
+	return p.Name == "" &&
+		len(p.AddInboundRules) == 0 && len(p.RemoveInboundRules) == 0 &&
+		len(p.AddOutboundRules) == 0 && len(p.RemoveOutboundRules) == 0 &&
+		len(p.AddServerIDs) == 0 && len(p.RemoveServerIDs) == 0 &&
+		len(p.AddTags) == 0 && len(p.RemoveTags) == 0
+}
+
+// DiffFirewall computes the plan that makes live match desired. Rules are
+// compared after normalising their protocol, port range and the order of
+// their sources or destinations, so that equivalent rules are left alone.
+func DiffFirewall(live *Firewall, desired *FirewallRequest) *FirewallPlan {
+	plan := &FirewallPlan{FirewallID: live.ID}
+	if desired.Name != "" && desired.Name != live.Name {
+		plan.Name = desired.Name
+	}
+
+	liveInbound := make(map[string]bool)
+	for _, r := range live.InboundRules {
+		liveInbound[inboundRuleKey(r)] = true
+	}
+	desiredInbound := make(map[string]bool)
+	for _, r := range desired.InboundRules {
+		key := inboundRuleKey(r)
+		if !liveInbound[key] && !desiredInbound[key] {
+			plan.AddInboundRules = append(plan.AddInboundRules, r)
+		}
+		desiredInbound[key] = true
+	}
+	for _, r := range live.InboundRules {
+		if !desiredInbound[inboundRuleKey(r)] {
+			plan.RemoveInboundRules = append(plan.RemoveInboundRules, r)
+		}
+	}
+
+	liveOutbound := make(map[string]bool)
+	for _, r := range live.OutboundRules {
+		liveOutbound[outboundRuleKey(r)] = true
+	}
+	desiredOutbound := make(map[string]bool)
+	for _, r := range desired.OutboundRules {
+		key := outboundRuleKey(r)
+		if !liveOutbound[key] && !desiredOutbound[key] {
+			plan.AddOutboundRules = append(plan.AddOutboundRules, r)
+		}
+		desiredOutbound[key] = true
+	}
+	for _, r := range live.OutboundRules {
+		if !desiredOutbound[outboundRuleKey(r)] {
+			plan.RemoveOutboundRules = append(plan.RemoveOutboundRules, r)
+		}
+	}
+
+	plan.AddServerIDs, plan.RemoveServerIDs = diffInts(live.ServerIDs, desired.ServerIDs)
+	plan.AddTags, plan.RemoveTags = diffStrings(live.Tags, desired.Tags)
+	return plan
+}
+
+// Plan fetches the Firewall and returns the changes that Reconcile would make
+// to it, without applying them.
+func (fw *FirewallsServiceOp) Plan(ctx context.Context, fID string, desired *FirewallRequest) (*FirewallPlan, *Response, error) {
+	if desired == nil {
+		return nil, nil, NewArgError("desired", "cannot be nil")
+	}
+
+	live, resp, err := fw.Get(ctx, fID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return DiffFirewall(live, desired), resp, nil
+}
+
+// Reconcile brings the Firewall in line with desired using the minimal set of
+// add and remove calls, rather than replacing its configuration. New rules,
+// servers and tags are added before old ones are removed so that traffic
+// allowed by both configurations is never blocked. It returns the plan that
+// was applied.
+//
+// If a call fails, Reconcile returns the error without undoing the changes
+// already made. Running it again completes the remaining changes.
+func (fw *FirewallsServiceOp) Reconcile(ctx context.Context, fID string, desired *FirewallRequest) (*FirewallPlan, *Response, error) {
+	plan, resp, err := fw.Plan(ctx, fID, desired)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if len(plan.AddInboundRules) > 0 || len(plan.AddOutboundRules) > 0 {
+		resp, err = fw.AddRules(ctx, fID, &FirewallRulesRequest{
+			InboundRules:  plan.AddInboundRules,
+			OutboundRules: plan.AddOutboundRules,
+		})
+		if err != nil {
+			return plan, resp, err
+		}
+	}
+	if len(plan.AddServerIDs) > 0 {
+		if resp, err = fw.AddServers(ctx, fID, plan.AddServerIDs...); err != nil {
+			return plan, resp, err
+		}
+	}
+	if len(plan.AddTags) > 0 {
+		if resp, err = fw.AddTags(ctx, fID, plan.AddTags...); err != nil {
+			return plan, resp, err
+		}
+	}
+	if len(plan.RemoveServerIDs) > 0 {
+		if resp, err = fw.RemoveServers(ctx, fID, plan.RemoveServerIDs...); err != nil {
+			return plan, resp, err
+		}
+	}
+	if len(plan.RemoveTags) > 0 {
+		if resp, err = fw.RemoveTags(ctx, fID, plan.RemoveTags...); err != nil {
+			return plan, resp, err
+		}
+	}
+	if len(plan.RemoveInboundRules) > 0 || len(plan.RemoveOutboundRules) > 0 {
+		resp, err = fw.RemoveRules(ctx, fID, &FirewallRulesRequest{
+			InboundRules:  plan.RemoveInboundRules,
+			OutboundRules: plan.RemoveOutboundRules,
+		})
+		if err != nil {
+			return plan, resp, err
+		}
+	}
+
+	// The rules and membership now match, so renaming with the desired
+	// configuration does not change them.
+	if plan.Name != "" {
+		if _, resp, err = fw.Update(ctx, fID, desired); err != nil {
+			return plan, resp, err
+		}
+	}
+
+	return plan, resp, nil
+}
+
+// inboundRuleKey returns a key that is equal for equivalent inbound rules.
+func inboundRuleKey(r InboundRule) string {
+	var sources Destinations
+	if r.Sources != nil {
+		sources = Destinations(*r.Sources)
+	}
+	return ruleKey(r.Protocol, r.PortRange, sources)
+}
+
+// outboundRuleKey returns a key that is equal for equivalent outbound rules.
+func outboundRuleKey(r OutboundRule) string {
+	var destinations Destinations
+	if r.Destinations != nil {
+		destinations = *r.Destinations
+	}
+	return ruleKey(r.Protocol, r.PortRange, destinations)
+}
+
+func ruleKey(protocol, portRange string, targets Destinations) string {
+	b, _ := json.Marshal(normalizeTargets(targets))
+	return strings.ToLower(strings.TrimSpace(protocol)) + " " + normalizePortRange(portRange) + " " + string(b)
+}
+
+// normalizePortRange returns the canonical form of a port range, treating
+// "", "0", "all" and "1-65535" as all ports and "N-N" as "N".
+func normalizePortRange(ports string) string {
+	ports = strings.ToLower(strings.TrimSpace(ports))
+	switch ports {
+	case "", "0", "all", "1-65535":
+		return "all"
+	}
+
+	if i := strings.Index(ports, "-"); i >= 0 {
+		from, to := strings.TrimSpace(ports[:i]), strings.TrimSpace(ports[i+1:])
+		if from == to {
+			return from
+		}
+		return from + "-" + to
+	}
+	return ports
+}
+
+// normalizeTargets returns a copy of the rule targets with each list sorted
+// and free of duplicates.
+func normalizeTargets(t Destinations) Destinations {
+	return Destinations{
+		Addresses:        sortedStrings(t.Addresses),
+		Tags:             sortedStrings(t.Tags),
+		ServerIDs:        sortedInts(t.ServerIDs),
+		LoadBalancerUIDs: sortedStrings(t.LoadBalancerUIDs),
+	}
+}
+
+func sortedStrings(s []string) []string {
+	seen := make(map[string]bool, len(s))
+	var out []string
+	for _, v := range s {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func sortedInts(s []int) []int {
+	seen := make(map[int]bool, len(s))
+	var out []int
+	for _, v := range s {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	sort.Ints(out)
+	return out
+}
+
+// diffInts returns the sorted values that are only in desired and only in
+// live.
+func diffInts(live, desired []int) (add, remove []int) {
+	liveSet := make(map[int]bool, len(live))
+	for _, v := range live {
+		liveSet[v] = true
+	}
+	desiredSet := make(map[int]bool, len(desired))
+	for _, v := range desired {
+		desiredSet[v] = true
+	}
+
+	for _, v := range sortedInts(desired) {
+		if !liveSet[v] {
+			add = append(add, v)
+		}
+	}
+	for _, v := range sortedInts(live) {
+		if !desiredSet[v] {
+			remove = append(remove, v)
+		}
+	}
+	return add, remove
+}
+
+// diffStrings returns the sorted values that are only in desired and only in
+// live.
+func diffStrings(live, desired []string) (add, remove []string) {
+	liveSet := make(map[string]bool, len(live))
+	for _, v := range live {
+		liveSet[v] = true
+	}
+	desiredSet := make(map[string]bool, len(desired))
+	for _, v := range desired {
+		desiredSet[v] = true
+	}
+
+	for _, v := range sortedStrings(desired) {
+		if !liveSet[v] {
+			add = append(add, v)
+		}
+	}
+	for _, v := range sortedStrings(live) {
+		if !desiredSet[v] {
+			remove = append(remove, v)
+		}
+	}
+	return add, remove
+}
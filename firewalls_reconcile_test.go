@@ -0,0 +1,152 @@
+package binarylane
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var liveFirewall = &Firewall{
+	ID:   "fe6b88f2-b42b-4bf7-bbd3-5ae20208f0b0",
+	Name: "web",
+	InboundRules: []InboundRule{
+		{Protocol: "tcp", PortRange: "22", Sources: &Sources{Addresses: []string{"10.0.0.0/8", "0.0.0.0/0"}}},
+		{Protocol: "tcp", PortRange: "8080", Sources: &Sources{Addresses: []string{"0.0.0.0/0"}}},
+	},
+	OutboundRules: []OutboundRule{
+		{Protocol: "tcp", PortRange: "0", Destinations: &Destinations{Addresses: []string{"0.0.0.0/0"}}},
+	},
+	ServerIDs: []int{1, 2},
+	Tags:      []string{"frontend"},
+}
+
+func TestFirewalls_DiffFirewall(t *testing.T) {
+	desired := &FirewallRequest{
+		Name: "web",
+		InboundRules: []InboundRule{
+			{Protocol: "TCP", PortRange: "22-22", Sources: &Sources{Addresses: []string{"0.0.0.0/0", "10.0.0.0/8"}}},
+			{Protocol: "tcp", PortRange: "443", Sources: &Sources{Addresses: []string{"0.0.0.0/0"}}},
+		},
+		OutboundRules: []OutboundRule{
+			{Protocol: "tcp", PortRange: "all", Destinations: &Destinations{Addresses: []string{"0.0.0.0/0"}}},
+		},
+		ServerIDs: []int{3, 2},
+		Tags:      []string{"frontend", "backend"},
+	}
+
+	plan := DiffFirewall(liveFirewall, desired)
+
+	expected := &FirewallPlan{
+		FirewallID:         liveFirewall.ID,
+		AddInboundRules:    []InboundRule{desired.InboundRules[1]},
+		RemoveInboundRules: []InboundRule{liveFirewall.InboundRules[1]},
+		AddServerIDs:       []int{3},
+		RemoveServerIDs:    []int{1},
+		AddTags:            []string{"backend"},
+	}
+	if !reflect.DeepEqual(plan, expected) {
+		t.Errorf("DiffFirewall returned %+v, expected %+v", plan, expected)
+	}
+}
+
+func TestFirewalls_DiffFirewallUnchanged(t *testing.T) {
+	desired := &FirewallRequest{
+		InboundRules:  liveFirewall.InboundRules,
+		OutboundRules: liveFirewall.OutboundRules,
+		ServerIDs:     []int{2, 1},
+		Tags:          liveFirewall.Tags,
+	}
+
+	if plan := DiffFirewall(liveFirewall, desired); !plan.Empty() {
+		t.Errorf("DiffFirewall returned %+v, expected an empty plan", plan)
+	}
+}
+
+func TestFirewalls_Plan(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/firewalls/"+liveFirewall.ID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		json.NewEncoder(w).Encode(&firewallRoot{Firewall: liveFirewall})
+	})
+
+	plan, _, err := client.Firewalls.Plan(ctx, liveFirewall.ID, &FirewallRequest{Name: "renamed"})
+	if err != nil {
+		t.Fatalf("Firewalls.Plan returned error: %v", err)
+	}
+	if plan.Name != "renamed" || len(plan.RemoveInboundRules) != 2 || len(plan.RemoveServerIDs) != 2 {
+		t.Errorf("Firewalls.Plan returned %+v", plan)
+	}
+}
+
+func TestFirewalls_Reconcile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]json.RawMessage
+		json.NewDecoder(r.Body).Decode(&body)
+		keys := ""
+		for _, k := range []string{"inbound_rules", "outbound_rules", "server_ids", "tags"} {
+			if v, ok := body[k]; ok && string(v) != "null" {
+				keys += " " + k
+			}
+		}
+		calls = append(calls, fmt.Sprintf("%s %s%s", r.Method, r.URL.Path, keys))
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	base := "/v2/firewalls/" + liveFirewall.ID
+	mux.HandleFunc(base, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			json.NewEncoder(w).Encode(&firewallRoot{Firewall: liveFirewall})
+			return
+		}
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		json.NewEncoder(w).Encode(&firewallRoot{Firewall: liveFirewall})
+	})
+	mux.HandleFunc(base+"/rules", record)
+	mux.HandleFunc(base+"/servers", record)
+	mux.HandleFunc(base+"/tags", record)
+
+	desired := &FirewallRequest{
+		Name: "renamed",
+		InboundRules: []InboundRule{
+			liveFirewall.InboundRules[0],
+			{Protocol: "tcp", PortRange: "443", Sources: &Sources{Addresses: []string{"0.0.0.0/0"}}},
+		},
+		OutboundRules: liveFirewall.OutboundRules,
+		ServerIDs:     []int{2, 3},
+	}
+
+	plan, _, err := client.Firewalls.Reconcile(ctx, liveFirewall.ID, desired)
+	if err != nil {
+		t.Fatalf("Firewalls.Reconcile returned error: %v", err)
+	}
+	if plan.Empty() {
+		t.Error("Firewalls.Reconcile returned an empty plan")
+	}
+
+	expected := []string{
+		"POST " + base + "/rules inbound_rules",
+		"POST " + base + "/servers server_ids",
+		"DELETE " + base + "/servers server_ids",
+		"DELETE " + base + "/tags tags",
+		"DELETE " + base + "/rules inbound_rules",
+		"PUT " + base,
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Firewalls.Reconcile made calls %q, expected %q", calls, expected)
+	}
+}
+
+func TestFirewalls_ReconcileNilRequest(t *testing.T) {
+	_, _, err := NewClient(nil).Firewalls.Reconcile(ctx, "id", nil)
+	if _, ok := err.(*ArgError); !ok {
+		t.Errorf("Firewalls.Reconcile returned %v, expected an ArgError", err)
+	}
+}
@@ -13,6 +13,7 @@ const floatingBasePath = "v2/floating_ips"
 // See: https://api.binarylane.com.au/reference#floating-ips
 type FloatingIPsService interface {
 	List(context.Context, *ListOptions) ([]FloatingIP, *Response, error)
+	ListAll(context.Context) ([]FloatingIP, error)
 	Get(context.Context, string) (*FloatingIP, *Response, error)
 	Create(context.Context, *FloatingIPCreateRequest) (*FloatingIP, *Response, error)
 	Delete(context.Context, string) (*Response, error)
@@ -62,6 +63,7 @@ type FloatingIPCreateRequest struct {
 
 // List all floating IPs.
 func (f *FloatingIPsServiceOp) List(ctx context.Context, opt *ListOptions) ([]FloatingIP, *Response, error) {
+	ctx = withOperation(ctx, "FloatingIPs.List")
 	path := floatingBasePath
 	path, err := addOptions(path, opt)
 	if err != nil {
@@ -88,8 +90,24 @@ func (f *FloatingIPsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Fl
 	return root.FloatingIPs, resp, err
 }
 
+// ListAll lists all floating IPs, fetching every page of results.
+func (f *FloatingIPsServiceOp) ListAll(ctx context.Context) ([]FloatingIP, error) {
+	var list []FloatingIP
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := f.List(ctx, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
 // Get an individual floating IP.
 func (f *FloatingIPsServiceOp) Get(ctx context.Context, ip string) (*FloatingIP, *Response, error) {
+	ctx = withOperation(ctx, "FloatingIPs.Get")
 	path := fmt.Sprintf("%s/%s", floatingBasePath, ip)
 
 	req, err := f.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -109,6 +127,7 @@ func (f *FloatingIPsServiceOp) Get(ctx context.Context, ip string) (*FloatingIP,
 // Create a floating IP. If the ServerID field of the request is not empty,
 // the floating IP will also be assigned to the server.
 func (f *FloatingIPsServiceOp) Create(ctx context.Context, createRequest *FloatingIPCreateRequest) (*FloatingIP, *Response, error) {
+	ctx = withOperation(ctx, "FloatingIPs.Create")
 	path := floatingBasePath
 
 	req, err := f.client.NewRequest(ctx, http.MethodPost, path, createRequest)
@@ -130,6 +149,7 @@ func (f *FloatingIPsServiceOp) Create(ctx context.Context, createRequest *Floati
 
 // Delete a floating IP.
 func (f *FloatingIPsServiceOp) Delete(ctx context.Context, ip string) (*Response, error) {
+	ctx = withOperation(ctx, "FloatingIPs.Delete")
 	path := fmt.Sprintf("%s/%s", floatingBasePath, ip)
 
 	req, err := f.client.NewRequest(ctx, http.MethodDelete, path, nil)
@@ -24,6 +24,7 @@ type FloatingIPActionsServiceOp struct {
 
 // Assign a floating IP to a server.
 func (s *FloatingIPActionsServiceOp) Assign(ctx context.Context, ip string, serverID int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "FloatingIPActions.Assign")
 	request := &ActionRequest{
 		"type":      "assign",
 		"server_id": serverID,
@@ -33,18 +34,21 @@ func (s *FloatingIPActionsServiceOp) Assign(ctx context.Context, ip string, serv
 
 // Unassign a floating IP from the server it is currently assigned to.
 func (s *FloatingIPActionsServiceOp) Unassign(ctx context.Context, ip string) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "FloatingIPActions.Unassign")
 	request := &ActionRequest{"type": "unassign"}
 	return s.doAction(ctx, ip, request)
 }
 
 // Get an action for a particular floating IP by id.
 func (s *FloatingIPActionsServiceOp) Get(ctx context.Context, ip string, actionID int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "FloatingIPActions.Get")
 	path := fmt.Sprintf("%s/%d", floatingIPActionPath(ip), actionID)
 	return s.get(ctx, path)
 }
 
 // List the actions for a particular floating IP.
 func (s *FloatingIPActionsServiceOp) List(ctx context.Context, ip string, opt *ListOptions) ([]Action, *Response, error) {
+	ctx = withOperation(ctx, "FloatingIPActions.List")
 	path := floatingIPActionPath(ip)
 	path, err := addOptions(path, opt)
 	if err != nil {
@@ -0,0 +1,82 @@
+package binarylane
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+)
+
+// IdempotencyKeyHeader is the request header that carries an idempotency key.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+type idempotencyKeyContextKey struct{}
+
+// ContextWithIdempotencyKey returns a copy of ctx that makes POST requests
+// created with it carry key in the Idempotency-Key header. An API that
+// honours the header performs a create at most once for each key, however
+// many times the request is sent.
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key set on ctx by
+// ContextWithIdempotencyKey.
+func IdempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key, ok && key != ""
+}
+
+// NewIdempotencyKey returns a new random idempotency key, formatted as a
+// version 4 UUID.
+func NewIdempotencyKey() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("binarylane: reading random bytes: %v", err))
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// WithIdempotencyKeys is a client option for an API that honours idempotency
+// keys. Every POST request is given a new key unless its context already
+// has one, and POST requests with a key are retried under the client's
+// RetryPolicy like idempotent requests.
+//
+// Without this option POST requests are never retried, since a create that
+// reached the API before the connection failed would be made twice. If the
+// API does not honour keys, use util.IdempotentCreator instead, which looks
+// for the resource before retrying.
+func WithIdempotencyKeys() ClientOpt {
+	return func(c *Client) error {
+		c.idempotencyKeys = true
+		return nil
+	}
+}
+
+// setIdempotencyKey adds the idempotency key for a new request, if it has
+// one.
+func (c *Client) setIdempotencyKey(ctx context.Context, req *http.Request) {
+	if req.Method != http.MethodPost {
+		return
+	}
+	key, ok := IdempotencyKeyFromContext(ctx)
+	if !ok {
+		if !c.idempotencyKeys {
+			return
+		}
+		key = NewIdempotencyKey()
+	}
+	req.Header.Set(IdempotencyKeyHeader, key)
+}
+
+// canRetry reports whether req may be retried: it is idempotent, or it is a
+// POST with an idempotency key the API is known to honour.
+func (c *Client) canRetry(req *http.Request) bool {
+	if isIdempotent(req) {
+		return true
+	}
+	return c.idempotencyKeys && req.Method == http.MethodPost && req.Header.Get(IdempotencyKeyHeader) != "" &&
+		(req.Body == nil || req.Body == http.NoBody || req.GetBody != nil)
+}
@@ -0,0 +1,106 @@
+package binarylane
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+)
+
+func TestNewIdempotencyKey(t *testing.T) {
+	uuid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	a, b := NewIdempotencyKey(), NewIdempotencyKey()
+	if !uuid.MatchString(a) {
+		t.Errorf("NewIdempotencyKey() = %q, expected a version 4 UUID", a)
+	}
+	if a == b {
+		t.Errorf("NewIdempotencyKey() returned %q twice", a)
+	}
+}
+
+func TestNewRequest_idempotencyKeyFromContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	keyed := ContextWithIdempotencyKey(ctx, "abc")
+	req, _ := client.NewRequest(keyed, http.MethodPost, "v2/servers", nil)
+	if got := req.Header.Get(IdempotencyKeyHeader); got != "abc" {
+		t.Errorf("POST %s = %q, expected %q", IdempotencyKeyHeader, got, "abc")
+	}
+
+	req, _ = client.NewRequest(keyed, http.MethodGet, "v2/servers", nil)
+	if got := req.Header.Get(IdempotencyKeyHeader); got != "" {
+		t.Errorf("GET %s = %q, expected none", IdempotencyKeyHeader, got)
+	}
+
+	req, _ = client.NewRequest(ctx, http.MethodPost, "v2/servers", nil)
+	if got := req.Header.Get(IdempotencyKeyHeader); got != "" {
+		t.Errorf("POST without a key %s = %q, expected none", IdempotencyKeyHeader, got)
+	}
+}
+
+func TestDo_postNotRetriedWithoutIdempotencyKeys(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := WithRetryPolicy(testRetryPolicy)(client); err != nil {
+		t.Fatalf("WithRetryPolicy(): %v", err)
+	}
+
+	calls := 0
+	mux.HandleFunc("/v2/servers", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, `{"message":"unavailable"}`, http.StatusServiceUnavailable)
+	})
+
+	req, _ := client.NewRequest(ContextWithIdempotencyKey(ctx, "abc"), http.MethodPost, "v2/servers", map[string]string{"name": "web"})
+	if _, err := client.Do(ctx, req, nil); err == nil {
+		t.Fatal("Do(): expected an error")
+	}
+	if calls != 1 {
+		t.Errorf("Server calls = %d, expected 1", calls)
+	}
+}
+
+func TestDo_retryPostWithIdempotencyKeys(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, opt := range []ClientOpt{WithRetryPolicy(testRetryPolicy), WithIdempotencyKeys()} {
+		if err := opt(client); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var keys, bodies []string
+	mux.HandleFunc("/v2/servers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		bodies = append(bodies, StreamToString(r.Body))
+		if len(keys) < 2 {
+			http.Error(w, `{"message":"unavailable"}`, http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"server":{"id":1}}`)
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodPost, "v2/servers", map[string]string{"name": "web"})
+	resp, err := client.Do(ctx, req, nil)
+	if err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+	if resp.Attempts != 2 {
+		t.Errorf("Response attempts = %d, expected 2", resp.Attempts)
+	}
+	if len(keys) != 2 || keys[0] == "" || keys[0] != keys[1] {
+		t.Errorf("%s headers = %q, expected the same key on each attempt", IdempotencyKeyHeader, keys)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] {
+		t.Errorf("Request bodies = %q, expected the body to be replayed", bodies)
+	}
+
+	req, _ = client.NewRequest(ctx, http.MethodPost, "v2/servers", nil)
+	if got := req.Header.Get(IdempotencyKeyHeader); got == "" || got == keys[0] {
+		t.Errorf("Second request %s = %q, expected a new key", IdempotencyKeyHeader, got)
+	}
+}
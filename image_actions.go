@@ -25,6 +25,7 @@ var _ ImageActionsService = &ImageActionsServiceOp{}
 
 // Transfer an image
 func (i *ImageActionsServiceOp) Transfer(ctx context.Context, imageID int, transferRequest *ActionRequest) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ImageActions.Transfer")
 	if imageID < 1 {
 		return nil, nil, NewArgError("imageID", "cannot be less than 1")
 	}
@@ -51,6 +52,7 @@ func (i *ImageActionsServiceOp) Transfer(ctx context.Context, imageID int, trans
 
 // Convert an image to a snapshot
 func (i *ImageActionsServiceOp) Convert(ctx context.Context, imageID int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ImageActions.Convert")
 	if imageID < 1 {
 		return nil, nil, NewArgError("imageID", "cannont be less than 1")
 	}
@@ -77,6 +79,7 @@ func (i *ImageActionsServiceOp) Convert(ctx context.Context, imageID int) (*Acti
 
 // Get an action for a particular image by id.
 func (i *ImageActionsServiceOp) Get(ctx context.Context, imageID, actionID int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ImageActions.Get")
 	if imageID < 1 {
 		return nil, nil, NewArgError("imageID", "cannot be less than 1")
 	}
@@ -13,9 +13,11 @@ const imageBasePath = "v2/images"
 // See: https://api.binarylane.com.au/reference#images
 type ImagesService interface {
 	List(context.Context, *ListOptions) ([]Image, *Response, error)
+	ListAll(context.Context) ([]Image, error)
 	ListDistribution(ctx context.Context, opt *ListOptions) ([]Image, *Response, error)
 	ListApplication(ctx context.Context, opt *ListOptions) ([]Image, *Response, error)
 	ListUser(ctx context.Context, opt *ListOptions) ([]Image, *Response, error)
+	ListUserAll(context.Context) ([]Image, error)
 	ListByTag(ctx context.Context, tag string, opt *ListOptions) ([]Image, *Response, error)
 	GetByID(context.Context, int) (*Image, *Response, error)
 	GetBySlug(context.Context, string) (*Image, *Response, error)
@@ -87,35 +89,71 @@ func (i Image) String() string {
 
 // List lists all the images available.
 func (s *ImagesServiceOp) List(ctx context.Context, opt *ListOptions) ([]Image, *Response, error) {
+	ctx = withOperation(ctx, "Images.List")
 	return s.list(ctx, opt, nil)
 }
 
+// ListAll lists all images, fetching every page of results.
+func (s *ImagesServiceOp) ListAll(ctx context.Context) ([]Image, error) {
+	var list []Image
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := s.List(ctx, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
 // ListDistribution lists all the distribution images.
 func (s *ImagesServiceOp) ListDistribution(ctx context.Context, opt *ListOptions) ([]Image, *Response, error) {
+	ctx = withOperation(ctx, "Images.ListDistribution")
 	listOpt := listImageOptions{Type: "distribution"}
 	return s.list(ctx, opt, &listOpt)
 }
 
 // ListApplication lists all the application images.
 func (s *ImagesServiceOp) ListApplication(ctx context.Context, opt *ListOptions) ([]Image, *Response, error) {
+	ctx = withOperation(ctx, "Images.ListApplication")
 	listOpt := listImageOptions{Type: "application"}
 	return s.list(ctx, opt, &listOpt)
 }
 
 // ListUser lists all the user images.
 func (s *ImagesServiceOp) ListUser(ctx context.Context, opt *ListOptions) ([]Image, *Response, error) {
+	ctx = withOperation(ctx, "Images.ListUser")
 	listOpt := listImageOptions{Private: true}
 	return s.list(ctx, opt, &listOpt)
 }
 
+// ListUserAll lists all the user images, fetching every page of results.
+func (s *ImagesServiceOp) ListUserAll(ctx context.Context) ([]Image, error) {
+	var list []Image
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := s.ListUser(ctx, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
 // ListByTag lists all images with a specific tag applied.
 func (s *ImagesServiceOp) ListByTag(ctx context.Context, tag string, opt *ListOptions) ([]Image, *Response, error) {
+	ctx = withOperation(ctx, "Images.ListByTag")
 	listOpt := listImageOptions{Tag: tag}
 	return s.list(ctx, opt, &listOpt)
 }
 
 // GetByID retrieves an image by id.
 func (s *ImagesServiceOp) GetByID(ctx context.Context, imageID int) (*Image, *Response, error) {
+	ctx = withOperation(ctx, "Images.GetByID")
 	if imageID < 1 {
 		return nil, nil, NewArgError("imageID", "cannot be less than 1")
 	}
@@ -125,6 +163,7 @@ func (s *ImagesServiceOp) GetByID(ctx context.Context, imageID int) (*Image, *Re
 
 // GetBySlug retrieves an image by slug.
 func (s *ImagesServiceOp) GetBySlug(ctx context.Context, slug string) (*Image, *Response, error) {
+	ctx = withOperation(ctx, "Images.GetBySlug")
 	if len(slug) < 1 {
 		return nil, nil, NewArgError("slug", "cannot be blank")
 	}
@@ -134,6 +173,7 @@ func (s *ImagesServiceOp) GetBySlug(ctx context.Context, slug string) (*Image, *
 
 // Create a new image
 func (s *ImagesServiceOp) Create(ctx context.Context, createRequest *CustomImageCreateRequest) (*Image, *Response, error) {
+	ctx = withOperation(ctx, "Images.Create")
 	if createRequest == nil {
 		return nil, nil, NewArgError("createRequest", "cannot be nil")
 	}
@@ -154,6 +194,7 @@ func (s *ImagesServiceOp) Create(ctx context.Context, createRequest *CustomImage
 
 // Update an image name.
 func (s *ImagesServiceOp) Update(ctx context.Context, imageID int, updateRequest *ImageUpdateRequest) (*Image, *Response, error) {
+	ctx = withOperation(ctx, "Images.Update")
 	if imageID < 1 {
 		return nil, nil, NewArgError("imageID", "cannot be less than 1")
 	}
@@ -179,6 +220,7 @@ func (s *ImagesServiceOp) Update(ctx context.Context, imageID int, updateRequest
 
 // Delete an image.
 func (s *ImagesServiceOp) Delete(ctx context.Context, imageID int) (*Response, error) {
+	ctx = withOperation(ctx, "Images.Delete")
 	if imageID < 1 {
 		return nil, NewArgError("imageID", "cannot be less than 1")
 	}
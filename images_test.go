@@ -172,6 +172,33 @@ func TestImages_ListUser(t *testing.T) {
 	}
 }
 
+func TestImages_ListUserAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/images", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("private") != "true" {
+			t.Errorf("'private' query = %v, expected true", r.URL.Query().Get("private"))
+		}
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"images":[{"id":2}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"images":[{"id":1}], "links":{"pages":{"next":"http://example.com/v2/images?page=2&private=true"}}}`)
+	})
+
+	images, err := client.Images.ListUserAll(ctx)
+	if err != nil {
+		t.Errorf("Images.ListUserAll returned error: %v", err)
+	}
+
+	expected := []Image{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(images, expected) {
+		t.Errorf("Images.ListUserAll returned %+v, expected %+v", images, expected)
+	}
+}
+
 func TestImages_ListByTag(t *testing.T) {
 	setup()
 	defer teardown()
@@ -0,0 +1,248 @@
+package binarylane
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	headerAuthorization = "Authorization"
+	headerRequestID     = "X-Request-Id"
+
+	redacted = "REDACTED"
+
+	// maxLoggedBody is the largest request or response body that is written
+	// to the log in full. Longer bodies are truncated.
+	maxLoggedBody = 64 << 10
+)
+
+// Interceptor observes each attempt the client makes to send a request to
+// the API, including attempts made when a request is retried. Any of its
+// hooks may be nil.
+type Interceptor struct {
+	// BeforeRequest is called before each attempt is sent. attempt starts at
+	// 1. If it returns an error, the request is not sent and the error is
+	// returned to the caller after the OnError hooks have been called.
+	BeforeRequest func(req *http.Request, attempt int) error
+
+	// AfterResponse is called whenever the API returns a response, whatever
+	// its status code. latency is the time taken to receive the response
+	// headers.
+	AfterResponse func(req *http.Request, resp *http.Response, latency time.Duration)
+
+	// OnError is called when no response was received, such as when the
+	// connection failed, the request's context was done or a BeforeRequest
+	// hook returned an error.
+	OnError func(req *http.Request, err error, latency time.Duration)
+}
+
+// WithInterceptor is a client option that adds i to the client's chain of
+// interceptors. BeforeRequest hooks are called in the order the interceptors
+// were added, and AfterResponse and OnError hooks in the reverse order, so
+// that the first interceptor added wraps all the others.
+func WithInterceptor(i Interceptor) ClientOpt {
+	return func(c *Client) error {
+		c.interceptors = append(c.interceptors, i)
+		return nil
+	}
+}
+
+// sendAttempt sends a single attempt of req, calling the client's
+// interceptors around it.
+func (c *Client) sendAttempt(ctx context.Context, req *http.Request, attempt int) (*http.Response, error) {
+	start := time.Now()
+
+	for i, ic := range c.interceptors {
+		if ic.BeforeRequest == nil {
+			continue
+		}
+		if err := ic.BeforeRequest(req, attempt); err != nil {
+			c.interceptError(c.interceptors[:i+1], req, err, time.Since(start))
+			return nil, err
+		}
+	}
+
+	resp, err := DoRequestWithClient(attemptContext(ctx, req, attempt), c.client, req)
+	latency := time.Since(start)
+	if err != nil {
+		c.interceptError(c.interceptors, req, err, latency)
+		return nil, err
+	}
+
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		if hook := c.interceptors[i].AfterResponse; hook != nil {
+			hook(req, resp, latency)
+		}
+	}
+	return resp, nil
+}
+
+// interceptError calls the OnError hooks of chain in reverse order.
+func (c *Client) interceptError(chain []Interceptor, req *http.Request, err error, latency time.Duration) {
+	for i := len(chain) - 1; i >= 0; i-- {
+		if hook := chain[i].OnError; hook != nil {
+			hook(req, err, latency)
+		}
+	}
+}
+
+// Logger is the interface used by the debug logger. It is satisfied by
+// *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// NewLoggingInterceptor returns an Interceptor that writes every request and
+// response, including headers and body, to l along with the latency of the
+// request and the request ID reported by the API. It is intended for
+// debugging, so the Authorization header and any user_data, password or
+// private_key fields in JSON bodies are redacted.
+func NewLoggingInterceptor(l Logger) Interceptor {
+	return Interceptor{
+		BeforeRequest: func(req *http.Request, attempt int) error {
+			body, err := requestBody(req)
+			if err != nil {
+				return err
+			}
+			l.Printf("binarylane: --> %s %s (attempt %d)\n%s%s",
+				req.Method, req.URL, attempt, formatHeader(req.Header), formatBody(body))
+			return nil
+		},
+		AfterResponse: func(req *http.Request, resp *http.Response, latency time.Duration) {
+			body, err := responseBody(resp)
+			if err != nil {
+				l.Printf("binarylane: <-- %s %s: reading response body: %v", req.Method, req.URL, err)
+				return
+			}
+			l.Printf("binarylane: <-- %s %s %s (%s, request id %q)\n%s%s",
+				resp.Status, req.Method, req.URL, latency, responseRequestID(resp, body),
+				formatHeader(resp.Header), formatBody(body))
+		},
+		OnError: func(req *http.Request, err error, latency time.Duration) {
+			l.Printf("binarylane: <-- %s %s failed (%s): %v", req.Method, req.URL, latency, err)
+		},
+	}
+}
+
+// WithDebugLogger is a client option that logs every request and response
+// to l, as described by NewLoggingInterceptor.
+func WithDebugLogger(l Logger) ClientOpt {
+	return WithInterceptor(NewLoggingInterceptor(l))
+}
+
+// requestBody returns a copy of the body of req without consuming it.
+func requestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody == nil {
+		return nil, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return ioutil.ReadAll(body)
+}
+
+// responseBody reads the body of resp, replacing it so that it can be read
+// again by the caller.
+func responseBody(resp *http.Response) ([]byte, error) {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, err
+}
+
+// responseRequestID returns the request ID from the response headers, or
+// failing that from an error response body.
+func responseRequestID(resp *http.Response, body []byte) string {
+	if id := resp.Header.Get(headerRequestID); id != "" {
+		return id
+	}
+
+	var errorBody struct {
+		RequestID string `json:"request_id"`
+	}
+	_ = json.Unmarshal(body, &errorBody)
+	return errorBody.RequestID
+}
+
+// formatHeader formats h one field per line, sorted by name, with the
+// Authorization header redacted.
+func formatHeader(h http.Header) string {
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		for _, value := range h[name] {
+			if http.CanonicalHeaderKey(name) == headerAuthorization {
+				value = redacted
+			}
+			fmt.Fprintf(&b, "%s: %s\n", name, value)
+		}
+	}
+	return b.String()
+}
+
+// formatBody formats a request or response body for the log, redacting
+// sensitive fields if it is JSON.
+func formatBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err == nil {
+		if out, err := json.Marshal(redactJSON(v)); err == nil {
+			body = out
+		}
+	}
+
+	if len(body) > maxLoggedBody {
+		return fmt.Sprintf("\n%s... (%d bytes truncated)", body[:maxLoggedBody], len(body)-maxLoggedBody)
+	}
+	return "\n" + string(body)
+}
+
+// redactJSON replaces the values of sensitive fields in a decoded JSON value.
+func redactJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if sensitiveField(key) {
+				v[key] = redacted
+			} else {
+				v[key] = redactJSON(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactJSON(value)
+		}
+	}
+	return v
+}
+
+// sensitiveField reports whether a JSON field may contain a secret.
+func sensitiveField(key string) bool {
+	key = strings.ToLower(key)
+	return key == "user_data" || key == "private_key" || strings.Contains(key, "password")
+}
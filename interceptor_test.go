@@ -0,0 +1,150 @@
+package binarylane
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDo_interceptorOrder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	for _, name := range []string{"outer", "inner"} {
+		name := name
+		err := WithInterceptor(Interceptor{
+			BeforeRequest: func(req *http.Request, attempt int) error {
+				calls = append(calls, fmt.Sprintf("%s before %d", name, attempt))
+				return nil
+			},
+			AfterResponse: func(req *http.Request, resp *http.Response, latency time.Duration) {
+				calls = append(calls, fmt.Sprintf("%s after %d", name, resp.StatusCode))
+			},
+		})(client)
+		if err != nil {
+			t.Fatalf("WithInterceptor(): %v", err)
+		}
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+	if _, err := client.Do(context.Background(), req, nil); err == nil {
+		t.Fatal("Expected error")
+	}
+
+	expected := []string{"outer before 1", "inner before 1", "inner after 404", "outer after 404"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Interceptor calls = %v, expected %v", calls, expected)
+	}
+}
+
+func TestDo_interceptorTransportError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var hookErr error
+	err := WithInterceptor(Interceptor{
+		OnError: func(req *http.Request, err error, latency time.Duration) {
+			hookErr = err
+		},
+	})(client)
+	if err != nil {
+		t.Fatalf("WithInterceptor(): %v", err)
+	}
+
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.Do(cancelled, req, nil)
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	if hookErr == nil || !errors.Is(hookErr, context.Canceled) {
+		t.Errorf("OnError received %v, expected %v", hookErr, context.Canceled)
+	}
+}
+
+func TestDo_interceptorAbort(t *testing.T) {
+	setup()
+	defer teardown()
+
+	abort := errors.New("abort")
+	var hookErr error
+	err := WithInterceptor(Interceptor{
+		BeforeRequest: func(req *http.Request, attempt int) error {
+			return abort
+		},
+		OnError: func(req *http.Request, err error, latency time.Duration) {
+			hookErr = err
+		},
+	})(client)
+	if err != nil {
+		t.Fatalf("WithInterceptor(): %v", err)
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Request was sent after BeforeRequest returned an error")
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+	if _, err := client.Do(context.Background(), req, nil); err != abort {
+		t.Errorf("Do() returned %v, expected %v", err, abort)
+	}
+	if hookErr != abort {
+		t.Errorf("OnError received %v, expected %v", hookErr, abort)
+	}
+}
+
+func TestDo_debugLoggerRedacts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	if err := WithDebugLogger(log.New(&buf, "", 0))(client); err != nil {
+		t.Fatalf("WithDebugLogger(): %v", err)
+	}
+
+	mux.HandleFunc("/v2/servers", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"server":{"id":1,"password":"hunter2"}}`)
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodPost, "/v2/servers", map[string]interface{}{
+		"name":      "web",
+		"user_data": "#!/bin/sh\necho secret",
+		"backup":    map[string]string{"root_password": "secret"},
+	})
+	req.Header.Set("Authorization", "Bearer token")
+
+	var body map[string]map[string]interface{}
+	if _, err := client.Do(context.Background(), req, &body); err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+	if body["server"]["password"] != "hunter2" {
+		t.Errorf("Response body = %v, expected it to be unaffected by logging", body)
+	}
+
+	logged := buf.String()
+	for _, secret := range []string{"Bearer token", "secret", "hunter2"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("Log contains %q:\n%s", secret, logged)
+		}
+	}
+	for _, expected := range []string{"--> POST", `"name":"web"`, "<-- 202 Accepted POST", `request id "req-123"`, "Authorization: REDACTED"} {
+		if !strings.Contains(logged, expected) {
+			t.Errorf("Log does not contain %q:\n%s", expected, logged)
+		}
+	}
+}
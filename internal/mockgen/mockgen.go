@@ -0,0 +1,485 @@
+// Package mockgen generates the mocks package from the service interfaces of
+// the binarylane package.
+//
+// Every exported interface whose name ends in "Service" gets a mock built
+// on testify's mock.Mock, and every field of Client holding a service is
+// replaced with its mock by the generated NewClient. The source is read
+// with go/parser rather than go/types so that generation needs nothing
+// beyond the standard library.
+package mockgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	pkgName = "binarylane"
+	pkgPath = "github.com/binarylane/go-binarylane"
+)
+
+// Output is the generated source of the mocks package.
+type Output struct {
+	// Mocks is the source of the mocks and NewClient.
+	Mocks []byte
+
+	// Checks is the source of a test file asserting at compile time that
+	// every mock implements its interface.
+	Checks []byte
+}
+
+type service struct {
+	Name    string
+	Methods []method
+}
+
+type method struct {
+	Name    string
+	Params  []param
+	Results []string
+}
+
+type param struct {
+	Name     string
+	Type     string
+	Variadic bool
+}
+
+type clientField struct {
+	Name    string
+	Service string
+}
+
+// Signature returns the parameter list of m for its declaration.
+func (m method) Signature() string {
+	parts := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		parts[i] = p.Name + " " + p.declType()
+	}
+	return strings.Join(parts, ", ")
+}
+
+// FuncType returns the type of a function with the parameters of m and the
+// given result, which a test can return from a mocked call to compute it.
+func (m method) FuncType(result string) string {
+	parts := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		parts[i] = p.declType()
+	}
+	return "func(" + strings.Join(parts, ", ") + ") " + result
+}
+
+// Args returns the arguments of m as passed to mock.Mock.Called, with any
+// variadic arguments as a single slice.
+func (m method) Args() string {
+	names := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		names[i] = p.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// CallArgs returns the arguments of m as passed on to a function returned
+// from a mocked call.
+func (m method) CallArgs() string {
+	names := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		names[i] = p.Name
+		if p.Variadic {
+			names[i] += "..."
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// ResultList returns the result types of m for its declaration.
+func (m method) ResultList() string {
+	switch len(m.Results) {
+	case 0:
+		return ""
+	case 1:
+		return m.Results[0]
+	}
+	return "(" + strings.Join(m.Results, ", ") + ")"
+}
+
+// ResultNames returns the names of the variables holding the results of m.
+func (m method) ResultNames() string {
+	names := make([]string, len(m.Results))
+	for i := range m.Results {
+		names[i] = "r" + strconv.Itoa(i)
+	}
+	return strings.Join(names, ", ")
+}
+
+func (p param) declType() string {
+	if p.Variadic {
+		return "..." + strings.TrimPrefix(p.Type, "[]")
+	}
+	return p.Type
+}
+
+// Generate reads the binarylane package in dir and returns the source of
+// the mocks package.
+func Generate(dir string) (*Output, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	pkg, ok := pkgs[pkgName]
+	if !ok {
+		return nil, fmt.Errorf("mockgen: package %s not found in %s", pkgName, dir)
+	}
+
+	imports := map[string]string{pkgName: strconv.Quote(pkgPath), "mock": strconv.Quote("github.com/stretchr/testify/mock")}
+	var services []service
+	var fields []clientField
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				switch t := ts.Type.(type) {
+				case *ast.InterfaceType:
+					if !ts.Name.IsExported() || !strings.HasSuffix(ts.Name.Name, "Service") {
+						continue
+					}
+					s, err := parseService(ts.Name.Name, t, file, imports)
+					if err != nil {
+						return nil, err
+					}
+					services = append(services, s)
+				case *ast.StructType:
+					if ts.Name.Name == "Client" {
+						fields = clientFields(t)
+					}
+				}
+			}
+		}
+	}
+	if len(services) == 0 {
+		return nil, fmt.Errorf("mockgen: no service interfaces found in %s", dir)
+	}
+	sort.Slice(services, func(i, j int) bool { return services[i].Name < services[j].Name })
+
+	names := make(map[string]bool, len(services))
+	for _, s := range services {
+		names[s.Name] = true
+	}
+	var clientServices []clientField
+	for _, f := range fields {
+		if names[f.Service] {
+			clientServices = append(clientServices, f)
+		}
+	}
+
+	data := struct {
+		Imports  []string
+		Services []service
+		Fields   []clientField
+	}{sortedImports(imports), services, clientServices}
+
+	out := &Output{}
+	if out.Mocks, err = execute(mocksTemplate, data); err != nil {
+		return nil, err
+	}
+	if out.Checks, err = execute(checksTemplate, data); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// parseService reads the methods of the service interface named name,
+// adding the packages its method signatures refer to to imports.
+func parseService(name string, t *ast.InterfaceType, file *ast.File, imports map[string]string) (service, error) {
+	s := service{Name: name}
+	for _, field := range t.Methods.List {
+		ft, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) != 1 {
+			return s, fmt.Errorf("mockgen: %s embeds an interface, which is not supported", name)
+		}
+
+		m := method{Name: field.Names[0].Name}
+		for _, p := range fieldList(ft.Params) {
+			typ, err := typeString(p, file, imports)
+			if err != nil {
+				return s, fmt.Errorf("mockgen: %s.%s: %v", name, m.Name, err)
+			}
+			_, variadic := p.(*ast.Ellipsis)
+			m.Params = append(m.Params, param{Type: typ, Variadic: variadic})
+		}
+		for _, r := range fieldList(ft.Results) {
+			typ, err := typeString(r, file, imports)
+			if err != nil {
+				return s, fmt.Errorf("mockgen: %s.%s: %v", name, m.Name, err)
+			}
+			m.Results = append(m.Results, typ)
+		}
+
+		// Parameters are renamed so that they cannot collide with the
+		// variables of the generated method.
+		for i := range m.Params {
+			m.Params[i].Name = "_a" + strconv.Itoa(i)
+		}
+		s.Methods = append(s.Methods, m)
+	}
+	return s, nil
+}
+
+// fieldList returns the type of each value in a parameter or result list,
+// so that "a, b int" gives two types.
+func fieldList(fl *ast.FieldList) []ast.Expr {
+	if fl == nil {
+		return nil
+	}
+	var types []ast.Expr
+	for _, f := range fl.List {
+		n := len(f.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			types = append(types, f.Type)
+		}
+	}
+	return types
+}
+
+// clientFields returns the fields of Client with a named type.
+func clientFields(t *ast.StructType) []clientField {
+	var fields []clientField
+	for _, f := range t.Fields.List {
+		ident, ok := f.Type.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		for _, name := range f.Names {
+			if name.IsExported() {
+				fields = append(fields, clientField{Name: name.Name, Service: ident.Name})
+			}
+		}
+	}
+	return fields
+}
+
+// typeString formats the type expr from file as it is written in the mocks
+// package, qualifying the binarylane package's own types and adding the
+// packages it refers to to imports. Variadic types are formatted as slices.
+func typeString(expr ast.Expr, file *ast.File, imports map[string]string) (string, error) {
+	var err error
+	qualified := qualify(expr, func(pkg string) {
+		spec, ok := importSpec(file, pkg)
+		if !ok {
+			err = fmt.Errorf("unknown package %s", pkg)
+			return
+		}
+		imports[pkg] = spec
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), qualified); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// qualify returns a copy of expr with the binarylane package's exported
+// identifiers qualified, calling use for each package expr refers to.
+func qualify(expr ast.Expr, use func(pkg string)) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if e.IsExported() {
+			return &ast.SelectorExpr{X: ast.NewIdent(pkgName), Sel: ast.NewIdent(e.Name)}
+		}
+		return ast.NewIdent(e.Name)
+	case *ast.SelectorExpr:
+		pkg := e.X.(*ast.Ident).Name
+		use(pkg)
+		return &ast.SelectorExpr{X: ast.NewIdent(pkg), Sel: ast.NewIdent(e.Sel.Name)}
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualify(e.X, use)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: qualify(e.Elt, use)}
+	case *ast.Ellipsis:
+		return &ast.ArrayType{Elt: qualify(e.Elt, use)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualify(e.Key, use), Value: qualify(e.Value, use)}
+	case *ast.ChanType:
+		return &ast.ChanType{Dir: e.Dir, Value: qualify(e.Value, use)}
+	case *ast.InterfaceType:
+		// Without positions the printer splits an empty interface over two
+		// lines, so it is written out as a name instead.
+		if e.Methods == nil || len(e.Methods.List) == 0 {
+			return ast.NewIdent("interface{}")
+		}
+	case *ast.FuncType:
+		return &ast.FuncType{Params: qualifyFields(e.Params, use), Results: qualifyFields(e.Results, use)}
+	}
+	return expr
+}
+
+func qualifyFields(fl *ast.FieldList, use func(pkg string)) *ast.FieldList {
+	if fl == nil {
+		return nil
+	}
+	out := &ast.FieldList{}
+	for _, f := range fl.List {
+		out.List = append(out.List, &ast.Field{Names: f.Names, Type: qualify(f.Type, use)})
+	}
+	return out
+}
+
+// importSpec returns the import spec for the package imported by file as
+// name.
+func importSpec(file *ast.File, name string) (string, bool) {
+	for _, imp := range file.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		if imp.Name != nil {
+			if imp.Name.Name == name {
+				return name + " " + imp.Path.Value, true
+			}
+			continue
+		}
+		if path == name || strings.HasSuffix(path, "/"+name) {
+			return imp.Path.Value, true
+		}
+	}
+	return "", false
+}
+
+// sortedImports returns the import specs in imports, which maps package
+// names to specs, with standard library packages first.
+func sortedImports(imports map[string]string) []string {
+	var std, other []string
+	for _, spec := range imports {
+		if strings.Contains(spec, ".") {
+			other = append(other, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+	if len(std) > 0 && len(other) > 0 {
+		std = append(std, "")
+	}
+	return append(std, other...)
+}
+
+func execute(t *template.Template, data interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("mockgen: formatting generated source: %v", err)
+	}
+	return src, nil
+}
+
+var mocksTemplate = template.Must(template.New("mocks").Parse(`// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+{{range $s := .Services}}
+// {{$s.Name}} is a mock of binarylane.{{$s.Name}}.
+type {{$s.Name}} struct {
+	mock.Mock
+}
+
+// New{{$s.Name}} returns a mock of binarylane.{{$s.Name}}. It asserts
+// that its expectations were met when the test finishes.
+func New{{$s.Name}}(t TestingT) *{{$s.Name}} {
+	m := &{{$s.Name}}{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+{{range $m := $s.Methods}}
+// {{$m.Name}} mocks binarylane.{{$s.Name}}.{{$m.Name}}.
+func (_m *{{$s.Name}}) {{$m.Name}}({{$m.Signature}}) {{$m.ResultList}} {
+	{{if $m.Results}}ret := {{end}}_m.Called({{$m.Args}})
+{{- range $i, $r := $m.Results}}
+
+	var r{{$i}} {{$r}}
+	if rf, ok := ret.Get({{$i}}).({{$m.FuncType $r}}); ok {
+		r{{$i}} = rf({{$m.CallArgs}})
+	} else {{if eq $r "error"}}{
+		r{{$i}} = ret.Error({{$i}})
+	}{{else}}if ret.Get({{$i}}) != nil {
+		r{{$i}} = ret.Get({{$i}}).({{$r}})
+	}{{end}}
+{{- end}}
+{{- if $m.Results}}
+
+	return {{$m.ResultNames}}
+{{- end}}
+}
+{{end}}
+{{- end}}
+// Services holds the mocks of a client created by NewClient.
+type Services struct {
+{{- range .Fields}}
+	{{.Name}} *{{.Service}}
+{{- end}}
+}
+
+// NewClient returns a client whose services are all mocks, along with the
+// mocks. Each mock asserts its expectations were met when the test
+// finishes.
+func NewClient(t TestingT) (*binarylane.Client, *Services) {
+	s := &Services{
+{{- range .Fields}}
+		{{.Name}}: New{{.Service}}(t),
+{{- end}}
+	}
+
+	c := binarylane.NewClient(nil)
+{{- range .Fields}}
+	c.{{.Name}} = s.{{.Name}}
+{{- end}}
+	return c, s
+}
+`))
+
+var checksTemplate = template.Must(template.New("checks").Parse(`// Code generated by mockgen. DO NOT EDIT.
+
+package mocks_test
+
+import (
+	"github.com/binarylane/go-binarylane"
+	"github.com/binarylane/go-binarylane/mocks"
+)
+
+// Each mock must implement its interface.
+var (
+{{- range .Services}}
+	_ binarylane.{{.Name}} = (*mocks.{{.Name}})(nil)
+{{- end}}
+)
+`))
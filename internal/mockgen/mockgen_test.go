@@ -0,0 +1,92 @@
+package mockgen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testSource = `package binarylane
+
+import (
+	"context"
+	nethttp "net/http"
+)
+
+type Client struct {
+	Widgets WidgetsService
+	Name    string
+	other   WidgetsService
+}
+
+type WidgetsService interface {
+	Get(ctx context.Context, id int) (*Widget, *Response, error)
+	Tag(context.Context, string, ...interface{}) error
+	Headers(a, b nethttp.Header) map[string][]Widget
+}
+
+type widgetsService interface {
+	Get(context.Context) error
+}
+
+type Widget struct{}
+
+type Response struct{}
+`
+
+func writeSource(t *testing.T, src string) string {
+	dir, err := ioutil.TempDir("", "mockgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	if err := ioutil.WriteFile(filepath.Join(dir, "widgets.go"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestGenerate(t *testing.T) {
+	out, err := Generate(writeSource(t, testSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mocks := string(out.Mocks)
+	for _, expected := range []string{
+		"\t\"context\"\n\tnethttp \"net/http\"\n\n\t\"github.com/binarylane/go-binarylane\"\n",
+		"type WidgetsService struct {\n\tmock.Mock\n}",
+		"func (_m *WidgetsService) Get(_a0 context.Context, _a1 int) (*binarylane.Widget, *binarylane.Response, error) {",
+		"func (_m *WidgetsService) Tag(_a0 context.Context, _a1 string, _a2 ...interface{}) error {\n\tret := _m.Called(_a0, _a1, _a2)",
+		"r0 = rf(_a0, _a1, _a2...)",
+		"func (_m *WidgetsService) Headers(_a0 nethttp.Header, _a1 nethttp.Header) map[string][]binarylane.Widget {",
+		"Widgets *WidgetsService\n}",
+		"c.Widgets = s.Widgets",
+	} {
+		if !strings.Contains(mocks, expected) {
+			t.Errorf("Generated mocks do not contain %q:\n%s", expected, mocks)
+		}
+	}
+	if strings.Contains(mocks, "widgetsService") || strings.Contains(mocks, "c.other") {
+		t.Errorf("Generated mocks include unexported types or fields:\n%s", mocks)
+	}
+
+	if !strings.Contains(string(out.Checks), "_ binarylane.WidgetsService = (*mocks.WidgetsService)(nil)") {
+		t.Errorf("Generated checks:\n%s", out.Checks)
+	}
+}
+
+func TestGenerate_embeddedInterface(t *testing.T) {
+	src := "package binarylane\n\ntype BaseService interface{}\n\ntype WidgetsService interface {\n\tBaseService\n}\n"
+	if _, err := Generate(writeSource(t, src)); err == nil || !strings.Contains(err.Error(), "embeds") {
+		t.Errorf("Generate() returned %v, expected embedding to be rejected", err)
+	}
+}
+
+func TestGenerate_noServices(t *testing.T) {
+	if _, err := Generate(writeSource(t, "package binarylane\n")); err == nil {
+		t.Error("Generate(): expected an error")
+	}
+}
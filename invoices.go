@@ -117,6 +117,7 @@ func (i Invoice) String() string {
 
 // Get detailed invoice items for an Invoice
 func (s *InvoicesServiceOp) Get(ctx context.Context, invoiceUUID string, opt *ListOptions) (*Invoice, *Response, error) {
+	ctx = withOperation(ctx, "Invoices.Get")
 	path := fmt.Sprintf("%s/%s", invoicesBasePath, invoiceUUID)
 	path, err := addOptions(path, opt)
 	if err != nil {
@@ -145,6 +146,7 @@ func (s *InvoicesServiceOp) Get(ctx context.Context, invoiceUUID string, opt *Li
 
 // List invoices for a customer
 func (s *InvoicesServiceOp) List(ctx context.Context, opt *ListOptions) (*InvoiceList, *Response, error) {
+	ctx = withOperation(ctx, "Invoices.List")
 	path := invoicesBasePath
 	path, err := addOptions(path, opt)
 	if err != nil {
@@ -173,6 +175,7 @@ func (s *InvoicesServiceOp) List(ctx context.Context, opt *ListOptions) (*Invoic
 
 // GetSummary returns a summary of metadata and summarized usage for an Invoice
 func (s *InvoicesServiceOp) GetSummary(ctx context.Context, invoiceUUID string) (*InvoiceSummary, *Response, error) {
+	ctx = withOperation(ctx, "Invoices.GetSummary")
 	path := fmt.Sprintf("%s/%s/summary", invoicesBasePath, invoiceUUID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -191,6 +194,7 @@ func (s *InvoicesServiceOp) GetSummary(ctx context.Context, invoiceUUID string)
 
 // GetPDF returns the pdf for an Invoice
 func (s *InvoicesServiceOp) GetPDF(ctx context.Context, invoiceUUID string) ([]byte, *Response, error) {
+	ctx = withOperation(ctx, "Invoices.GetPDF")
 	path := fmt.Sprintf("%s/%s/pdf", invoicesBasePath, invoiceUUID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -209,6 +213,7 @@ func (s *InvoicesServiceOp) GetPDF(ctx context.Context, invoiceUUID string) ([]b
 
 // GetCSV returns the csv for an Invoice
 func (s *InvoicesServiceOp) GetCSV(ctx context.Context, invoiceUUID string) ([]byte, *Response, error) {
+	ctx = withOperation(ctx, "Invoices.GetCSV")
 	path := fmt.Sprintf("%s/%s/csv", invoicesBasePath, invoiceUUID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
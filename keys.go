@@ -13,6 +13,7 @@ const keysBasePath = "v2/account/keys"
 // See: https://api.binarylane.com.au/reference#keys
 type KeysService interface {
 	List(context.Context, *ListOptions) ([]Key, *Response, error)
+	ListAll(context.Context) ([]Key, error)
 	GetByID(context.Context, int) (*Key, *Response, error)
 	GetByFingerprint(context.Context, string) (*Key, *Response, error)
 	Create(context.Context, *KeyCreateRequest) (*Key, *Response, error)
@@ -65,6 +66,7 @@ type KeyCreateRequest struct {
 
 // List all keys
 func (s *KeysServiceOp) List(ctx context.Context, opt *ListOptions) ([]Key, *Response, error) {
+	ctx = withOperation(ctx, "Keys.List")
 	path := keysBasePath
 	path, err := addOptions(path, opt)
 	if err != nil {
@@ -91,6 +93,21 @@ func (s *KeysServiceOp) List(ctx context.Context, opt *ListOptions) ([]Key, *Res
 	return root.SSHKeys, resp, err
 }
 
+// ListAll lists all keys, fetching every page of results.
+func (s *KeysServiceOp) ListAll(ctx context.Context) ([]Key, error) {
+	var list []Key
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := s.List(ctx, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
 // Performs a get given a path
 func (s *KeysServiceOp) get(ctx context.Context, path string) (*Key, *Response, error) {
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -109,6 +126,7 @@ func (s *KeysServiceOp) get(ctx context.Context, path string) (*Key, *Response,
 
 // GetByID gets a Key by id
 func (s *KeysServiceOp) GetByID(ctx context.Context, keyID int) (*Key, *Response, error) {
+	ctx = withOperation(ctx, "Keys.GetByID")
 	if keyID < 1 {
 		return nil, nil, NewArgError("keyID", "cannot be less than 1")
 	}
@@ -119,6 +137,7 @@ func (s *KeysServiceOp) GetByID(ctx context.Context, keyID int) (*Key, *Response
 
 // GetByFingerprint gets a Key by by fingerprint
 func (s *KeysServiceOp) GetByFingerprint(ctx context.Context, fingerprint string) (*Key, *Response, error) {
+	ctx = withOperation(ctx, "Keys.GetByFingerprint")
 	if len(fingerprint) < 1 {
 		return nil, nil, NewArgError("fingerprint", "cannot not be empty")
 	}
@@ -129,6 +148,7 @@ func (s *KeysServiceOp) GetByFingerprint(ctx context.Context, fingerprint string
 
 // Create a key using a KeyCreateRequest
 func (s *KeysServiceOp) Create(ctx context.Context, createRequest *KeyCreateRequest) (*Key, *Response, error) {
+	ctx = withOperation(ctx, "Keys.Create")
 	if createRequest == nil {
 		return nil, nil, NewArgError("createRequest", "cannot be nil")
 	}
@@ -149,6 +169,7 @@ func (s *KeysServiceOp) Create(ctx context.Context, createRequest *KeyCreateRequ
 
 // UpdateByID updates a key name by ID.
 func (s *KeysServiceOp) UpdateByID(ctx context.Context, keyID int, updateRequest *KeyUpdateRequest) (*Key, *Response, error) {
+	ctx = withOperation(ctx, "Keys.UpdateByID")
 	if keyID < 1 {
 		return nil, nil, NewArgError("keyID", "cannot be less than 1")
 	}
@@ -174,6 +195,7 @@ func (s *KeysServiceOp) UpdateByID(ctx context.Context, keyID int, updateRequest
 
 // UpdateByFingerprint updates a key name by fingerprint.
 func (s *KeysServiceOp) UpdateByFingerprint(ctx context.Context, fingerprint string, updateRequest *KeyUpdateRequest) (*Key, *Response, error) {
+	ctx = withOperation(ctx, "Keys.UpdateByFingerprint")
 	if len(fingerprint) < 1 {
 		return nil, nil, NewArgError("fingerprint", "cannot be empty")
 	}
@@ -211,6 +233,7 @@ func (s *KeysServiceOp) delete(ctx context.Context, path string) (*Response, err
 
 // DeleteByID deletes a key by its id
 func (s *KeysServiceOp) DeleteByID(ctx context.Context, keyID int) (*Response, error) {
+	ctx = withOperation(ctx, "Keys.DeleteByID")
 	if keyID < 1 {
 		return nil, NewArgError("keyID", "cannot be less than 1")
 	}
@@ -221,6 +244,7 @@ func (s *KeysServiceOp) DeleteByID(ctx context.Context, keyID int) (*Response, e
 
 // DeleteByFingerprint deletes a key by its fingerprint
 func (s *KeysServiceOp) DeleteByFingerprint(ctx context.Context, fingerprint string) (*Response, error) {
+	ctx = withOperation(ctx, "Keys.DeleteByFingerprint")
 	if len(fingerprint) < 1 {
 		return nil, NewArgError("fingerprint", "cannot be empty")
 	}
@@ -16,6 +16,7 @@ const serversPath = "servers"
 type LoadBalancersService interface {
 	Get(context.Context, int) (*LoadBalancer, *Response, error)
 	List(context.Context, *ListOptions) ([]LoadBalancer, *Response, error)
+	ListAll(context.Context) ([]LoadBalancer, error)
 	Create(context.Context, *LoadBalancerRequest) (*LoadBalancer, *Response, error)
 	Update(ctx context.Context, lbID int, lbr *LoadBalancerRequest) (*LoadBalancer, *Response, error)
 	Delete(ctx context.Context, lbID int) (*Response, error)
@@ -190,6 +191,7 @@ var _ LoadBalancersService = &LoadBalancersServiceOp{}
 
 // Get an existing load balancer by its identifier.
 func (l *LoadBalancersServiceOp) Get(ctx context.Context, lbID int) (*LoadBalancer, *Response, error) {
+	ctx = withOperation(ctx, "LoadBalancers.Get")
 	path := fmt.Sprintf("%s/%d", loadBalancersBasePath, lbID)
 
 	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -208,6 +210,7 @@ func (l *LoadBalancersServiceOp) Get(ctx context.Context, lbID int) (*LoadBalanc
 
 // List load balancers, with optional pagination.
 func (l *LoadBalancersServiceOp) List(ctx context.Context, opt *ListOptions) ([]LoadBalancer, *Response, error) {
+	ctx = withOperation(ctx, "LoadBalancers.List")
 	path, err := addOptions(loadBalancersBasePath, opt)
 	if err != nil {
 		return nil, nil, err
@@ -233,8 +236,24 @@ func (l *LoadBalancersServiceOp) List(ctx context.Context, opt *ListOptions) ([]
 	return root.LoadBalancers, resp, err
 }
 
+// ListAll lists all load balancers, fetching every page of results.
+func (l *LoadBalancersServiceOp) ListAll(ctx context.Context) ([]LoadBalancer, error) {
+	var list []LoadBalancer
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := l.List(ctx, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
 // Create a new load balancer with a given configuration.
 func (l *LoadBalancersServiceOp) Create(ctx context.Context, lbr *LoadBalancerRequest) (*LoadBalancer, *Response, error) {
+	ctx = withOperation(ctx, "LoadBalancers.Create")
 	req, err := l.client.NewRequest(ctx, http.MethodPost, loadBalancersBasePath, lbr)
 	if err != nil {
 		return nil, nil, err
@@ -251,6 +270,7 @@ func (l *LoadBalancersServiceOp) Create(ctx context.Context, lbr *LoadBalancerRe
 
 // Update an existing load balancer with new configuration.
 func (l *LoadBalancersServiceOp) Update(ctx context.Context, lbID int, lbr *LoadBalancerRequest) (*LoadBalancer, *Response, error) {
+	ctx = withOperation(ctx, "LoadBalancers.Update")
 	path := fmt.Sprintf("%s/%d", loadBalancersBasePath, lbID)
 
 	req, err := l.client.NewRequest(ctx, "PUT", path, lbr)
@@ -269,6 +289,7 @@ func (l *LoadBalancersServiceOp) Update(ctx context.Context, lbID int, lbr *Load
 
 // Delete a load balancer by its identifier.
 func (l *LoadBalancersServiceOp) Delete(ctx context.Context, ldID int) (*Response, error) {
+	ctx = withOperation(ctx, "LoadBalancers.Delete")
 	path := fmt.Sprintf("%s/%d", loadBalancersBasePath, ldID)
 
 	req, err := l.client.NewRequest(ctx, http.MethodDelete, path, nil)
@@ -281,6 +302,7 @@ func (l *LoadBalancersServiceOp) Delete(ctx context.Context, ldID int) (*Respons
 
 // AddServers adds servers to a load balancer.
 func (l *LoadBalancersServiceOp) AddServers(ctx context.Context, lbID int, serverIDs ...int) (*Response, error) {
+	ctx = withOperation(ctx, "LoadBalancers.AddServers")
 	path := fmt.Sprintf("%s/%d/%s", loadBalancersBasePath, lbID, serversPath)
 
 	req, err := l.client.NewRequest(ctx, http.MethodPost, path, &serverIDsRequest{IDs: serverIDs})
@@ -293,6 +315,7 @@ func (l *LoadBalancersServiceOp) AddServers(ctx context.Context, lbID int, serve
 
 // RemoveServers removes servers from a load balancer.
 func (l *LoadBalancersServiceOp) RemoveServers(ctx context.Context, lbID int, serverIDs ...int) (*Response, error) {
+	ctx = withOperation(ctx, "LoadBalancers.RemoveServers")
 	path := fmt.Sprintf("%s/%d/%s", loadBalancersBasePath, lbID, serversPath)
 
 	req, err := l.client.NewRequest(ctx, http.MethodDelete, path, &serverIDsRequest{IDs: serverIDs})
@@ -305,6 +328,7 @@ func (l *LoadBalancersServiceOp) RemoveServers(ctx context.Context, lbID int, se
 
 // AddForwardingRules adds forwarding rules to a load balancer.
 func (l *LoadBalancersServiceOp) AddForwardingRules(ctx context.Context, lbID int, rules ...ForwardingRule) (*Response, error) {
+	ctx = withOperation(ctx, "LoadBalancers.AddForwardingRules")
 	path := fmt.Sprintf("%s/%d/%s", loadBalancersBasePath, lbID, forwardingRulesPath)
 
 	req, err := l.client.NewRequest(ctx, http.MethodPost, path, &forwardingRulesRequest{Rules: rules})
@@ -317,6 +341,7 @@ func (l *LoadBalancersServiceOp) AddForwardingRules(ctx context.Context, lbID in
 
 // RemoveForwardingRules removes forwarding rules from a load balancer.
 func (l *LoadBalancersServiceOp) RemoveForwardingRules(ctx context.Context, lbID int, rules ...ForwardingRule) (*Response, error) {
+	ctx = withOperation(ctx, "LoadBalancers.RemoveForwardingRules")
 	path := fmt.Sprintf("%s/%d/%s", loadBalancersBasePath, lbID, forwardingRulesPath)
 
 	req, err := l.client.NewRequest(ctx, http.MethodDelete, path, &forwardingRulesRequest{Rules: rules})
@@ -0,0 +1,26 @@
+//go:build ignore
+// +build ignore
+
+// This program generates services.go and services_test.go from the service
+// interfaces of the binarylane package. It is run by go generate.
+package main
+
+import (
+	"io/ioutil"
+	"log"
+
+	"github.com/binarylane/go-binarylane/internal/mockgen"
+)
+
+func main() {
+	out, err := mockgen.Generate("..")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("services.go", out.Mocks, 0644); err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("services_test.go", out.Checks, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
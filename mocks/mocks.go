@@ -0,0 +1,27 @@
+// Package mocks provides mocks of the go-binarylane service interfaces, for
+// testing code that uses the client without an API or a fake of one.
+//
+// Each mock is built on testify's mock.Mock. Set expectations with On, and
+// give the values to return with Return, either directly or as a function
+// with the method's parameters that computes them. Variadic arguments are
+// matched as a single slice.
+//
+//	client, m := mocks.NewClient(t)
+//	m.Servers.On("Get", mock.Anything, 123).Return(&binarylane.Server{ID: 123}, nil, nil)
+//
+//	server, _, err := client.Servers.Get(ctx, 123)
+//
+// The mocks are generated from the interfaces by gen.go, so that they stay
+// in step with the client. Run go generate after changing a service
+// interface.
+package mocks
+
+//go:generate go run gen.go
+
+import "github.com/stretchr/testify/mock"
+
+// TestingT is the subset of testing.TB used by the mocks.
+type TestingT interface {
+	mock.TestingT
+	Cleanup(func())
+}
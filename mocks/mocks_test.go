@@ -0,0 +1,103 @@
+package mocks_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/binarylane/go-binarylane"
+	"github.com/binarylane/go-binarylane/internal/mockgen"
+	"github.com/binarylane/go-binarylane/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+var ctx = context.TODO()
+
+func TestGenerated(t *testing.T) {
+	out, err := mockgen.Generate("..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for file, expected := range map[string][]byte{"services.go": out.Mocks, "services_test.go": out.Checks} {
+		got, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, expected) {
+			t.Errorf("%s is out of date with the service interfaces; run go generate ./mocks", file)
+		}
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	client, m := mocks.NewClient(t)
+
+	m.Servers.On("Get", mock.Anything, 123).Return(&binarylane.Server{ID: 123, Name: "web"}, nil, nil).Once()
+	m.Domains.On("Get", mock.Anything, "missing.example").Return(nil, nil, fmt.Errorf("not found"))
+
+	server, _, err := client.Servers.Get(ctx, 123)
+	if err != nil || server.Name != "web" {
+		t.Errorf("Servers.Get returned %+v, %v", server, err)
+	}
+	domain, _, err := client.Domains.Get(ctx, "missing.example")
+	if err == nil || domain != nil {
+		t.Errorf("Domains.Get returned %+v, %v, expected the mocked error", domain, err)
+	}
+}
+
+func TestMock_returnFunc(t *testing.T) {
+	keys := mocks.NewKeysService(t)
+	keys.On("Create", mock.Anything, mock.AnythingOfType("*binarylane.KeyCreateRequest")).Return(
+		func(_ context.Context, req *binarylane.KeyCreateRequest) *binarylane.Key {
+			return &binarylane.Key{ID: 1, Name: req.Name}
+		},
+		nil, nil)
+
+	key, _, err := keys.Create(ctx, &binarylane.KeyCreateRequest{Name: "laptop"})
+	if err != nil || key.Name != "laptop" {
+		t.Errorf("Keys.Create returned %+v, %v", key, err)
+	}
+}
+
+func TestMock_variadic(t *testing.T) {
+	firewalls := mocks.NewFirewallsService(t)
+	firewalls.On("AddServers", mock.Anything, "fw-1", []int{1, 2}).Return(nil, nil)
+
+	if _, err := firewalls.AddServers(ctx, "fw-1", 1, 2); err != nil {
+		t.Errorf("Firewalls.AddServers returned %v", err)
+	}
+}
+
+// recordingT is a mocks.TestingT that records failures instead of failing
+// the test.
+type recordingT struct {
+	errors   []string
+	cleanups []func()
+}
+
+func (r *recordingT) Logf(format string, args ...interface{}) {}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingT) FailNow() {}
+
+func (r *recordingT) Cleanup(f func()) {
+	r.cleanups = append(r.cleanups, f)
+}
+
+func TestMock_unmetExpectations(t *testing.T) {
+	rt := &recordingT{}
+	servers := mocks.NewServersService(rt)
+	servers.On("Delete", mock.Anything, 7).Return(nil, nil)
+
+	for _, f := range rt.cleanups {
+		f()
+	}
+	if len(rt.errors) == 0 {
+		t.Error("Cleanup did not report the unmet expectation")
+	}
+}
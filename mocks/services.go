@@ -0,0 +1,5046 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/binarylane/go-binarylane"
+	"github.com/stretchr/testify/mock"
+)
+
+// AccountService is a mock of binarylane.AccountService.
+type AccountService struct {
+	mock.Mock
+}
+
+// NewAccountService returns a mock of binarylane.AccountService. It asserts
+// that its expectations were met when the test finishes.
+func NewAccountService(t TestingT) *AccountService {
+	m := &AccountService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Get mocks binarylane.AccountService.Get.
+func (_m *AccountService) Get(_a0 context.Context) (*binarylane.Account, *binarylane.Response, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *binarylane.Account
+	if rf, ok := ret.Get(0).(func(context.Context) *binarylane.Account); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Account)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context) *binarylane.Response); ok {
+		r1 = rf(_a0)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(_a0)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ActionsService is a mock of binarylane.ActionsService.
+type ActionsService struct {
+	mock.Mock
+}
+
+// NewActionsService returns a mock of binarylane.ActionsService. It asserts
+// that its expectations were met when the test finishes.
+func NewActionsService(t TestingT) *ActionsService {
+	m := &ActionsService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// List mocks binarylane.ActionsService.List.
+func (_m *ActionsService) List(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListAll mocks binarylane.ActionsService.ListAll.
+func (_m *ActionsService) ListAll(_a0 context.Context) ([]binarylane.Action, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context) []binarylane.Action); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Action)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get mocks binarylane.ActionsService.Get.
+func (_m *ActionsService) Get(_a0 context.Context, _a1 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// BalanceService is a mock of binarylane.BalanceService.
+type BalanceService struct {
+	mock.Mock
+}
+
+// NewBalanceService returns a mock of binarylane.BalanceService. It asserts
+// that its expectations were met when the test finishes.
+func NewBalanceService(t TestingT) *BalanceService {
+	m := &BalanceService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Get mocks binarylane.BalanceService.Get.
+func (_m *BalanceService) Get(_a0 context.Context) (*binarylane.Balance, *binarylane.Response, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *binarylane.Balance
+	if rf, ok := ret.Get(0).(func(context.Context) *binarylane.Balance); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Balance)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context) *binarylane.Response); ok {
+		r1 = rf(_a0)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(_a0)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// BillingHistoryService is a mock of binarylane.BillingHistoryService.
+type BillingHistoryService struct {
+	mock.Mock
+}
+
+// NewBillingHistoryService returns a mock of binarylane.BillingHistoryService. It asserts
+// that its expectations were met when the test finishes.
+func NewBillingHistoryService(t TestingT) *BillingHistoryService {
+	m := &BillingHistoryService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// List mocks binarylane.BillingHistoryService.List.
+func (_m *BillingHistoryService) List(_a0 context.Context, _a1 *binarylane.ListOptions) (*binarylane.BillingHistory, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.BillingHistory
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) *binarylane.BillingHistory); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.BillingHistory)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CertificatesService is a mock of binarylane.CertificatesService.
+type CertificatesService struct {
+	mock.Mock
+}
+
+// NewCertificatesService returns a mock of binarylane.CertificatesService. It asserts
+// that its expectations were met when the test finishes.
+func NewCertificatesService(t TestingT) *CertificatesService {
+	m := &CertificatesService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// List mocks binarylane.CertificatesService.List.
+func (_m *CertificatesService) List(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.Certificate, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Certificate
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.Certificate); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Certificate)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListAll mocks binarylane.CertificatesService.ListAll.
+func (_m *CertificatesService) ListAll(_a0 context.Context) ([]binarylane.Certificate, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []binarylane.Certificate
+	if rf, ok := ret.Get(0).(func(context.Context) []binarylane.Certificate); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Certificate)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get mocks binarylane.CertificatesService.Get.
+func (_m *CertificatesService) Get(_a0 context.Context, _a1 string) (*binarylane.Certificate, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Certificate
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Certificate); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Certificate)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Create mocks binarylane.CertificatesService.Create.
+func (_m *CertificatesService) Create(_a0 context.Context, _a1 *binarylane.CertificateRequest) (*binarylane.Certificate, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Certificate
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.CertificateRequest) *binarylane.Certificate); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Certificate)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.CertificateRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.CertificateRequest) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Delete mocks binarylane.CertificatesService.Delete.
+func (_m *CertificatesService) Delete(_a0 context.Context, _a1 string) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DomainsService is a mock of binarylane.DomainsService.
+type DomainsService struct {
+	mock.Mock
+}
+
+// NewDomainsService returns a mock of binarylane.DomainsService. It asserts
+// that its expectations were met when the test finishes.
+func NewDomainsService(t TestingT) *DomainsService {
+	m := &DomainsService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// List mocks binarylane.DomainsService.List.
+func (_m *DomainsService) List(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.Domain, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Domain
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.Domain); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Domain)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListAll mocks binarylane.DomainsService.ListAll.
+func (_m *DomainsService) ListAll(_a0 context.Context) ([]binarylane.Domain, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []binarylane.Domain
+	if rf, ok := ret.Get(0).(func(context.Context) []binarylane.Domain); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Domain)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get mocks binarylane.DomainsService.Get.
+func (_m *DomainsService) Get(_a0 context.Context, _a1 string) (*binarylane.Domain, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Domain
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Domain); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Domain)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Create mocks binarylane.DomainsService.Create.
+func (_m *DomainsService) Create(_a0 context.Context, _a1 *binarylane.DomainCreateRequest) (*binarylane.Domain, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Domain
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.DomainCreateRequest) *binarylane.Domain); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Domain)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.DomainCreateRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.DomainCreateRequest) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Delete mocks binarylane.DomainsService.Delete.
+func (_m *DomainsService) Delete(_a0 context.Context, _a1 string) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Records mocks binarylane.DomainsService.Records.
+func (_m *DomainsService) Records(_a0 context.Context, _a1 string, _a2 *binarylane.ListOptions) ([]binarylane.DomainRecord, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []binarylane.DomainRecord
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.ListOptions) []binarylane.DomainRecord); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.DomainRecord)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RecordsAll mocks binarylane.DomainsService.RecordsAll.
+func (_m *DomainsService) RecordsAll(_a0 context.Context, _a1 string) ([]binarylane.DomainRecord, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.DomainRecord
+	if rf, ok := ret.Get(0).(func(context.Context, string) []binarylane.DomainRecord); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.DomainRecord)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordsByType mocks binarylane.DomainsService.RecordsByType.
+func (_m *DomainsService) RecordsByType(_a0 context.Context, _a1 string, _a2 string, _a3 *binarylane.ListOptions) ([]binarylane.DomainRecord, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 []binarylane.DomainRecord
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *binarylane.ListOptions) []binarylane.DomainRecord); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.DomainRecord)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RecordsByName mocks binarylane.DomainsService.RecordsByName.
+func (_m *DomainsService) RecordsByName(_a0 context.Context, _a1 string, _a2 string, _a3 *binarylane.ListOptions) ([]binarylane.DomainRecord, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 []binarylane.DomainRecord
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *binarylane.ListOptions) []binarylane.DomainRecord); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.DomainRecord)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RecordsByTypeAndName mocks binarylane.DomainsService.RecordsByTypeAndName.
+func (_m *DomainsService) RecordsByTypeAndName(_a0 context.Context, _a1 string, _a2 string, _a3 string, _a4 *binarylane.ListOptions) ([]binarylane.DomainRecord, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 []binarylane.DomainRecord
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *binarylane.ListOptions) []binarylane.DomainRecord); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.DomainRecord)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, string, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Record mocks binarylane.DomainsService.Record.
+func (_m *DomainsService) Record(_a0 context.Context, _a1 string, _a2 int) (*binarylane.DomainRecord, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.DomainRecord
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *binarylane.DomainRecord); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.DomainRecord)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, int) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// DeleteRecord mocks binarylane.DomainsService.DeleteRecord.
+func (_m *DomainsService) DeleteRecord(_a0 context.Context, _a1 string, _a2 int) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EditRecord mocks binarylane.DomainsService.EditRecord.
+func (_m *DomainsService) EditRecord(_a0 context.Context, _a1 string, _a2 int, _a3 *binarylane.DomainRecordEditRequest) (*binarylane.DomainRecord, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *binarylane.DomainRecord
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, *binarylane.DomainRecordEditRequest) *binarylane.DomainRecord); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.DomainRecord)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, *binarylane.DomainRecordEditRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, int, *binarylane.DomainRecordEditRequest) error); ok {
+		r2 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CreateRecord mocks binarylane.DomainsService.CreateRecord.
+func (_m *DomainsService) CreateRecord(_a0 context.Context, _a1 string, _a2 *binarylane.DomainRecordEditRequest) (*binarylane.DomainRecord, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.DomainRecord
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.DomainRecordEditRequest) *binarylane.DomainRecord); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.DomainRecord)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.DomainRecordEditRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *binarylane.DomainRecordEditRequest) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PlanRecords mocks binarylane.DomainsService.PlanRecords.
+func (_m *DomainsService) PlanRecords(_a0 context.Context, _a1 string, _a2 []binarylane.DomainRecordEditRequest) (*binarylane.DomainRecordsPlan, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.DomainRecordsPlan
+	if rf, ok := ret.Get(0).(func(context.Context, string, []binarylane.DomainRecordEditRequest) *binarylane.DomainRecordsPlan); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.DomainRecordsPlan)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []binarylane.DomainRecordEditRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SyncRecords mocks binarylane.DomainsService.SyncRecords.
+func (_m *DomainsService) SyncRecords(_a0 context.Context, _a1 string, _a2 []binarylane.DomainRecordEditRequest) (*binarylane.DomainRecordsPlan, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.DomainRecordsPlan
+	if rf, ok := ret.Get(0).(func(context.Context, string, []binarylane.DomainRecordEditRequest) *binarylane.DomainRecordsPlan); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.DomainRecordsPlan)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []binarylane.DomainRecordEditRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FirewallsService is a mock of binarylane.FirewallsService.
+type FirewallsService struct {
+	mock.Mock
+}
+
+// NewFirewallsService returns a mock of binarylane.FirewallsService. It asserts
+// that its expectations were met when the test finishes.
+func NewFirewallsService(t TestingT) *FirewallsService {
+	m := &FirewallsService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Get mocks binarylane.FirewallsService.Get.
+func (_m *FirewallsService) Get(_a0 context.Context, _a1 string) (*binarylane.Firewall, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Firewall
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Firewall); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Firewall)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Create mocks binarylane.FirewallsService.Create.
+func (_m *FirewallsService) Create(_a0 context.Context, _a1 *binarylane.FirewallRequest) (*binarylane.Firewall, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Firewall
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.FirewallRequest) *binarylane.Firewall); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Firewall)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.FirewallRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.FirewallRequest) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Update mocks binarylane.FirewallsService.Update.
+func (_m *FirewallsService) Update(_a0 context.Context, _a1 string, _a2 *binarylane.FirewallRequest) (*binarylane.Firewall, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Firewall
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.FirewallRequest) *binarylane.Firewall); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Firewall)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.FirewallRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *binarylane.FirewallRequest) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Delete mocks binarylane.FirewallsService.Delete.
+func (_m *FirewallsService) Delete(_a0 context.Context, _a1 string) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List mocks binarylane.FirewallsService.List.
+func (_m *FirewallsService) List(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.Firewall, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Firewall
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.Firewall); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Firewall)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListAll mocks binarylane.FirewallsService.ListAll.
+func (_m *FirewallsService) ListAll(_a0 context.Context) ([]binarylane.Firewall, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []binarylane.Firewall
+	if rf, ok := ret.Get(0).(func(context.Context) []binarylane.Firewall); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Firewall)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByServer mocks binarylane.FirewallsService.ListByServer.
+func (_m *FirewallsService) ListByServer(_a0 context.Context, _a1 int, _a2 *binarylane.ListOptions) ([]binarylane.Firewall, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []binarylane.Firewall
+	if rf, ok := ret.Get(0).(func(context.Context, int, *binarylane.ListOptions) []binarylane.Firewall); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Firewall)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// AddServers mocks binarylane.FirewallsService.AddServers.
+func (_m *FirewallsService) AddServers(_a0 context.Context, _a1 string, _a2 ...int) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...int) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...int) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveServers mocks binarylane.FirewallsService.RemoveServers.
+func (_m *FirewallsService) RemoveServers(_a0 context.Context, _a1 string, _a2 ...int) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...int) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...int) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddTags mocks binarylane.FirewallsService.AddTags.
+func (_m *FirewallsService) AddTags(_a0 context.Context, _a1 string, _a2 ...string) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...string) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...string) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveTags mocks binarylane.FirewallsService.RemoveTags.
+func (_m *FirewallsService) RemoveTags(_a0 context.Context, _a1 string, _a2 ...string) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...string) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...string) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddRules mocks binarylane.FirewallsService.AddRules.
+func (_m *FirewallsService) AddRules(_a0 context.Context, _a1 string, _a2 *binarylane.FirewallRulesRequest) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.FirewallRulesRequest) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.FirewallRulesRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveRules mocks binarylane.FirewallsService.RemoveRules.
+func (_m *FirewallsService) RemoveRules(_a0 context.Context, _a1 string, _a2 *binarylane.FirewallRulesRequest) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.FirewallRulesRequest) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.FirewallRulesRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Plan mocks binarylane.FirewallsService.Plan.
+func (_m *FirewallsService) Plan(_a0 context.Context, _a1 string, _a2 *binarylane.FirewallRequest) (*binarylane.FirewallPlan, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.FirewallPlan
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.FirewallRequest) *binarylane.FirewallPlan); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.FirewallPlan)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.FirewallRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *binarylane.FirewallRequest) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Reconcile mocks binarylane.FirewallsService.Reconcile.
+func (_m *FirewallsService) Reconcile(_a0 context.Context, _a1 string, _a2 *binarylane.FirewallRequest) (*binarylane.FirewallPlan, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.FirewallPlan
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.FirewallRequest) *binarylane.FirewallPlan); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.FirewallPlan)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.FirewallRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *binarylane.FirewallRequest) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FloatingIPActionsService is a mock of binarylane.FloatingIPActionsService.
+type FloatingIPActionsService struct {
+	mock.Mock
+}
+
+// NewFloatingIPActionsService returns a mock of binarylane.FloatingIPActionsService. It asserts
+// that its expectations were met when the test finishes.
+func NewFloatingIPActionsService(t TestingT) *FloatingIPActionsService {
+	m := &FloatingIPActionsService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Assign mocks binarylane.FloatingIPActionsService.Assign.
+func (_m *FloatingIPActionsService) Assign(_a0 context.Context, _a1 string, _a2 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, int) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Unassign mocks binarylane.FloatingIPActionsService.Unassign.
+func (_m *FloatingIPActionsService) Unassign(_a0 context.Context, _a1 string) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Get mocks binarylane.FloatingIPActionsService.Get.
+func (_m *FloatingIPActionsService) Get(_a0 context.Context, _a1 string, _a2 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, int) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// List mocks binarylane.FloatingIPActionsService.List.
+func (_m *FloatingIPActionsService) List(_a0 context.Context, _a1 string, _a2 *binarylane.ListOptions) ([]binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.ListOptions) []binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FloatingIPsService is a mock of binarylane.FloatingIPsService.
+type FloatingIPsService struct {
+	mock.Mock
+}
+
+// NewFloatingIPsService returns a mock of binarylane.FloatingIPsService. It asserts
+// that its expectations were met when the test finishes.
+func NewFloatingIPsService(t TestingT) *FloatingIPsService {
+	m := &FloatingIPsService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// List mocks binarylane.FloatingIPsService.List.
+func (_m *FloatingIPsService) List(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.FloatingIP, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.FloatingIP
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.FloatingIP); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.FloatingIP)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListAll mocks binarylane.FloatingIPsService.ListAll.
+func (_m *FloatingIPsService) ListAll(_a0 context.Context) ([]binarylane.FloatingIP, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []binarylane.FloatingIP
+	if rf, ok := ret.Get(0).(func(context.Context) []binarylane.FloatingIP); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.FloatingIP)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get mocks binarylane.FloatingIPsService.Get.
+func (_m *FloatingIPsService) Get(_a0 context.Context, _a1 string) (*binarylane.FloatingIP, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.FloatingIP
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.FloatingIP); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.FloatingIP)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Create mocks binarylane.FloatingIPsService.Create.
+func (_m *FloatingIPsService) Create(_a0 context.Context, _a1 *binarylane.FloatingIPCreateRequest) (*binarylane.FloatingIP, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.FloatingIP
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.FloatingIPCreateRequest) *binarylane.FloatingIP); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.FloatingIP)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.FloatingIPCreateRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.FloatingIPCreateRequest) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Delete mocks binarylane.FloatingIPsService.Delete.
+func (_m *FloatingIPsService) Delete(_a0 context.Context, _a1 string) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImageActionsService is a mock of binarylane.ImageActionsService.
+type ImageActionsService struct {
+	mock.Mock
+}
+
+// NewImageActionsService returns a mock of binarylane.ImageActionsService. It asserts
+// that its expectations were met when the test finishes.
+func NewImageActionsService(t TestingT) *ImageActionsService {
+	m := &ImageActionsService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Get mocks binarylane.ImageActionsService.Get.
+func (_m *ImageActionsService) Get(_a0 context.Context, _a1 int, _a2 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Transfer mocks binarylane.ImageActionsService.Transfer.
+func (_m *ImageActionsService) Transfer(_a0 context.Context, _a1 int, _a2 *binarylane.ActionRequest) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int, *binarylane.ActionRequest) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, *binarylane.ActionRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, *binarylane.ActionRequest) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Convert mocks binarylane.ImageActionsService.Convert.
+func (_m *ImageActionsService) Convert(_a0 context.Context, _a1 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ImagesService is a mock of binarylane.ImagesService.
+type ImagesService struct {
+	mock.Mock
+}
+
+// NewImagesService returns a mock of binarylane.ImagesService. It asserts
+// that its expectations were met when the test finishes.
+func NewImagesService(t TestingT) *ImagesService {
+	m := &ImagesService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// List mocks binarylane.ImagesService.List.
+func (_m *ImagesService) List(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.Image, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Image
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.Image); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Image)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListAll mocks binarylane.ImagesService.ListAll.
+func (_m *ImagesService) ListAll(_a0 context.Context) ([]binarylane.Image, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []binarylane.Image
+	if rf, ok := ret.Get(0).(func(context.Context) []binarylane.Image); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Image)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDistribution mocks binarylane.ImagesService.ListDistribution.
+func (_m *ImagesService) ListDistribution(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.Image, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Image
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.Image); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Image)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListApplication mocks binarylane.ImagesService.ListApplication.
+func (_m *ImagesService) ListApplication(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.Image, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Image
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.Image); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Image)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListUser mocks binarylane.ImagesService.ListUser.
+func (_m *ImagesService) ListUser(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.Image, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Image
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.Image); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Image)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListUserAll mocks binarylane.ImagesService.ListUserAll.
+func (_m *ImagesService) ListUserAll(_a0 context.Context) ([]binarylane.Image, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []binarylane.Image
+	if rf, ok := ret.Get(0).(func(context.Context) []binarylane.Image); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Image)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByTag mocks binarylane.ImagesService.ListByTag.
+func (_m *ImagesService) ListByTag(_a0 context.Context, _a1 string, _a2 *binarylane.ListOptions) ([]binarylane.Image, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []binarylane.Image
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.ListOptions) []binarylane.Image); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Image)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetByID mocks binarylane.ImagesService.GetByID.
+func (_m *ImagesService) GetByID(_a0 context.Context, _a1 int) (*binarylane.Image, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Image
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Image); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Image)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetBySlug mocks binarylane.ImagesService.GetBySlug.
+func (_m *ImagesService) GetBySlug(_a0 context.Context, _a1 string) (*binarylane.Image, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Image
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Image); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Image)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Create mocks binarylane.ImagesService.Create.
+func (_m *ImagesService) Create(_a0 context.Context, _a1 *binarylane.CustomImageCreateRequest) (*binarylane.Image, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Image
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.CustomImageCreateRequest) *binarylane.Image); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Image)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.CustomImageCreateRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.CustomImageCreateRequest) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Update mocks binarylane.ImagesService.Update.
+func (_m *ImagesService) Update(_a0 context.Context, _a1 int, _a2 *binarylane.ImageUpdateRequest) (*binarylane.Image, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Image
+	if rf, ok := ret.Get(0).(func(context.Context, int, *binarylane.ImageUpdateRequest) *binarylane.Image); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Image)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, *binarylane.ImageUpdateRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, *binarylane.ImageUpdateRequest) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Delete mocks binarylane.ImagesService.Delete.
+func (_m *ImagesService) Delete(_a0 context.Context, _a1 int) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvoicesService is a mock of binarylane.InvoicesService.
+type InvoicesService struct {
+	mock.Mock
+}
+
+// NewInvoicesService returns a mock of binarylane.InvoicesService. It asserts
+// that its expectations were met when the test finishes.
+func NewInvoicesService(t TestingT) *InvoicesService {
+	m := &InvoicesService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Get mocks binarylane.InvoicesService.Get.
+func (_m *InvoicesService) Get(_a0 context.Context, _a1 string, _a2 *binarylane.ListOptions) (*binarylane.Invoice, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Invoice
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.ListOptions) *binarylane.Invoice); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Invoice)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetPDF mocks binarylane.InvoicesService.GetPDF.
+func (_m *InvoicesService) GetPDF(_a0 context.Context, _a1 string) ([]byte, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]byte)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetCSV mocks binarylane.InvoicesService.GetCSV.
+func (_m *InvoicesService) GetCSV(_a0 context.Context, _a1 string) ([]byte, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]byte)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// List mocks binarylane.InvoicesService.List.
+func (_m *InvoicesService) List(_a0 context.Context, _a1 *binarylane.ListOptions) (*binarylane.InvoiceList, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.InvoiceList
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) *binarylane.InvoiceList); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.InvoiceList)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetSummary mocks binarylane.InvoicesService.GetSummary.
+func (_m *InvoicesService) GetSummary(_a0 context.Context, _a1 string) (*binarylane.InvoiceSummary, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.InvoiceSummary
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.InvoiceSummary); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.InvoiceSummary)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// KeysService is a mock of binarylane.KeysService.
+type KeysService struct {
+	mock.Mock
+}
+
+// NewKeysService returns a mock of binarylane.KeysService. It asserts
+// that its expectations were met when the test finishes.
+func NewKeysService(t TestingT) *KeysService {
+	m := &KeysService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// List mocks binarylane.KeysService.List.
+func (_m *KeysService) List(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.Key, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Key
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.Key); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Key)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListAll mocks binarylane.KeysService.ListAll.
+func (_m *KeysService) ListAll(_a0 context.Context) ([]binarylane.Key, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []binarylane.Key
+	if rf, ok := ret.Get(0).(func(context.Context) []binarylane.Key); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Key)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID mocks binarylane.KeysService.GetByID.
+func (_m *KeysService) GetByID(_a0 context.Context, _a1 int) (*binarylane.Key, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Key
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Key); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Key)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetByFingerprint mocks binarylane.KeysService.GetByFingerprint.
+func (_m *KeysService) GetByFingerprint(_a0 context.Context, _a1 string) (*binarylane.Key, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Key
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Key); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Key)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Create mocks binarylane.KeysService.Create.
+func (_m *KeysService) Create(_a0 context.Context, _a1 *binarylane.KeyCreateRequest) (*binarylane.Key, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Key
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.KeyCreateRequest) *binarylane.Key); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Key)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.KeyCreateRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.KeyCreateRequest) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UpdateByID mocks binarylane.KeysService.UpdateByID.
+func (_m *KeysService) UpdateByID(_a0 context.Context, _a1 int, _a2 *binarylane.KeyUpdateRequest) (*binarylane.Key, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Key
+	if rf, ok := ret.Get(0).(func(context.Context, int, *binarylane.KeyUpdateRequest) *binarylane.Key); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Key)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, *binarylane.KeyUpdateRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, *binarylane.KeyUpdateRequest) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UpdateByFingerprint mocks binarylane.KeysService.UpdateByFingerprint.
+func (_m *KeysService) UpdateByFingerprint(_a0 context.Context, _a1 string, _a2 *binarylane.KeyUpdateRequest) (*binarylane.Key, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Key
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.KeyUpdateRequest) *binarylane.Key); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Key)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.KeyUpdateRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *binarylane.KeyUpdateRequest) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// DeleteByID mocks binarylane.KeysService.DeleteByID.
+func (_m *KeysService) DeleteByID(_a0 context.Context, _a1 int) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteByFingerprint mocks binarylane.KeysService.DeleteByFingerprint.
+func (_m *KeysService) DeleteByFingerprint(_a0 context.Context, _a1 string) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoadBalancersService is a mock of binarylane.LoadBalancersService.
+type LoadBalancersService struct {
+	mock.Mock
+}
+
+// NewLoadBalancersService returns a mock of binarylane.LoadBalancersService. It asserts
+// that its expectations were met when the test finishes.
+func NewLoadBalancersService(t TestingT) *LoadBalancersService {
+	m := &LoadBalancersService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Get mocks binarylane.LoadBalancersService.Get.
+func (_m *LoadBalancersService) Get(_a0 context.Context, _a1 int) (*binarylane.LoadBalancer, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.LoadBalancer
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.LoadBalancer); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.LoadBalancer)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// List mocks binarylane.LoadBalancersService.List.
+func (_m *LoadBalancersService) List(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.LoadBalancer, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.LoadBalancer
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.LoadBalancer); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.LoadBalancer)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListAll mocks binarylane.LoadBalancersService.ListAll.
+func (_m *LoadBalancersService) ListAll(_a0 context.Context) ([]binarylane.LoadBalancer, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []binarylane.LoadBalancer
+	if rf, ok := ret.Get(0).(func(context.Context) []binarylane.LoadBalancer); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.LoadBalancer)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create mocks binarylane.LoadBalancersService.Create.
+func (_m *LoadBalancersService) Create(_a0 context.Context, _a1 *binarylane.LoadBalancerRequest) (*binarylane.LoadBalancer, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.LoadBalancer
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.LoadBalancerRequest) *binarylane.LoadBalancer); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.LoadBalancer)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.LoadBalancerRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.LoadBalancerRequest) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Update mocks binarylane.LoadBalancersService.Update.
+func (_m *LoadBalancersService) Update(_a0 context.Context, _a1 int, _a2 *binarylane.LoadBalancerRequest) (*binarylane.LoadBalancer, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.LoadBalancer
+	if rf, ok := ret.Get(0).(func(context.Context, int, *binarylane.LoadBalancerRequest) *binarylane.LoadBalancer); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.LoadBalancer)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, *binarylane.LoadBalancerRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, *binarylane.LoadBalancerRequest) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Delete mocks binarylane.LoadBalancersService.Delete.
+func (_m *LoadBalancersService) Delete(_a0 context.Context, _a1 int) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddServers mocks binarylane.LoadBalancersService.AddServers.
+func (_m *LoadBalancersService) AddServers(_a0 context.Context, _a1 int, _a2 ...int) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int, ...int) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ...int) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveServers mocks binarylane.LoadBalancersService.RemoveServers.
+func (_m *LoadBalancersService) RemoveServers(_a0 context.Context, _a1 int, _a2 ...int) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int, ...int) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ...int) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddForwardingRules mocks binarylane.LoadBalancersService.AddForwardingRules.
+func (_m *LoadBalancersService) AddForwardingRules(_a0 context.Context, _a1 int, _a2 ...binarylane.ForwardingRule) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int, ...binarylane.ForwardingRule) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ...binarylane.ForwardingRule) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveForwardingRules mocks binarylane.LoadBalancersService.RemoveForwardingRules.
+func (_m *LoadBalancersService) RemoveForwardingRules(_a0 context.Context, _a1 int, _a2 ...binarylane.ForwardingRule) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int, ...binarylane.ForwardingRule) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, ...binarylane.ForwardingRule) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectsService is a mock of binarylane.ProjectsService.
+type ProjectsService struct {
+	mock.Mock
+}
+
+// NewProjectsService returns a mock of binarylane.ProjectsService. It asserts
+// that its expectations were met when the test finishes.
+func NewProjectsService(t TestingT) *ProjectsService {
+	m := &ProjectsService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// List mocks binarylane.ProjectsService.List.
+func (_m *ProjectsService) List(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.Project, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Project
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.Project); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Project)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListAll mocks binarylane.ProjectsService.ListAll.
+func (_m *ProjectsService) ListAll(_a0 context.Context) ([]binarylane.Project, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []binarylane.Project
+	if rf, ok := ret.Get(0).(func(context.Context) []binarylane.Project); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Project)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDefault mocks binarylane.ProjectsService.GetDefault.
+func (_m *ProjectsService) GetDefault(_a0 context.Context) (*binarylane.Project, *binarylane.Response, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *binarylane.Project
+	if rf, ok := ret.Get(0).(func(context.Context) *binarylane.Project); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Project)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context) *binarylane.Response); ok {
+		r1 = rf(_a0)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(_a0)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Get mocks binarylane.ProjectsService.Get.
+func (_m *ProjectsService) Get(_a0 context.Context, _a1 string) (*binarylane.Project, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Project
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Project); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Project)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Create mocks binarylane.ProjectsService.Create.
+func (_m *ProjectsService) Create(_a0 context.Context, _a1 *binarylane.CreateProjectRequest) (*binarylane.Project, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Project
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.CreateProjectRequest) *binarylane.Project); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Project)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.CreateProjectRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.CreateProjectRequest) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Update mocks binarylane.ProjectsService.Update.
+func (_m *ProjectsService) Update(_a0 context.Context, _a1 string, _a2 *binarylane.UpdateProjectRequest) (*binarylane.Project, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Project
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.UpdateProjectRequest) *binarylane.Project); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Project)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.UpdateProjectRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *binarylane.UpdateProjectRequest) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Delete mocks binarylane.ProjectsService.Delete.
+func (_m *ProjectsService) Delete(_a0 context.Context, _a1 string) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListResources mocks binarylane.ProjectsService.ListResources.
+func (_m *ProjectsService) ListResources(_a0 context.Context, _a1 string, _a2 *binarylane.ListOptions) ([]binarylane.ProjectResource, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []binarylane.ProjectResource
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.ListOptions) []binarylane.ProjectResource); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.ProjectResource)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListResourcesAll mocks binarylane.ProjectsService.ListResourcesAll.
+func (_m *ProjectsService) ListResourcesAll(_a0 context.Context, _a1 string) ([]binarylane.ProjectResource, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.ProjectResource
+	if rf, ok := ret.Get(0).(func(context.Context, string) []binarylane.ProjectResource); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.ProjectResource)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssignResources mocks binarylane.ProjectsService.AssignResources.
+func (_m *ProjectsService) AssignResources(_a0 context.Context, _a1 string, _a2 ...interface{}) ([]binarylane.ProjectResource, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []binarylane.ProjectResource
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) []binarylane.ProjectResource); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.ProjectResource)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...interface{}) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, ...interface{}) error); ok {
+		r2 = rf(_a0, _a1, _a2...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RegionsService is a mock of binarylane.RegionsService.
+type RegionsService struct {
+	mock.Mock
+}
+
+// NewRegionsService returns a mock of binarylane.RegionsService. It asserts
+// that its expectations were met when the test finishes.
+func NewRegionsService(t TestingT) *RegionsService {
+	m := &RegionsService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// List mocks binarylane.RegionsService.List.
+func (_m *RegionsService) List(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.Region, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Region
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.Region); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Region)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListAll mocks binarylane.RegionsService.ListAll.
+func (_m *RegionsService) ListAll(_a0 context.Context) ([]binarylane.Region, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []binarylane.Region
+	if rf, ok := ret.Get(0).(func(context.Context) []binarylane.Region); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Region)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServerActionsService is a mock of binarylane.ServerActionsService.
+type ServerActionsService struct {
+	mock.Mock
+}
+
+// NewServerActionsService returns a mock of binarylane.ServerActionsService. It asserts
+// that its expectations were met when the test finishes.
+func NewServerActionsService(t TestingT) *ServerActionsService {
+	m := &ServerActionsService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Shutdown mocks binarylane.ServerActionsService.Shutdown.
+func (_m *ServerActionsService) Shutdown(_a0 context.Context, _a1 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ShutdownByTag mocks binarylane.ServerActionsService.ShutdownByTag.
+func (_m *ServerActionsService) ShutdownByTag(_a0 context.Context, _a1 string) ([]binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string) []binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PowerOff mocks binarylane.ServerActionsService.PowerOff.
+func (_m *ServerActionsService) PowerOff(_a0 context.Context, _a1 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PowerOffByTag mocks binarylane.ServerActionsService.PowerOffByTag.
+func (_m *ServerActionsService) PowerOffByTag(_a0 context.Context, _a1 string) ([]binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string) []binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PowerOn mocks binarylane.ServerActionsService.PowerOn.
+func (_m *ServerActionsService) PowerOn(_a0 context.Context, _a1 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PowerOnByTag mocks binarylane.ServerActionsService.PowerOnByTag.
+func (_m *ServerActionsService) PowerOnByTag(_a0 context.Context, _a1 string) ([]binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string) []binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PowerCycle mocks binarylane.ServerActionsService.PowerCycle.
+func (_m *ServerActionsService) PowerCycle(_a0 context.Context, _a1 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PowerCycleByTag mocks binarylane.ServerActionsService.PowerCycleByTag.
+func (_m *ServerActionsService) PowerCycleByTag(_a0 context.Context, _a1 string) ([]binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string) []binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Reboot mocks binarylane.ServerActionsService.Reboot.
+func (_m *ServerActionsService) Reboot(_a0 context.Context, _a1 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Restore mocks binarylane.ServerActionsService.Restore.
+func (_m *ServerActionsService) Restore(_a0 context.Context, _a1 int, _a2 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Resize mocks binarylane.ServerActionsService.Resize.
+func (_m *ServerActionsService) Resize(_a0 context.Context, _a1 int, _a2 string, _a3 bool) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, bool) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, bool) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, string, bool) error); ok {
+		r2 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Rename mocks binarylane.ServerActionsService.Rename.
+func (_m *ServerActionsService) Rename(_a0 context.Context, _a1 int, _a2 string) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, string) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Snapshot mocks binarylane.ServerActionsService.Snapshot.
+func (_m *ServerActionsService) Snapshot(_a0 context.Context, _a1 int, _a2 string) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, string) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SnapshotByTag mocks binarylane.ServerActionsService.SnapshotByTag.
+func (_m *ServerActionsService) SnapshotByTag(_a0 context.Context, _a1 string, _a2 string) ([]binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// EnableBackups mocks binarylane.ServerActionsService.EnableBackups.
+func (_m *ServerActionsService) EnableBackups(_a0 context.Context, _a1 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// EnableBackupsByTag mocks binarylane.ServerActionsService.EnableBackupsByTag.
+func (_m *ServerActionsService) EnableBackupsByTag(_a0 context.Context, _a1 string) ([]binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string) []binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// DisableBackups mocks binarylane.ServerActionsService.DisableBackups.
+func (_m *ServerActionsService) DisableBackups(_a0 context.Context, _a1 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// DisableBackupsByTag mocks binarylane.ServerActionsService.DisableBackupsByTag.
+func (_m *ServerActionsService) DisableBackupsByTag(_a0 context.Context, _a1 string) ([]binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string) []binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PasswordReset mocks binarylane.ServerActionsService.PasswordReset.
+func (_m *ServerActionsService) PasswordReset(_a0 context.Context, _a1 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RebuildByImageID mocks binarylane.ServerActionsService.RebuildByImageID.
+func (_m *ServerActionsService) RebuildByImageID(_a0 context.Context, _a1 int, _a2 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RebuildByImageSlug mocks binarylane.ServerActionsService.RebuildByImageSlug.
+func (_m *ServerActionsService) RebuildByImageSlug(_a0 context.Context, _a1 int, _a2 string) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, string) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ChangeKernel mocks binarylane.ServerActionsService.ChangeKernel.
+func (_m *ServerActionsService) ChangeKernel(_a0 context.Context, _a1 int, _a2 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// EnableIPv6 mocks binarylane.ServerActionsService.EnableIPv6.
+func (_m *ServerActionsService) EnableIPv6(_a0 context.Context, _a1 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// EnableIPv6ByTag mocks binarylane.ServerActionsService.EnableIPv6ByTag.
+func (_m *ServerActionsService) EnableIPv6ByTag(_a0 context.Context, _a1 string) ([]binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string) []binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// EnablePrivateNetworking mocks binarylane.ServerActionsService.EnablePrivateNetworking.
+func (_m *ServerActionsService) EnablePrivateNetworking(_a0 context.Context, _a1 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// EnablePrivateNetworkingByTag mocks binarylane.ServerActionsService.EnablePrivateNetworkingByTag.
+func (_m *ServerActionsService) EnablePrivateNetworkingByTag(_a0 context.Context, _a1 string) ([]binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string) []binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Get mocks binarylane.ServerActionsService.Get.
+func (_m *ServerActionsService) Get(_a0 context.Context, _a1 int, _a2 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetByURI mocks binarylane.ServerActionsService.GetByURI.
+func (_m *ServerActionsService) GetByURI(_a0 context.Context, _a1 string) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ServersService is a mock of binarylane.ServersService.
+type ServersService struct {
+	mock.Mock
+}
+
+// NewServersService returns a mock of binarylane.ServersService. It asserts
+// that its expectations were met when the test finishes.
+func NewServersService(t TestingT) *ServersService {
+	m := &ServersService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// List mocks binarylane.ServersService.List.
+func (_m *ServersService) List(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.Server, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Server
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.Server); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Server)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListAll mocks binarylane.ServersService.ListAll.
+func (_m *ServersService) ListAll(_a0 context.Context) ([]binarylane.Server, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []binarylane.Server
+	if rf, ok := ret.Get(0).(func(context.Context) []binarylane.Server); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Server)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByTag mocks binarylane.ServersService.ListByTag.
+func (_m *ServersService) ListByTag(_a0 context.Context, _a1 string, _a2 *binarylane.ListOptions) ([]binarylane.Server, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []binarylane.Server
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.ListOptions) []binarylane.Server); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Server)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Get mocks binarylane.ServersService.Get.
+func (_m *ServersService) Get(_a0 context.Context, _a1 int) (*binarylane.Server, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Server
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Server); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Server)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Create mocks binarylane.ServersService.Create.
+func (_m *ServersService) Create(_a0 context.Context, _a1 *binarylane.ServerCreateRequest) (*binarylane.Server, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Server
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ServerCreateRequest) *binarylane.Server); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Server)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ServerCreateRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ServerCreateRequest) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CreateMultiple mocks binarylane.ServersService.CreateMultiple.
+func (_m *ServersService) CreateMultiple(_a0 context.Context, _a1 *binarylane.ServerMultiCreateRequest) ([]binarylane.Server, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Server
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ServerMultiCreateRequest) []binarylane.Server); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Server)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ServerMultiCreateRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ServerMultiCreateRequest) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Delete mocks binarylane.ServersService.Delete.
+func (_m *ServersService) Delete(_a0 context.Context, _a1 int) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteByTag mocks binarylane.ServersService.DeleteByTag.
+func (_m *ServersService) DeleteByTag(_a0 context.Context, _a1 string) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Kernels mocks binarylane.ServersService.Kernels.
+func (_m *ServersService) Kernels(_a0 context.Context, _a1 int, _a2 *binarylane.ListOptions) ([]binarylane.Kernel, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []binarylane.Kernel
+	if rf, ok := ret.Get(0).(func(context.Context, int, *binarylane.ListOptions) []binarylane.Kernel); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Kernel)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Snapshots mocks binarylane.ServersService.Snapshots.
+func (_m *ServersService) Snapshots(_a0 context.Context, _a1 int, _a2 *binarylane.ListOptions) ([]binarylane.Image, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []binarylane.Image
+	if rf, ok := ret.Get(0).(func(context.Context, int, *binarylane.ListOptions) []binarylane.Image); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Image)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Backups mocks binarylane.ServersService.Backups.
+func (_m *ServersService) Backups(_a0 context.Context, _a1 int, _a2 *binarylane.ListOptions) ([]binarylane.Image, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []binarylane.Image
+	if rf, ok := ret.Get(0).(func(context.Context, int, *binarylane.ListOptions) []binarylane.Image); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Image)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Actions mocks binarylane.ServersService.Actions.
+func (_m *ServersService) Actions(_a0 context.Context, _a1 int, _a2 *binarylane.ListOptions) ([]binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, int, *binarylane.ListOptions) []binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Neighbors mocks binarylane.ServersService.Neighbors.
+func (_m *ServersService) Neighbors(_a0 context.Context, _a1 int) ([]binarylane.Server, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Server
+	if rf, ok := ret.Get(0).(func(context.Context, int) []binarylane.Server); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Server)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SizesService is a mock of binarylane.SizesService.
+type SizesService struct {
+	mock.Mock
+}
+
+// NewSizesService returns a mock of binarylane.SizesService. It asserts
+// that its expectations were met when the test finishes.
+func NewSizesService(t TestingT) *SizesService {
+	m := &SizesService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// List mocks binarylane.SizesService.List.
+func (_m *SizesService) List(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.Size, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Size
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.Size); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Size)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListAll mocks binarylane.SizesService.ListAll.
+func (_m *SizesService) ListAll(_a0 context.Context) ([]binarylane.Size, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []binarylane.Size
+	if rf, ok := ret.Get(0).(func(context.Context) []binarylane.Size); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Size)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SnapshotsService is a mock of binarylane.SnapshotsService.
+type SnapshotsService struct {
+	mock.Mock
+}
+
+// NewSnapshotsService returns a mock of binarylane.SnapshotsService. It asserts
+// that its expectations were met when the test finishes.
+func NewSnapshotsService(t TestingT) *SnapshotsService {
+	m := &SnapshotsService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// List mocks binarylane.SnapshotsService.List.
+func (_m *SnapshotsService) List(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.Snapshot, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Snapshot
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.Snapshot); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Snapshot)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListAll mocks binarylane.SnapshotsService.ListAll.
+func (_m *SnapshotsService) ListAll(_a0 context.Context) ([]binarylane.Snapshot, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []binarylane.Snapshot
+	if rf, ok := ret.Get(0).(func(context.Context) []binarylane.Snapshot); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Snapshot)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListVolume mocks binarylane.SnapshotsService.ListVolume.
+func (_m *SnapshotsService) ListVolume(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.Snapshot, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Snapshot
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.Snapshot); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Snapshot)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListServer mocks binarylane.SnapshotsService.ListServer.
+func (_m *SnapshotsService) ListServer(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.Snapshot, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Snapshot
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.Snapshot); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Snapshot)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Get mocks binarylane.SnapshotsService.Get.
+func (_m *SnapshotsService) Get(_a0 context.Context, _a1 string) (*binarylane.Snapshot, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Snapshot
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Snapshot); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Snapshot)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Delete mocks binarylane.SnapshotsService.Delete.
+func (_m *SnapshotsService) Delete(_a0 context.Context, _a1 string) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagsService is a mock of binarylane.TagsService.
+type TagsService struct {
+	mock.Mock
+}
+
+// NewTagsService returns a mock of binarylane.TagsService. It asserts
+// that its expectations were met when the test finishes.
+func NewTagsService(t TestingT) *TagsService {
+	m := &TagsService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// List mocks binarylane.TagsService.List.
+func (_m *TagsService) List(_a0 context.Context, _a1 *binarylane.ListOptions) ([]binarylane.Tag, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Tag
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []binarylane.Tag); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Tag)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListAll mocks binarylane.TagsService.ListAll.
+func (_m *TagsService) ListAll(_a0 context.Context) ([]binarylane.Tag, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []binarylane.Tag
+	if rf, ok := ret.Get(0).(func(context.Context) []binarylane.Tag); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Tag)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get mocks binarylane.TagsService.Get.
+func (_m *TagsService) Get(_a0 context.Context, _a1 string) (*binarylane.Tag, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Tag
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Tag); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Tag)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Create mocks binarylane.TagsService.Create.
+func (_m *TagsService) Create(_a0 context.Context, _a1 *binarylane.TagCreateRequest) (*binarylane.Tag, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Tag
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.TagCreateRequest) *binarylane.Tag); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Tag)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.TagCreateRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.TagCreateRequest) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Delete mocks binarylane.TagsService.Delete.
+func (_m *TagsService) Delete(_a0 context.Context, _a1 string) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagResources mocks binarylane.TagsService.TagResources.
+func (_m *TagsService) TagResources(_a0 context.Context, _a1 string, _a2 *binarylane.TagResourcesRequest) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.TagResourcesRequest) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.TagResourcesRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UntagResources mocks binarylane.TagsService.UntagResources.
+func (_m *TagsService) UntagResources(_a0 context.Context, _a1 string, _a2 *binarylane.UntagResourcesRequest) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.UntagResourcesRequest) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.UntagResourcesRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VPCsService is a mock of binarylane.VPCsService.
+type VPCsService struct {
+	mock.Mock
+}
+
+// NewVPCsService returns a mock of binarylane.VPCsService. It asserts
+// that its expectations were met when the test finishes.
+func NewVPCsService(t TestingT) *VPCsService {
+	m := &VPCsService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Create mocks binarylane.VPCsService.Create.
+func (_m *VPCsService) Create(_a0 context.Context, _a1 *binarylane.VPCCreateRequest) (*binarylane.VPC, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.VPC
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.VPCCreateRequest) *binarylane.VPC); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.VPC)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.VPCCreateRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.VPCCreateRequest) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Get mocks binarylane.VPCsService.Get.
+func (_m *VPCsService) Get(_a0 context.Context, _a1 int) (*binarylane.VPC, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.VPC
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.VPC); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.VPC)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// List mocks binarylane.VPCsService.List.
+func (_m *VPCsService) List(_a0 context.Context, _a1 *binarylane.ListOptions) ([]*binarylane.VPC, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []*binarylane.VPC
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListOptions) []*binarylane.VPC); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]*binarylane.VPC)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListAll mocks binarylane.VPCsService.ListAll.
+func (_m *VPCsService) ListAll(_a0 context.Context) ([]*binarylane.VPC, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []*binarylane.VPC
+	if rf, ok := ret.Get(0).(func(context.Context) []*binarylane.VPC); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]*binarylane.VPC)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update mocks binarylane.VPCsService.Update.
+func (_m *VPCsService) Update(_a0 context.Context, _a1 int, _a2 *binarylane.VPCUpdateRequest) (*binarylane.VPC, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.VPC
+	if rf, ok := ret.Get(0).(func(context.Context, int, *binarylane.VPCUpdateRequest) *binarylane.VPC); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.VPC)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, *binarylane.VPCUpdateRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, *binarylane.VPCUpdateRequest) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Set mocks binarylane.VPCsService.Set.
+func (_m *VPCsService) Set(_a0 context.Context, _a1 int, _a2 ...binarylane.VPCSetField) (*binarylane.VPC, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.VPC
+	if rf, ok := ret.Get(0).(func(context.Context, int, ...binarylane.VPCSetField) *binarylane.VPC); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.VPC)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, int, ...binarylane.VPCSetField) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int, ...binarylane.VPCSetField) error); ok {
+		r2 = rf(_a0, _a1, _a2...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Delete mocks binarylane.VPCsService.Delete.
+func (_m *VPCsService) Delete(_a0 context.Context, _a1 int) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VolumeActionsService is a mock of binarylane.VolumeActionsService.
+type VolumeActionsService struct {
+	mock.Mock
+}
+
+// NewVolumeActionsService returns a mock of binarylane.VolumeActionsService. It asserts
+// that its expectations were met when the test finishes.
+func NewVolumeActionsService(t TestingT) *VolumeActionsService {
+	m := &VolumeActionsService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Attach mocks binarylane.VolumeActionsService.Attach.
+func (_m *VolumeActionsService) Attach(_a0 context.Context, _a1 string, _a2 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, int) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Detach mocks binarylane.VolumeActionsService.Detach.
+func (_m *VolumeActionsService) Detach(_a0 context.Context, _a1 string, _a2 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, int) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Resize mocks binarylane.VolumeActionsService.Resize.
+func (_m *VolumeActionsService) Resize(_a0 context.Context, _a1 string, _a2 int, _a3 string) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, int, string) error); ok {
+		r2 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Get mocks binarylane.VolumeActionsService.Get.
+func (_m *VolumeActionsService) Get(_a0 context.Context, _a1 string, _a2 int) (*binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, int) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// List mocks binarylane.VolumeActionsService.List.
+func (_m *VolumeActionsService) List(_a0 context.Context, _a1 string, _a2 *binarylane.ListOptions) ([]binarylane.Action, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []binarylane.Action
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.ListOptions) []binarylane.Action); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Action)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// VolumesService is a mock of binarylane.VolumesService.
+type VolumesService struct {
+	mock.Mock
+}
+
+// NewVolumesService returns a mock of binarylane.VolumesService. It asserts
+// that its expectations were met when the test finishes.
+func NewVolumesService(t TestingT) *VolumesService {
+	m := &VolumesService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// List mocks binarylane.VolumesService.List.
+func (_m *VolumesService) List(_a0 context.Context, _a1 *binarylane.ListVolumeParams) ([]binarylane.Volume, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []binarylane.Volume
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.ListVolumeParams) []binarylane.Volume); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Volume)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.ListVolumeParams) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.ListVolumeParams) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListAll mocks binarylane.VolumesService.ListAll.
+func (_m *VolumesService) ListAll(_a0 context.Context) ([]binarylane.Volume, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []binarylane.Volume
+	if rf, ok := ret.Get(0).(func(context.Context) []binarylane.Volume); ok {
+		r0 = rf(_a0)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Volume)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get mocks binarylane.VolumesService.Get.
+func (_m *VolumesService) Get(_a0 context.Context, _a1 string) (*binarylane.Volume, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Volume
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Volume); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Volume)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Create mocks binarylane.VolumesService.Create.
+func (_m *VolumesService) Create(_a0 context.Context, _a1 *binarylane.VolumeCreateRequest) (*binarylane.Volume, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Volume
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.VolumeCreateRequest) *binarylane.Volume); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Volume)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.VolumeCreateRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.VolumeCreateRequest) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Delete mocks binarylane.VolumesService.Delete.
+func (_m *VolumesService) Delete(_a0 context.Context, _a1 string) (*binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string) *binarylane.Response); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Response)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSnapshots mocks binarylane.VolumesService.ListSnapshots.
+func (_m *VolumesService) ListSnapshots(_a0 context.Context, _a1 string, _a2 *binarylane.ListOptions) ([]binarylane.Snapshot, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []binarylane.Snapshot
+	if rf, ok := ret.Get(0).(func(context.Context, string, *binarylane.ListOptions) []binarylane.Snapshot); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]binarylane.Snapshot)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, string, *binarylane.ListOptions) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *binarylane.ListOptions) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CreateSnapshot mocks binarylane.VolumesService.CreateSnapshot.
+func (_m *VolumesService) CreateSnapshot(_a0 context.Context, _a1 *binarylane.SnapshotCreateRequest) (*binarylane.Snapshot, *binarylane.Response, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *binarylane.Snapshot
+	if rf, ok := ret.Get(0).(func(context.Context, *binarylane.SnapshotCreateRequest) *binarylane.Snapshot); ok {
+		r0 = rf(_a0, _a1)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*binarylane.Snapshot)
+	}
+
+	var r1 *binarylane.Response
+	if rf, ok := ret.Get(1).(func(context.Context, *binarylane.SnapshotCreateRequest) *binarylane.Response); ok {
+		r1 = rf(_a0, _a1)
+	} else if ret.Get(1) != nil {
+		r1 = ret.Get(1).(*binarylane.Response)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *binarylane.SnapshotCreateRequest) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Services holds the mocks of a client created by NewClient.
+type Services struct {
+	Account           *AccountService
+	Actions           *ActionsService
+	Balance           *BalanceService
+	BillingHistory    *BillingHistoryService
+	Certificates      *CertificatesService
+	Domains           *DomainsService
+	Servers           *ServersService
+	ServerActions     *ServerActionsService
+	Images            *ImagesService
+	ImageActions      *ImageActionsService
+	Invoices          *InvoicesService
+	Keys              *KeysService
+	Regions           *RegionsService
+	Sizes             *SizesService
+	FloatingIPs       *FloatingIPsService
+	FloatingIPActions *FloatingIPActionsService
+	Snapshots         *SnapshotsService
+	Tags              *TagsService
+	LoadBalancers     *LoadBalancersService
+	Firewalls         *FirewallsService
+	Projects          *ProjectsService
+	VPCs              *VPCsService
+	Volumes           *VolumesService
+	VolumeActions     *VolumeActionsService
+}
+
+// NewClient returns a client whose services are all mocks, along with the
+// mocks. Each mock asserts its expectations were met when the test
+// finishes.
+func NewClient(t TestingT) (*binarylane.Client, *Services) {
+	s := &Services{
+		Account:           NewAccountService(t),
+		Actions:           NewActionsService(t),
+		Balance:           NewBalanceService(t),
+		BillingHistory:    NewBillingHistoryService(t),
+		Certificates:      NewCertificatesService(t),
+		Domains:           NewDomainsService(t),
+		Servers:           NewServersService(t),
+		ServerActions:     NewServerActionsService(t),
+		Images:            NewImagesService(t),
+		ImageActions:      NewImageActionsService(t),
+		Invoices:          NewInvoicesService(t),
+		Keys:              NewKeysService(t),
+		Regions:           NewRegionsService(t),
+		Sizes:             NewSizesService(t),
+		FloatingIPs:       NewFloatingIPsService(t),
+		FloatingIPActions: NewFloatingIPActionsService(t),
+		Snapshots:         NewSnapshotsService(t),
+		Tags:              NewTagsService(t),
+		LoadBalancers:     NewLoadBalancersService(t),
+		Firewalls:         NewFirewallsService(t),
+		Projects:          NewProjectsService(t),
+		VPCs:              NewVPCsService(t),
+		Volumes:           NewVolumesService(t),
+		VolumeActions:     NewVolumeActionsService(t),
+	}
+
+	c := binarylane.NewClient(nil)
+	c.Account = s.Account
+	c.Actions = s.Actions
+	c.Balance = s.Balance
+	c.BillingHistory = s.BillingHistory
+	c.Certificates = s.Certificates
+	c.Domains = s.Domains
+	c.Servers = s.Servers
+	c.ServerActions = s.ServerActions
+	c.Images = s.Images
+	c.ImageActions = s.ImageActions
+	c.Invoices = s.Invoices
+	c.Keys = s.Keys
+	c.Regions = s.Regions
+	c.Sizes = s.Sizes
+	c.FloatingIPs = s.FloatingIPs
+	c.FloatingIPActions = s.FloatingIPActions
+	c.Snapshots = s.Snapshots
+	c.Tags = s.Tags
+	c.LoadBalancers = s.LoadBalancers
+	c.Firewalls = s.Firewalls
+	c.Projects = s.Projects
+	c.VPCs = s.VPCs
+	c.Volumes = s.Volumes
+	c.VolumeActions = s.VolumeActions
+	return c, s
+}
@@ -0,0 +1,36 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+package mocks_test
+
+import (
+	"github.com/binarylane/go-binarylane"
+	"github.com/binarylane/go-binarylane/mocks"
+)
+
+// Each mock must implement its interface.
+var (
+	_ binarylane.AccountService           = (*mocks.AccountService)(nil)
+	_ binarylane.ActionsService           = (*mocks.ActionsService)(nil)
+	_ binarylane.BalanceService           = (*mocks.BalanceService)(nil)
+	_ binarylane.BillingHistoryService    = (*mocks.BillingHistoryService)(nil)
+	_ binarylane.CertificatesService      = (*mocks.CertificatesService)(nil)
+	_ binarylane.DomainsService           = (*mocks.DomainsService)(nil)
+	_ binarylane.FirewallsService         = (*mocks.FirewallsService)(nil)
+	_ binarylane.FloatingIPActionsService = (*mocks.FloatingIPActionsService)(nil)
+	_ binarylane.FloatingIPsService       = (*mocks.FloatingIPsService)(nil)
+	_ binarylane.ImageActionsService      = (*mocks.ImageActionsService)(nil)
+	_ binarylane.ImagesService            = (*mocks.ImagesService)(nil)
+	_ binarylane.InvoicesService          = (*mocks.InvoicesService)(nil)
+	_ binarylane.KeysService              = (*mocks.KeysService)(nil)
+	_ binarylane.LoadBalancersService     = (*mocks.LoadBalancersService)(nil)
+	_ binarylane.ProjectsService          = (*mocks.ProjectsService)(nil)
+	_ binarylane.RegionsService           = (*mocks.RegionsService)(nil)
+	_ binarylane.ServerActionsService     = (*mocks.ServerActionsService)(nil)
+	_ binarylane.ServersService           = (*mocks.ServersService)(nil)
+	_ binarylane.SizesService             = (*mocks.SizesService)(nil)
+	_ binarylane.SnapshotsService         = (*mocks.SnapshotsService)(nil)
+	_ binarylane.TagsService              = (*mocks.TagsService)(nil)
+	_ binarylane.VPCsService              = (*mocks.VPCsService)(nil)
+	_ binarylane.VolumeActionsService     = (*mocks.VolumeActionsService)(nil)
+	_ binarylane.VolumesService           = (*mocks.VolumesService)(nil)
+)
@@ -0,0 +1,53 @@
+package binarylane
+
+import (
+	"context"
+	"net/http"
+)
+
+type operationContextKey struct{}
+
+type attemptContextKey struct{}
+
+// OperationFromContext returns the name of the service method that made a
+// request, such as "Servers.Create", from the context of a request being
+// sent by the client. It is intended for instrumentation, such as an
+// http.RoundTripper that names trace spans after the API call, and returns
+// false for requests not made by a service method.
+func OperationFromContext(ctx context.Context) (string, bool) {
+	op, ok := ctx.Value(operationContextKey{}).(string)
+	return op, ok && op != ""
+}
+
+// AttemptFromContext returns the attempt number, starting at 1, from the
+// context of a request being sent by the client. Attempts after the first
+// are retries made under the client's RetryPolicy.
+func AttemptFromContext(ctx context.Context) (int, bool) {
+	attempt, ok := ctx.Value(attemptContextKey{}).(int)
+	return attempt, ok
+}
+
+// withOperation returns ctx naming the service method op, such as
+// "Servers.Create", as the operation of the requests made with it. Each
+// service method sets its own name, replacing that of any method calling it.
+func withOperation(ctx context.Context, op string) context.Context {
+	return context.WithValue(ctx, operationContextKey{}, op)
+}
+
+// setOperation records on req the operation named by ctx, if any.
+func setOperation(ctx context.Context, req *http.Request) *http.Request {
+	op, ok := OperationFromContext(ctx)
+	if !ok {
+		return req
+	}
+	return req.WithContext(context.WithValue(req.Context(), operationContextKey{}, op))
+}
+
+// attemptContext returns ctx carrying the operation recorded on req and the
+// attempt number, for the request sent by DoRequestWithClient.
+func attemptContext(ctx context.Context, req *http.Request, attempt int) context.Context {
+	if op, ok := OperationFromContext(req.Context()); ok {
+		ctx = context.WithValue(ctx, operationContextKey{}, op)
+	}
+	return context.WithValue(ctx, attemptContextKey{}, attempt)
+}
@@ -0,0 +1,101 @@
+package binarylane
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+// contextRecorder is an http.RoundTripper that records the operation and
+// attempt carried by the context of each request.
+type contextRecorder struct {
+	mu         sync.Mutex
+	operations []string
+	attempts   []int
+}
+
+func (r *contextRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	op, _ := OperationFromContext(req.Context())
+	attempt, _ := AttemptFromContext(req.Context())
+	r.mu.Lock()
+	r.operations = append(r.operations, op)
+	r.attempts = append(r.attempts, attempt)
+	r.mu.Unlock()
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func setupContextRecorder() *contextRecorder {
+	recorder := &contextRecorder{}
+	client.client = &http.Client{Transport: recorder}
+	return recorder
+}
+
+func TestOperationFromContext(t *testing.T) {
+	setup()
+	defer teardown()
+	recorder := setupContextRecorder()
+
+	mux.HandleFunc("/v2/servers", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"servers": [], "meta": {"total": 0}}`)
+	})
+	mux.HandleFunc("/v2/servers/1/actions", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"action": {"id": 1}}`)
+	})
+	mux.HandleFunc("/v2/domains/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"domain_records": []}`)
+	})
+
+	if _, _, err := client.Servers.List(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.ServerActions.Reboot(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Domains.Records(ctx, "example.com", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Domains.RecordsAll(ctx, "example.com"); err != nil {
+		t.Fatal(err)
+	}
+	req, _ := client.NewRequest(ctx, http.MethodGet, "v2/servers", nil)
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"Servers.List", "ServerActions.Reboot", "Domains.Records", "Domains.Records", ""}
+	if fmt.Sprint(recorder.operations) != fmt.Sprint(expected) {
+		t.Errorf("Operations = %q, expected %q", recorder.operations, expected)
+	}
+}
+
+func TestAttemptFromContext(t *testing.T) {
+	setup()
+	defer teardown()
+	recorder := setupContextRecorder()
+	if err := WithRetryPolicy(testRetryPolicy)(client); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := 0
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			http.Error(w, `{"message":"unavailable"}`, http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"account": {}}`)
+	})
+
+	if _, _, err := client.Account.Get(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(recorder.attempts) != "[1 2 3]" {
+		t.Errorf("Attempts = %v, expected [1 2 3]", recorder.attempts)
+	}
+	for _, op := range recorder.operations {
+		if op != "Account.Get" {
+			t.Errorf("Operation = %q on a retry, expected %q", op, "Account.Get")
+		}
+	}
+}
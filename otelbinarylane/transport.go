@@ -0,0 +1,288 @@
+// Package otelbinarylane instruments the BinaryLane API client with
+// OpenTelemetry tracing and metrics.
+//
+// Instrumentation is opt-in: wrap the transport of the http.Client given to
+// the API client with NewTransport.
+//
+//	httpClient := &http.Client{Transport: otelbinarylane.NewTransport(nil)}
+//	client := binarylane.NewClient(httpClient)
+//
+// Each request sent to the API gets a client span named after the service
+// method that made it, such as "Servers.Create". Retries made under the
+// client's RetryPolicy are sent as separate requests, so each attempt has
+// its own span, with the attempt number recorded as an attribute.
+//
+// The package lives in its own module so that the API client does not
+// depend on OpenTelemetry.
+package otelbinarylane
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope of the tracer and meter.
+const ScopeName = "github.com/binarylane/go-binarylane/otelbinarylane"
+
+// Attributes recorded on spans and metrics, in addition to the standard
+// HTTP attributes.
+const (
+	// OperationKey is the service method that made the request, such as
+	// "Servers.Create".
+	OperationKey = attribute.Key("binarylane.operation")
+	// AttemptKey is the attempt number of the request, starting at 1.
+	AttemptKey = attribute.Key("binarylane.attempt")
+	// RequestIDKey is the ID the API gave the request, from the
+	// X-Request-Id header or the request_id of an error response.
+	RequestIDKey = attribute.Key("binarylane.request_id")
+	// RateLimitRemainingKey is the number of requests remaining in the
+	// current rate limit window.
+	RateLimitRemainingKey = attribute.Key("binarylane.rate_limit.remaining")
+
+	methodKey    = attribute.Key("http.request.method")
+	statusKey    = attribute.Key("http.response.status_code")
+	errorTypeKey = attribute.Key("error.type")
+	urlKey       = attribute.Key("url.full")
+	resendKey    = attribute.Key("http.request.resend_count")
+)
+
+// Metric names.
+const (
+	DurationMetric           = "binarylane.client.request.duration"
+	ErrorsMetric             = "binarylane.client.request.errors"
+	RetriesMetric            = "binarylane.client.request.retries"
+	RateLimitRemainingMetric = "binarylane.client.rate_limit.remaining"
+)
+
+const (
+	headerRequestID     = "X-Request-Id"
+	headerRateRemaining = "RateLimit-Remaining"
+)
+
+// Option configures the instrumentation.
+type Option func(*config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagators    propagation.TextMapPropagator
+}
+
+// WithTracerProvider sets the TracerProvider used to create spans. The
+// default is the global TracerProvider.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the MeterProvider used to record metrics. The
+// default is the global MeterProvider.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+// WithPropagators sets the propagators used to add the trace context to
+// requests. The default is the global TextMapPropagator.
+func WithPropagators(p propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagators = p
+	}
+}
+
+// transport is an http.RoundTripper that traces and measures requests.
+type transport struct {
+	next        http.RoundTripper
+	tracer      trace.Tracer
+	propagators propagation.TextMapPropagator
+
+	duration  metric.Float64Histogram
+	errors    metric.Int64Counter
+	retries   metric.Int64Counter
+	rateLimit metric.Int64Gauge
+}
+
+// NewTransport returns an http.RoundTripper that sends requests with next,
+// recording a span and metrics for each. If next is nil,
+// http.DefaultTransport is used.
+//
+// The metrics are a histogram of request latency, counters of failed
+// requests and of retries, and a gauge of the rate limit remaining as last
+// reported by the API. They are recorded with the operation, HTTP method and
+// status code as attributes.
+func NewTransport(next http.RoundTripper, opts ...Option) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.tracerProvider == nil {
+		c.tracerProvider = otel.GetTracerProvider()
+	}
+	if c.meterProvider == nil {
+		c.meterProvider = otel.GetMeterProvider()
+	}
+	if c.propagators == nil {
+		c.propagators = otel.GetTextMapPropagator()
+	}
+
+	meter := c.meterProvider.Meter(ScopeName)
+	t := &transport{
+		next:        next,
+		tracer:      c.tracerProvider.Tracer(ScopeName),
+		propagators: c.propagators,
+	}
+
+	// An error creating an instrument is reported to the global error
+	// handler rather than stopping requests being sent.
+	var err error
+	if t.duration, err = meter.Float64Histogram(DurationMetric,
+		metric.WithDescription("Duration of requests to the BinaryLane API."),
+		metric.WithUnit("s")); err != nil {
+		otel.Handle(err)
+	}
+	if t.errors, err = meter.Int64Counter(ErrorsMetric,
+		metric.WithDescription("Requests to the BinaryLane API that failed or returned an error status."),
+		metric.WithUnit("{request}")); err != nil {
+		otel.Handle(err)
+	}
+	if t.retries, err = meter.Int64Counter(RetriesMetric,
+		metric.WithDescription("Requests to the BinaryLane API that were retries of an earlier attempt."),
+		metric.WithUnit("{request}")); err != nil {
+		otel.Handle(err)
+	}
+	if t.rateLimit, err = meter.Int64Gauge(RateLimitRemainingMetric,
+		metric.WithDescription("Requests remaining in the BinaryLane API rate limit window."),
+		metric.WithUnit("{request}")); err != nil {
+		otel.Handle(err)
+	}
+	return t
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	op, ok := binarylane.OperationFromContext(ctx)
+	name := op
+	if !ok {
+		name = req.Method
+	}
+	attempt, ok := binarylane.AttemptFromContext(ctx)
+	if !ok {
+		attempt = 1
+	}
+
+	attrs := []attribute.KeyValue{methodKey.String(req.Method)}
+	if op != "" {
+		attrs = append(attrs, OperationKey.String(op))
+	}
+	ctx, span := t.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+		trace.WithAttributes(
+			urlKey.String(redactURL(req)),
+			AttemptKey.Int(attempt),
+			resendKey.Int(attempt-1),
+		))
+	defer span.End()
+
+	if attempt > 1 {
+		t.retries.Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+
+	req = req.Clone(ctx)
+	t.propagators.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	elapsed := time.Since(start).Seconds()
+	if err != nil {
+		attrs = append(attrs, errorTypeKey.String(errorType(err)))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		t.duration.Record(ctx, elapsed, metric.WithAttributes(attrs...))
+		t.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		return nil, err
+	}
+
+	attrs = append(attrs, statusKey.Int(resp.StatusCode))
+	span.SetAttributes(statusKey.Int(resp.StatusCode))
+	if id := requestID(resp); id != "" {
+		span.SetAttributes(RequestIDKey.String(id))
+	}
+	if remaining, err := strconv.ParseInt(resp.Header.Get(headerRateRemaining), 10, 64); err == nil {
+		span.SetAttributes(RateLimitRemainingKey.Int64(remaining))
+		t.rateLimit.Record(ctx, remaining)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		attrs = append(attrs, errorTypeKey.String(strconv.Itoa(resp.StatusCode)))
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+		t.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+	t.duration.Record(ctx, elapsed, metric.WithAttributes(attrs...))
+	return resp, nil
+}
+
+// requestID returns the ID the API gave the request. Error responses are
+// read with binarylane.CheckResponse for the request_id in the body, and the
+// body is replaced so that the client can read it again.
+func requestID(resp *http.Response) string {
+	if id := resp.Header.Get(headerRequestID); id != "" {
+		return id
+	}
+	if resp.StatusCode < http.StatusBadRequest || resp.Body == nil {
+		return ""
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	copied := *resp
+	copied.Body = io.NopCloser(bytes.NewReader(body))
+	var errResp *binarylane.ErrorResponse
+	if errors.As(binarylane.CheckResponse(&copied), &errResp) {
+		return errResp.RequestID
+	}
+	return ""
+}
+
+// redactURL returns the URL of req without any user information.
+func redactURL(req *http.Request) string {
+	u := *req.URL
+	u.User = nil
+	return u.String()
+}
+
+// errorType returns a short description of err for the error.type
+// attribute.
+func errorType(err error) string {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	default:
+		return "transport"
+	}
+}
@@ -0,0 +1,257 @@
+package otelbinarylane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type testEnv struct {
+	mux    *http.ServeMux
+	client *binarylane.Client
+	spans  *tracetest.InMemoryExporter
+	reader *sdkmetric.ManualReader
+}
+
+func setup(t *testing.T, opts ...binarylane.ClientOpt) *testEnv {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	spans := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(spans))
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	transport := NewTransport(nil,
+		WithTracerProvider(tp),
+		WithMeterProvider(mp),
+		WithPropagators(propagation.TraceContext{}))
+	opts = append([]binarylane.ClientOpt{binarylane.SetBaseURL(server.URL)}, opts...)
+	client, err := binarylane.New(&http.Client{Transport: transport}, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testEnv{mux: mux, client: client, spans: spans, reader: reader}
+}
+
+func (e *testEnv) metrics(t *testing.T) map[string]metricdata.Aggregation {
+	var rm metricdata.ResourceMetrics
+	if err := e.reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	metrics := make(map[string]metricdata.Aggregation)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+	return metrics
+}
+
+func spanAttr(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func TestTransport_span(t *testing.T) {
+	env := setup(t)
+	var traceparent string
+	env.mux.HandleFunc("/v2/servers", func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("Traceparent")
+		w.Header().Set("X-Request-Id", "req-1")
+		w.Header().Set("RateLimit-Remaining", "42")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"server": {"id": 1, "name": "web"}}`)
+	})
+
+	server, _, err := env.client.Servers.Create(context.Background(), &binarylane.ServerCreateRequest{Name: "web"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.ID != 1 {
+		t.Errorf("Servers.Create returned %+v", server)
+	}
+
+	spans := env.spans.GetSpans().Snapshots()
+	if len(spans) != 1 {
+		t.Fatalf("Recorded %d spans, expected 1", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "Servers.Create" {
+		t.Errorf("Span name = %q, expected %q", span.Name(), "Servers.Create")
+	}
+	expected := map[attribute.Key]attribute.Value{
+		OperationKey:          attribute.StringValue("Servers.Create"),
+		AttemptKey:            attribute.IntValue(1),
+		RequestIDKey:          attribute.StringValue("req-1"),
+		RateLimitRemainingKey: attribute.Int64Value(42),
+		statusKey:             attribute.IntValue(http.StatusAccepted),
+		methodKey:             attribute.StringValue(http.MethodPost),
+	}
+	for key, value := range expected {
+		if got := spanAttr(span, key); got != value {
+			t.Errorf("Span attribute %s = %v, expected %v", key, got.Emit(), value.Emit())
+		}
+	}
+	if span.Status().Code == codes.Error {
+		t.Errorf("Span status = %v, expected no error", span.Status())
+	}
+	if traceparent == "" || traceparent[36:52] != span.SpanContext().SpanID().String() {
+		t.Errorf("Traceparent header = %q, expected the span's context", traceparent)
+	}
+
+	metrics := env.metrics(t)
+	gauge, ok := metrics[RateLimitRemainingMetric].(metricdata.Gauge[int64])
+	if !ok || len(gauge.DataPoints) != 1 || gauge.DataPoints[0].Value != 42 {
+		t.Errorf("%s = %+v, expected 42", RateLimitRemainingMetric, metrics[RateLimitRemainingMetric])
+	}
+	hist, ok := metrics[DurationMetric].(metricdata.Histogram[float64])
+	if !ok || len(hist.DataPoints) != 1 || hist.DataPoints[0].Count != 1 {
+		t.Errorf("%s = %+v, expected one request", DurationMetric, metrics[DurationMetric])
+	}
+	if _, ok := metrics[ErrorsMetric]; ok {
+		t.Errorf("%s recorded for a successful request", ErrorsMetric)
+	}
+}
+
+func TestTransport_errorResponse(t *testing.T) {
+	env := setup(t)
+	env.mux.HandleFunc("/v2/servers/7", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"id": "not_found", "message": "The resource was not found.", "request_id": "req-404"}`)
+	})
+
+	_, _, err := env.client.Servers.Get(context.Background(), 7)
+	var errResp *binarylane.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.RequestID != "req-404" {
+		t.Fatalf("Servers.Get returned %v, expected the error response to be readable", err)
+	}
+
+	spans := env.spans.GetSpans().Snapshots()
+	if len(spans) != 1 {
+		t.Fatalf("Recorded %d spans, expected 1", len(spans))
+	}
+	if got := spanAttr(spans[0], RequestIDKey).AsString(); got != "req-404" {
+		t.Errorf("Span %s = %q, expected %q", RequestIDKey, got, "req-404")
+	}
+	if spans[0].Status().Code != codes.Error {
+		t.Errorf("Span status = %v, expected an error", spans[0].Status())
+	}
+
+	counter, ok := env.metrics(t)[ErrorsMetric].(metricdata.Sum[int64])
+	if !ok || len(counter.DataPoints) != 1 || counter.DataPoints[0].Value != 1 {
+		t.Fatalf("%s = %+v, expected 1", ErrorsMetric, counter)
+	}
+	status, _ := counter.DataPoints[0].Attributes.Value(statusKey)
+	op, _ := counter.DataPoints[0].Attributes.Value(OperationKey)
+	if status.AsInt64() != http.StatusNotFound || op.AsString() != "Servers.Get" {
+		t.Errorf("%s attributes = %v", ErrorsMetric, counter.DataPoints[0].Attributes.ToSlice())
+	}
+}
+
+func TestTransport_retries(t *testing.T) {
+	env := setup(t, binarylane.WithRetryPolicy(binarylane.RetryPolicy{
+		MaxRetries: 2,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: time.Millisecond,
+	}))
+	calls := 0
+	env.mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			http.Error(w, `{"message": "unavailable"}`, http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"account": {}}`)
+	})
+
+	if _, _, err := env.client.Account.Get(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := env.spans.GetSpans().Snapshots()
+	if len(spans) != 3 {
+		t.Fatalf("Recorded %d spans, expected one for each attempt", len(spans))
+	}
+	for i, span := range spans {
+		if span.Name() != "Account.Get" {
+			t.Errorf("Span %d name = %q, expected %q", i, span.Name(), "Account.Get")
+		}
+		if got := spanAttr(span, AttemptKey).AsInt64(); got != int64(i+1) {
+			t.Errorf("Span %d %s = %d, expected %d", i, AttemptKey, got, i+1)
+		}
+	}
+
+	metrics := env.metrics(t)
+	retries, ok := metrics[RetriesMetric].(metricdata.Sum[int64])
+	if !ok || len(retries.DataPoints) != 1 || retries.DataPoints[0].Value != 2 {
+		t.Errorf("%s = %+v, expected 2", RetriesMetric, metrics[RetriesMetric])
+	}
+	errs, ok := metrics[ErrorsMetric].(metricdata.Sum[int64])
+	if !ok || len(errs.DataPoints) != 1 || errs.DataPoints[0].Value != 2 {
+		t.Errorf("%s = %+v, expected 2", ErrorsMetric, metrics[ErrorsMetric])
+	}
+}
+
+func TestTransport_transportError(t *testing.T) {
+	env := setup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, _, err := env.client.Regions.List(ctx, nil); err == nil {
+		t.Fatal("Regions.List: expected an error")
+	}
+
+	spans := env.spans.GetSpans().Snapshots()
+	if len(spans) != 1 {
+		t.Fatalf("Recorded %d spans, expected 1", len(spans))
+	}
+	if spans[0].Status().Code != codes.Error || len(spans[0].Events()) == 0 {
+		t.Errorf("Span status = %v, expected the error to be recorded", spans[0].Status())
+	}
+	errs, ok := env.metrics(t)[ErrorsMetric].(metricdata.Sum[int64])
+	if !ok || len(errs.DataPoints) != 1 {
+		t.Fatalf("%s = %+v, expected 1", ErrorsMetric, errs)
+	}
+	if errType, _ := errs.DataPoints[0].Attributes.Value(errorTypeKey); errType.AsString() != "canceled" {
+		t.Errorf("%s = %q, expected %q", errorTypeKey, errType.AsString(), "canceled")
+	}
+}
+
+func TestTransport_notServiceMethod(t *testing.T) {
+	env := setup(t)
+	env.mux.HandleFunc("/v2/custom", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	req, _ := env.client.NewRequest(context.Background(), http.MethodGet, "v2/custom", nil)
+	if _, err := env.client.Do(context.Background(), req, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := env.spans.GetSpans().Snapshots()
+	if len(spans) != 1 || spans[0].Name() != http.MethodGet {
+		t.Fatalf("Spans = %v, expected one named after the method", spans)
+	}
+	if spanAttr(spans[0], OperationKey).Type() != attribute.INVALID {
+		t.Errorf("Span has %s for a request not made by a service method", OperationKey)
+	}
+}
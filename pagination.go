@@ -0,0 +1,184 @@
+package binarylane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrStopPagination may be returned, or wrapped, by a PageFunc to stop
+// Paginate before the last page has been fetched. Paginate itself then
+// returns nil.
+var ErrStopPagination = errors.New("stop pagination")
+
+// PageFunc fetches the page of results described by opt and handles the
+// items it contains. It is typically a closure around one of the List
+// methods, for example:
+//
+//	func(ctx context.Context, opt *ListOptions) (*Response, error) {
+//		servers, resp, err := client.Servers.List(ctx, opt)
+//		all = append(all, servers...)
+//		return resp, err
+//	}
+type PageFunc func(ctx context.Context, opt *ListOptions) (*Response, error)
+
+// Paginate calls fetch for each page of a paginated result set, in order,
+// starting at opt.Page (or the first page if opt is nil). It follows the
+// next page link returned by the API and stops after the last page, when
+// fetch returns an error, or when fetch returns ErrStopPagination.
+func Paginate(ctx context.Context, opt *ListOptions, fetch PageFunc) error {
+	o := firstPage(opt)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		page := o
+		resp, err := fetch(ctx, &page)
+		if errors.Is(err, ErrStopPagination) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		next, ok, err := nextPage(resp, o.Page)
+		if err != nil || !ok {
+			return err
+		}
+		o.Page = next
+	}
+}
+
+// PaginateConcurrent behaves like Paginate, except that once the first page
+// has been fetched and the number of pages is known, the remaining pages are
+// fetched using up to concurrency parallel requests. The number of pages is
+// taken from the last page link or, when opt.PerPage is set, from
+// Meta.Total. If neither is available the remaining pages are fetched
+// sequentially.
+//
+// fetch must be safe for concurrent use, and pages after the first may be
+// handled in any order; opt.Page identifies the page being fetched.
+func PaginateConcurrent(ctx context.Context, opt *ListOptions, concurrency int, fetch PageFunc) error {
+	if concurrency < 1 {
+		return NewArgError("concurrency", "cannot be less than 1")
+	}
+
+	o := firstPage(opt)
+	page := o
+	resp, err := fetch(ctx, &page)
+	if errors.Is(err, ErrStopPagination) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	next, ok, err := nextPage(resp, o.Page)
+	if err != nil || !ok {
+		return err
+	}
+
+	last, ok := lastPage(resp, o.PerPage)
+	if !ok {
+		o.Page = next
+		return Paginate(ctx, &o, fetch)
+	}
+
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+		pages    = make(chan int)
+	)
+	fail := func(err error) {
+		errOnce.Do(func() {
+			if !errors.Is(err, ErrStopPagination) {
+				firstErr = err
+			}
+			cancel()
+		})
+	}
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := range pages {
+				page := o
+				page.Page = n
+				if _, err := fetch(ctx, &page); err != nil {
+					fail(err)
+				}
+			}
+		}()
+	}
+
+feed:
+	for n := next; n <= last; n++ {
+		select {
+		case pages <- n:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(pages)
+	wg.Wait()
+
+	if firstErr == nil {
+		// Report cancellation of the caller's context, but not our own.
+		return parent.Err()
+	}
+	return firstErr
+}
+
+// firstPage returns a copy of opt with the page number defaulted to 1.
+func firstPage(opt *ListOptions) ListOptions {
+	var o ListOptions
+	if opt != nil {
+		o = *opt
+	}
+	if o.Page < 1 {
+		o.Page = 1
+	}
+	return o
+}
+
+// nextPage returns the number of the page following current, according to
+// the links in resp.
+func nextPage(resp *Response, current int) (int, bool, error) {
+	if resp == nil || resp.Links == nil || resp.Links.IsLastPage() {
+		return 0, false, nil
+	}
+
+	next, err := pageForURL(resp.Links.Pages.Next)
+	if err != nil {
+		return 0, false, err
+	}
+	if next <= current {
+		return 0, false, fmt.Errorf("next page %d does not follow page %d", next, current)
+	}
+
+	return next, true, nil
+}
+
+// lastPage returns the number of the final page, using either the last page
+// link or the total number of results.
+func lastPage(resp *Response, perPage int) (int, bool) {
+	if resp.Links != nil && resp.Links.Pages != nil && resp.Links.Pages.Last != "" {
+		if last, err := pageForURL(resp.Links.Pages.Last); err == nil {
+			return last, true
+		}
+	}
+
+	if resp.Meta != nil && perPage > 0 {
+		return (resp.Meta.Total + perPage - 1) / perPage, true
+	}
+
+	return 0, false
+}
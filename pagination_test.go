@@ -0,0 +1,242 @@
+package binarylane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// handlePagedKeys serves pages of one key each, with links to the next and
+// last pages.
+func handlePagedKeys(t *testing.T, pages int) {
+	mux.HandleFunc("/v2/account/keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+
+		links := fmt.Sprintf(`"last":"http://example.com/v2/account/keys?page=%d"`, pages)
+		if page < pages {
+			links += fmt.Sprintf(`,"next":"http://example.com/v2/account/keys?page=%d"`, page+1)
+		}
+		if page > 1 {
+			links += fmt.Sprintf(`,"prev":"http://example.com/v2/account/keys?page=%d"`, page-1)
+		}
+		fmt.Fprintf(w, `{"ssh_keys":[{"id":%d}],"links":{"pages":{%s}},"meta":{"total":%d}}`, page, links, pages)
+	})
+}
+
+func TestPaginate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handlePagedKeys(t, 3)
+
+	var ids []int
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		keys, resp, err := client.Keys.List(ctx, opt)
+		for _, k := range keys {
+			ids = append(ids, k.ID)
+		}
+		return resp, err
+	})
+	if err != nil {
+		t.Fatalf("Paginate returned error: %v", err)
+	}
+
+	expected := []int{1, 2, 3}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Paginate visited %v, expected %v", ids, expected)
+	}
+}
+
+func TestPaginate_startPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handlePagedKeys(t, 3)
+
+	var pages []int
+	err := Paginate(ctx, &ListOptions{Page: 2, PerPage: 1}, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		if opt.PerPage != 1 {
+			t.Errorf("PerPage = %d, expected 1", opt.PerPage)
+		}
+		pages = append(pages, opt.Page)
+		_, resp, err := client.Keys.List(ctx, opt)
+		return resp, err
+	})
+	if err != nil {
+		t.Fatalf("Paginate returned error: %v", err)
+	}
+
+	expected := []int{2, 3}
+	if !reflect.DeepEqual(pages, expected) {
+		t.Errorf("Paginate visited pages %v, expected %v", pages, expected)
+	}
+}
+
+func TestPaginate_stop(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handlePagedKeys(t, 5)
+
+	calls := 0
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		calls++
+		_, resp, err := client.Keys.List(ctx, opt)
+		if err == nil && opt.Page == 2 {
+			return resp, ErrStopPagination
+		}
+		return resp, err
+	})
+	if err != nil {
+		t.Fatalf("Paginate returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Paginate made %d calls, expected 2", calls)
+	}
+}
+
+func TestPaginate_stopWrapped(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handlePagedKeys(t, 5)
+
+	calls := 0
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		calls++
+		_, resp, err := client.Keys.List(ctx, opt)
+		if err == nil {
+			err = fmt.Errorf("found key: %w", ErrStopPagination)
+		}
+		return resp, err
+	})
+	if err != nil {
+		t.Fatalf("Paginate returned error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Paginate made %d calls, expected 1", calls)
+	}
+}
+
+func TestPaginate_error(t *testing.T) {
+	expected := errors.New("boom")
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		return nil, expected
+	})
+	if err != expected {
+		t.Errorf("Paginate returned %v, expected %v", err, expected)
+	}
+}
+
+func TestPaginate_linksDoNotAdvance(t *testing.T) {
+	resp := &Response{Links: &Links{Pages: &Pages{Next: "http://example.com/v2/account/keys?page=1"}}}
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		return resp, nil
+	})
+	if err == nil {
+		t.Error("Expected error when next page does not advance")
+	}
+}
+
+func TestPaginateConcurrent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handlePagedKeys(t, 6)
+
+	var (
+		mu  sync.Mutex
+		ids []int
+	)
+	err := PaginateConcurrent(ctx, nil, 3, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		keys, resp, err := client.Keys.List(ctx, opt)
+		mu.Lock()
+		for _, k := range keys {
+			ids = append(ids, k.ID)
+		}
+		mu.Unlock()
+		return resp, err
+	})
+	if err != nil {
+		t.Fatalf("PaginateConcurrent returned error: %v", err)
+	}
+
+	sort.Ints(ids)
+	expected := []int{1, 2, 3, 4, 5, 6}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("PaginateConcurrent visited %v, expected %v", ids, expected)
+	}
+}
+
+func TestPaginateConcurrent_metaTotal(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/account/keys", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		next := ""
+		if page < 4 {
+			next = fmt.Sprintf(`"links":{"pages":{"next":"http://example.com/v2/account/keys?page=%d"}},`, page+1)
+		}
+		fmt.Fprintf(w, `{"ssh_keys":[{"id":%d},{"id":%d}],%s"meta":{"total":7}}`, page*2-1, page*2, next)
+	})
+
+	var (
+		mu    sync.Mutex
+		pages []int
+	)
+	err := PaginateConcurrent(ctx, &ListOptions{PerPage: 2}, 2, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		mu.Lock()
+		pages = append(pages, opt.Page)
+		mu.Unlock()
+		_, resp, err := client.Keys.List(ctx, opt)
+		return resp, err
+	})
+	if err != nil {
+		t.Fatalf("PaginateConcurrent returned error: %v", err)
+	}
+
+	sort.Ints(pages)
+	expected := []int{1, 2, 3, 4}
+	if !reflect.DeepEqual(pages, expected) {
+		t.Errorf("PaginateConcurrent fetched pages %v, expected %v", pages, expected)
+	}
+}
+
+func TestPaginateConcurrent_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handlePagedKeys(t, 10)
+
+	expected := errors.New("boom")
+	err := PaginateConcurrent(ctx, nil, 2, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		_, resp, err := client.Keys.List(ctx, opt)
+		if err == nil && opt.Page == 3 {
+			return resp, expected
+		}
+		return resp, err
+	})
+	if err != expected {
+		t.Errorf("PaginateConcurrent returned %v, expected %v", err, expected)
+	}
+}
+
+func TestPaginateConcurrent_invalid(t *testing.T) {
+	err := PaginateConcurrent(ctx, nil, 0, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		return nil, nil
+	})
+	if _, ok := err.(*ArgError); !ok {
+		t.Errorf("Expected *ArgError, got %#v", err)
+	}
+}
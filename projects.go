@@ -20,6 +20,7 @@ const (
 // See: https://api.binarylane.com.au/reference/#projects
 type ProjectsService interface {
 	List(context.Context, *ListOptions) ([]Project, *Response, error)
+	ListAll(context.Context) ([]Project, error)
 	GetDefault(context.Context) (*Project, *Response, error)
 	Get(context.Context, string) (*Project, *Response, error)
 	Create(context.Context, *CreateProjectRequest) (*Project, *Response, error)
@@ -27,6 +28,7 @@ type ProjectsService interface {
 	Delete(context.Context, string) (*Response, error)
 
 	ListResources(context.Context, string, *ListOptions) ([]ProjectResource, *Response, error)
+	ListResourcesAll(context.Context, string) ([]ProjectResource, error)
 	AssignResources(context.Context, string, ...interface{}) ([]ProjectResource, *Response, error)
 }
 
@@ -142,6 +144,7 @@ var _ ProjectsService = &ProjectsServiceOp{}
 
 // List Projects.
 func (p *ProjectsServiceOp) List(ctx context.Context, opts *ListOptions) ([]Project, *Response, error) {
+	ctx = withOperation(ctx, "Projects.List")
 	path, err := addOptions(projectsBasePath, opts)
 	if err != nil {
 		return nil, nil, err
@@ -167,18 +170,36 @@ func (p *ProjectsServiceOp) List(ctx context.Context, opts *ListOptions) ([]Proj
 	return root.Projects, resp, err
 }
 
+// ListAll lists all projects, fetching every page of results.
+func (p *ProjectsServiceOp) ListAll(ctx context.Context) ([]Project, error) {
+	var list []Project
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := p.List(ctx, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
 // GetDefault project.
 func (p *ProjectsServiceOp) GetDefault(ctx context.Context) (*Project, *Response, error) {
+	ctx = withOperation(ctx, "Projects.GetDefault")
 	return p.getHelper(ctx, "default")
 }
 
 // Get retrieves a single project by its ID.
 func (p *ProjectsServiceOp) Get(ctx context.Context, projectID string) (*Project, *Response, error) {
+	ctx = withOperation(ctx, "Projects.Get")
 	return p.getHelper(ctx, projectID)
 }
 
 // Create a new project.
 func (p *ProjectsServiceOp) Create(ctx context.Context, cr *CreateProjectRequest) (*Project, *Response, error) {
+	ctx = withOperation(ctx, "Projects.Create")
 	req, err := p.client.NewRequest(ctx, http.MethodPost, projectsBasePath, cr)
 	if err != nil {
 		return nil, nil, err
@@ -195,6 +216,7 @@ func (p *ProjectsServiceOp) Create(ctx context.Context, cr *CreateProjectRequest
 
 // Update an existing project.
 func (p *ProjectsServiceOp) Update(ctx context.Context, projectID string, ur *UpdateProjectRequest) (*Project, *Response, error) {
+	ctx = withOperation(ctx, "Projects.Update")
 	path := path.Join(projectsBasePath, projectID)
 	req, err := p.client.NewRequest(ctx, http.MethodPatch, path, ur)
 	if err != nil {
@@ -213,6 +235,7 @@ func (p *ProjectsServiceOp) Update(ctx context.Context, projectID string, ur *Up
 // Delete an existing project. You cannot have any resources in a project
 // before deleting it. See the API documentation for more details.
 func (p *ProjectsServiceOp) Delete(ctx context.Context, projectID string) (*Response, error) {
+	ctx = withOperation(ctx, "Projects.Delete")
 	path := path.Join(projectsBasePath, projectID)
 	req, err := p.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -224,6 +247,7 @@ func (p *ProjectsServiceOp) Delete(ctx context.Context, projectID string) (*Resp
 
 // ListResources lists all resources in a project.
 func (p *ProjectsServiceOp) ListResources(ctx context.Context, projectID string, opts *ListOptions) ([]ProjectResource, *Response, error) {
+	ctx = withOperation(ctx, "Projects.ListResources")
 	basePath := path.Join(projectsBasePath, projectID, "resources")
 	path, err := addOptions(basePath, opts)
 	if err != nil {
@@ -250,6 +274,22 @@ func (p *ProjectsServiceOp) ListResources(ctx context.Context, projectID string,
 	return root.Resources, resp, err
 }
 
+// ListResourcesAll lists all the resources of a project, fetching every page
+// of results.
+func (p *ProjectsServiceOp) ListResourcesAll(ctx context.Context, projectID string) ([]ProjectResource, error) {
+	var list []ProjectResource
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := p.ListResources(ctx, projectID, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
 // AssignResources assigns one or more resources to a project. AssignResources
 // accepts resources in two possible formats:
 //  1. The resource type, like `&Server{ID: 1}` or `&FloatingIP{IP: "1.2.3.4"}`
@@ -258,6 +298,7 @@ func (p *ProjectsServiceOp) ListResources(ctx context.Context, projectID string,
 // There is no unassign. To move a resource to another project, just assign
 // it to that other project.
 func (p *ProjectsServiceOp) AssignResources(ctx context.Context, projectID string, resources ...interface{}) ([]ProjectResource, *Response, error) {
+	ctx = withOperation(ctx, "Projects.AssignResources")
 	path := path.Join(projectsBasePath, projectID, "resources")
 
 	ar := &assignResourcesRequest{
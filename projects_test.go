@@ -358,6 +358,30 @@ func TestProjects_ListResources(t *testing.T) {
 	}
 }
 
+func TestProjects_ListResourcesAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/projects/project-1/resources", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"resources":[{"urn":"bl:server:2"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"resources":[{"urn":"bl:server:1"}], "links":{"pages":{"next":"http://example.com/v2/projects/project-1/resources?page=2"}}}`)
+	})
+
+	resources, err := client.Projects.ListResourcesAll(ctx, "project-1")
+	if err != nil {
+		t.Errorf("Projects.ListResourcesAll returned error: %v", err)
+	}
+
+	expected := []ProjectResource{{URN: "bl:server:1"}, {URN: "bl:server:2"}}
+	if !reflect.DeepEqual(resources, expected) {
+		t.Errorf("Projects.ListResourcesAll returned %+v, expected %+v", resources, expected)
+	}
+}
+
 func TestProjects_ListResourcesWithMultiplePages(t *testing.T) {
 	setup()
 	defer teardown()
@@ -0,0 +1,108 @@
+package binarylane
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// rateLimiter paces requests so that the number of remaining requests in the
+// current rate limit window does not drop below a floor. It is shared by all
+// goroutines using the same Client.
+type rateLimiter struct {
+	floor int
+
+	mu sync.Mutex
+	// known is false until the API has reported a rate limit, and again
+	// once the reported window has reset.
+	known     bool
+	remaining int
+	reset     time.Time
+}
+
+// WithRateLimiter is a client option that makes the client wait, before
+// sending a request, whenever the API reports that no more than floor
+// requests remain in the current rate limit window. Requests resume once the
+// window resets, or fail with the context's error if it is done first.
+//
+// The limiter learns the rate limit from API responses, so requests are not
+// paced until the first response has been received. With WithCache,
+// responses served from the cache are not paced.
+func WithRateLimiter(floor int) ClientOpt {
+	return func(c *Client) error {
+		if floor < 0 {
+			return NewArgError("floor", "cannot be less than 0")
+		}
+
+		c.rateLimiter = &rateLimiter{floor: floor}
+		return nil
+	}
+}
+
+type rateLimiterContextKey struct{}
+
+// contextWithRateLimiter returns ctx carrying l, for a transport such as the
+// cache's to wait on only for requests it sends to the API.
+func contextWithRateLimiter(ctx context.Context, l *rateLimiter) context.Context {
+	return context.WithValue(ctx, rateLimiterContextKey{}, l)
+}
+
+// waitRateLimit waits on the rate limiter carried by ctx, if any.
+func waitRateLimit(ctx context.Context) error {
+	if l, ok := ctx.Value(rateLimiterContextKey{}).(*rateLimiter); ok {
+		return l.wait(ctx)
+	}
+	return nil
+}
+
+// wait blocks until a request may be sent, reserving one request from the
+// remaining allowance.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		if !l.known || l.remaining > l.floor {
+			l.remaining--
+			l.mu.Unlock()
+			return nil
+		}
+
+		delay := time.Until(l.reset)
+		if delay <= 0 {
+			l.known = false
+			l.mu.Unlock()
+			continue
+		}
+		l.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// update records the rate limit reported in a response.
+func (l *rateLimiter) update(header http.Header) {
+	rate := parseRate(header)
+	if rate.Limit == 0 || rate.Reset.IsZero() {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	switch {
+	case !l.known || rate.Reset.After(l.reset):
+		l.remaining = rate.Remaining
+		l.reset = rate.Reset.Time
+		l.known = true
+	case rate.Reset.Time.Equal(l.reset) && rate.Remaining < l.remaining:
+		// Responses for concurrent requests may arrive out of order, so
+		// only ever lower the allowance within a window.
+		l.remaining = rate.Remaining
+	}
+}
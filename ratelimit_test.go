@@ -0,0 +1,137 @@
+package binarylane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func rateHeader(limit, remaining int, reset time.Time) http.Header {
+	h := http.Header{}
+	h.Set(headerRateLimit, fmt.Sprint(limit))
+	h.Set(headerRateRemaining, fmt.Sprint(remaining))
+	h.Set(headerRateReset, fmt.Sprint(reset.Unix()))
+	return h
+}
+
+func TestRateLimiter_unknownDoesNotBlock(t *testing.T) {
+	l := &rateLimiter{floor: 10}
+	for i := 0; i < 100; i++ {
+		if err := l.wait(ctx); err != nil {
+			t.Fatalf("wait(): %v", err)
+		}
+	}
+}
+
+func TestRateLimiter_blocksAtFloor(t *testing.T) {
+	l := &rateLimiter{floor: 1}
+	l.update(rateHeader(60, 3, time.Now().Add(time.Hour)))
+
+	// Two requests may be made before the floor is reached.
+	for i := 0; i < 2; i++ {
+		if err := l.wait(ctx); err != nil {
+			t.Fatalf("wait(): %v", err)
+		}
+	}
+
+	cctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := l.wait(cctx); err != context.DeadlineExceeded {
+		t.Errorf("wait() = %v, expected %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRateLimiter_resumesAfterReset(t *testing.T) {
+	l := &rateLimiter{floor: 0}
+	l.update(rateHeader(60, 0, time.Now().Add(time.Second)))
+
+	start := time.Now()
+	if err := l.wait(ctx); err != nil {
+		t.Fatalf("wait(): %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("wait() took %v, expected at most the reset interval", elapsed)
+	}
+}
+
+func TestRateLimiter_updateOutOfOrder(t *testing.T) {
+	reset := time.Now().Add(time.Hour)
+	l := &rateLimiter{}
+	l.update(rateHeader(60, 10, reset))
+	l.update(rateHeader(60, 20, reset))
+
+	if l.remaining != 10 {
+		t.Errorf("remaining = %d, expected 10", l.remaining)
+	}
+
+	l.update(rateHeader(60, 59, reset.Add(time.Hour)))
+	if l.remaining != 59 {
+		t.Errorf("remaining after new window = %d, expected 59", l.remaining)
+	}
+}
+
+func TestRateLimiter_ignoresMissingHeaders(t *testing.T) {
+	l := &rateLimiter{}
+	l.update(http.Header{})
+	if l.known {
+		t.Error("Expected rate limit to remain unknown")
+	}
+}
+
+func TestDo_rateLimiter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := WithRateLimiter(5)(client); err != nil {
+		t.Fatalf("WithRateLimiter(): %v", err)
+	}
+
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	reset := time.Now().Add(time.Hour)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		remaining := 8 - calls
+		mu.Unlock()
+		for k, v := range rateHeader(60, remaining, reset) {
+			w.Header()[k] = v
+		}
+	})
+
+	// The limiter learns the rate limit from the first response.
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			req, _ := client.NewRequest(cctx, http.MethodGet, "/", nil)
+			_, _ = client.Do(cctx, req, nil)
+		}()
+	}
+	wg.Wait()
+
+	// The first request learns that 7 requests remain; two more may be sent
+	// before reaching the floor of 5.
+	if calls != 3 {
+		t.Errorf("Server calls = %d, expected 3", calls)
+	}
+}
+
+func TestWithRateLimiter_invalid(t *testing.T) {
+	if _, err := New(nil, WithRateLimiter(-1)); err == nil {
+		t.Error("Expected error for negative floor")
+	}
+}
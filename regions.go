@@ -10,6 +10,7 @@ import (
 // See: https://api.binarylane.com.au/reference#regions
 type RegionsService interface {
 	List(context.Context, *ListOptions) ([]Region, *Response, error)
+	ListAll(context.Context) ([]Region, error)
 }
 
 // RegionsServiceOp handles communication with the region related methods of the
@@ -41,6 +42,7 @@ func (r Region) String() string {
 
 // List all regions
 func (s *RegionsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Region, *Response, error) {
+	ctx = withOperation(ctx, "Regions.List")
 	path := "v2/regions"
 	path, err := addOptions(path, opt)
 	if err != nil {
@@ -66,3 +68,18 @@ func (s *RegionsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Region
 
 	return root.Regions, resp, err
 }
+
+// ListAll lists all regions, fetching every page of results.
+func (s *RegionsServiceOp) ListAll(ctx context.Context) ([]Region, error) {
+	var list []Region
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := s.List(ctx, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,109 @@
+package binarylane
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// CorrelationIDHeader is the request header set by WithCorrelationID.
+const CorrelationIDHeader = "X-Correlation-Id"
+
+// RequestOption customises a single request, for example by adding a header
+// or a query parameter. Options are given to NewRequest directly, or are
+// carried by a context made with ContextWithRequestOptions so that they
+// apply to the requests made by any service method called with it:
+//
+//	ctx = binarylane.ContextWithRequestOptions(ctx, binarylane.WithCorrelationID(jobID))
+//	servers, _, err := client.Servers.List(ctx, nil)
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	header  http.Header
+	query   url.Values
+	timeout time.Duration
+}
+
+type requestOptionsContextKey struct{}
+
+type requestTimeoutContextKey struct{}
+
+// ContextWithRequestOptions returns a copy of ctx carrying opts, which are
+// applied to every request created with it after any options already
+// carried by ctx.
+func ContextWithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	existing, _ := ctx.Value(requestOptionsContextKey{}).([]RequestOption)
+	all := make([]RequestOption, 0, len(existing)+len(opts))
+	all = append(append(all, existing...), opts...)
+	return context.WithValue(ctx, requestOptionsContextKey{}, all)
+}
+
+// WithHeader sets a header on the request, replacing any value the client
+// would otherwise send.
+func WithHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		o.header.Set(key, value)
+	}
+}
+
+// WithQuery adds a query parameter to the request, such as a filter that
+// the service methods do not model. Parameters already in the request's URL
+// are kept.
+func WithQuery(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		o.query.Add(key, value)
+	}
+}
+
+// WithTimeout limits how long Do may take to send the request and read its
+// response, including any retries.
+func WithTimeout(d time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = d
+	}
+}
+
+// WithCorrelationID tags the request with an ID in the X-Correlation-Id
+// header, so that it can be matched with the caller's own logs.
+func WithCorrelationID(id string) RequestOption {
+	return WithHeader(CorrelationIDHeader, id)
+}
+
+// applyRequestOptions applies the options carried by ctx and then opts to
+// req, returning the request to send.
+func applyRequestOptions(ctx context.Context, req *http.Request, opts []RequestOption) *http.Request {
+	carried, _ := ctx.Value(requestOptionsContextKey{}).([]RequestOption)
+	if len(carried) == 0 && len(opts) == 0 {
+		return req
+	}
+
+	o := &requestOptions{header: make(http.Header), query: make(url.Values)}
+	for _, opt := range carried {
+		opt(o)
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	for key, values := range o.header {
+		req.Header[key] = values
+	}
+	if len(o.query) > 0 {
+		q := req.URL.Query()
+		for key, values := range o.query {
+			q[key] = append(q[key], values...)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+	if o.timeout > 0 {
+		req = req.WithContext(context.WithValue(req.Context(), requestTimeoutContextKey{}, o.timeout))
+	}
+	return req
+}
+
+// requestTimeout returns the timeout set on req with WithTimeout.
+func requestTimeout(req *http.Request) (time.Duration, bool) {
+	d, ok := req.Context().Value(requestTimeoutContextKey{}).(time.Duration)
+	return d, ok
+}
@@ -0,0 +1,108 @@
+package binarylane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewRequest_requestOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "v2/servers?page=2", nil,
+		WithHeader("User-Agent", "custom"),
+		WithQuery("tag_name", "web"),
+		WithQuery("tag_name", "db"),
+		WithCorrelationID("job-1"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := req.Header.Get("User-Agent"); got != "custom" {
+		t.Errorf("User-Agent = %q, expected %q", got, "custom")
+	}
+	if got := req.Header.Get(CorrelationIDHeader); got != "job-1" {
+		t.Errorf("%s = %q, expected %q", CorrelationIDHeader, got, "job-1")
+	}
+	q := req.URL.Query()
+	if q.Get("page") != "2" || !reflect.DeepEqual(q["tag_name"], []string{"web", "db"}) {
+		t.Errorf("Query = %v, expected page and both tag_name values", q)
+	}
+}
+
+func TestNewRequest_contextRequestOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	optCtx := ContextWithRequestOptions(ctx, WithCorrelationID("job-1"), WithHeader("X-Team", "ops"))
+	optCtx = ContextWithRequestOptions(optCtx, WithCorrelationID("job-2"))
+
+	req, err := client.NewRequest(optCtx, http.MethodGet, "v2/servers", nil, WithHeader("X-Team", "web"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := req.Header.Get(CorrelationIDHeader); got != "job-2" {
+		t.Errorf("%s = %q, expected the later option to win", CorrelationIDHeader, got)
+	}
+	if got := req.Header.Get("X-Team"); got != "web" {
+		t.Errorf("X-Team = %q, expected the explicit option to win", got)
+	}
+
+	req, _ = client.NewRequest(ctx, http.MethodGet, "v2/servers", nil)
+	if got := req.Header.Get(CorrelationIDHeader); got != "" {
+		t.Errorf("%s = %q without options, expected none", CorrelationIDHeader, got)
+	}
+}
+
+func TestRequestOptions_serviceMethod(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/servers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"page": "2", "status": "active"})
+		if got := r.Header.Get(CorrelationIDHeader); got != "job-1" {
+			t.Errorf("%s = %q, expected %q", CorrelationIDHeader, got, "job-1")
+		}
+		fmt.Fprint(w, `{"servers": [{"id": 1}]}`)
+	})
+
+	optCtx := ContextWithRequestOptions(ctx, WithCorrelationID("job-1"), WithQuery("status", "active"))
+	servers, _, err := client.Servers.List(optCtx, &ListOptions{Page: 2})
+	if err != nil {
+		t.Fatalf("Servers.List returned error: %v", err)
+	}
+	if len(servers) != 1 || servers[0].ID != 1 {
+		t.Errorf("Servers.List returned %+v", servers)
+	}
+}
+
+func TestDo_requestTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	release := make(chan struct{})
+	defer close(release)
+	mux.HandleFunc("/v2/servers", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodGet, "v2/servers", nil, WithTimeout(10*time.Millisecond))
+	start := time.Now()
+	_, err := client.Do(context.Background(), req, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Do() returned %v, expected the deadline to be exceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Do() took %v", elapsed)
+	}
+}
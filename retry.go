@@ -0,0 +1,220 @@
+package binarylane
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const headerRetryAfter = "Retry-After"
+
+// RetryPolicy controls how the client retries requests that failed because
+// of rate limiting or a transient server error. Only idempotent requests
+// (GET, HEAD, OPTIONS, PUT and DELETE) are retried, along with POST requests
+// that carry an idempotency key when WithIdempotencyKeys is used.
+type RetryPolicy struct {
+	// MaxRetries is the number of times a request is retried after the
+	// initial attempt has failed.
+	MaxRetries int
+
+	// MinBackoff is the delay before the first retry. Subsequent retries
+	// double the delay, up to MaxBackoff, with random jitter applied.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the longest the client will wait between two attempts.
+	// If the API asks the client to wait longer than this, via Retry-After
+	// or RateLimit-Reset, the request is not retried.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is a reasonable RetryPolicy for most callers.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 4,
+	MinBackoff: 1 * time.Second,
+	MaxBackoff: 30 * time.Second,
+}
+
+var (
+	jitterMu   sync.Mutex
+	jitterRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// WithRetryPolicy is a client option for retrying idempotent requests that
+// fail with a 429 or 5xx response, or with a transport error.
+func WithRetryPolicy(p RetryPolicy) ClientOpt {
+	return func(c *Client) error {
+		if p.MaxRetries < 0 {
+			return NewArgError("MaxRetries", "cannot be less than 0")
+		}
+		if p.MinBackoff <= 0 {
+			return NewArgError("MinBackoff", "must be greater than 0")
+		}
+		if p.MaxBackoff < p.MinBackoff {
+			return NewArgError("MaxBackoff", "cannot be less than MinBackoff")
+		}
+
+		c.retryPolicy = &p
+		return nil
+	}
+}
+
+// backoff returns how long to wait before making the given retry attempt,
+// which is numbered from 1. The second return value is false if the request
+// should not be retried.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	if attempt > p.MaxRetries {
+		return 0, false
+	}
+
+	if err == nil {
+		if resp.StatusCode < 400 {
+			return 0, false
+		}
+		err = &ErrorResponse{Response: resp}
+	}
+	if !IsRetryable(err) {
+		return 0, false
+	}
+	if resp != nil {
+		if wait, ok := serverBackoff(resp); ok {
+			return wait, wait <= p.MaxBackoff
+		}
+	}
+
+	wait := p.MinBackoff << uint(attempt-1)
+	if wait > p.MaxBackoff || wait <= 0 {
+		wait = p.MaxBackoff
+	}
+
+	// Full jitter: pick a random delay between MinBackoff and wait.
+	jitterMu.Lock()
+	jitter := time.Duration(jitterRand.Int63n(int64(wait-p.MinBackoff) + 1))
+	jitterMu.Unlock()
+
+	return p.MinBackoff + jitter, true
+}
+
+// retryableStatus reports whether a response with the given status code is
+// worth retrying. It backs IsRetryable.
+func retryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// serverBackoff returns the delay requested by the API through either the
+// Retry-After header or, for rate limited responses, the RateLimit-Reset
+// header.
+func serverBackoff(resp *http.Response) (time.Duration, bool) {
+	if v := resp.Header.Get(headerRetryAfter); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return nonNegative(time.Until(t)), true
+		}
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if v := resp.Header.Get(headerRateReset); v != "" {
+			if reset, _ := strconv.ParseInt(v, 10, 64); reset != 0 {
+				return nonNegative(time.Until(time.Unix(reset, 0))), true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// isIdempotent reports whether req may safely be sent more than once.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+
+	// The body must be replayable for the request to be resent.
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// doWithRetry sends req, pacing it with the client's rate limiter and
+// retrying it according to the client's RetryPolicy. It returns the final
+// HTTP response along with the number of attempts made.
+func (c *Client) doWithRetry(ctx context.Context, req *http.Request) (*http.Response, int, error) {
+	if c.rateLimiter != nil && c.cached {
+		// Leave the wait to the cache, so that responses it serves do
+		// not use up the allowance.
+		ctx = contextWithRateLimiter(ctx, c.rateLimiter)
+	}
+	for attempt := 1; ; attempt++ {
+		if c.rateLimiter != nil && !c.cached {
+			if err := c.rateLimiter.wait(ctx); err != nil {
+				return nil, attempt - 1, err
+			}
+		}
+
+		resp, err := c.sendAttempt(ctx, req, attempt)
+		if err == nil {
+			if c.rateLimiter != nil && !fromCache(resp) {
+				c.rateLimiter.update(resp.Header)
+			}
+			if c.onRequestCompleted != nil {
+				c.onRequestCompleted(req, resp)
+			}
+		}
+
+		if c.retryPolicy == nil || !c.canRetry(req) || ctx.Err() != nil {
+			return resp, attempt, err
+		}
+
+		wait, retry := c.retryPolicy.backoff(attempt, resp, err)
+		if !retry {
+			return resp, attempt, err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, attempt, err
+		case <-timer.C:
+		}
+
+		if resp != nil {
+			discardBody(resp.Body)
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, attempt, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// discardBody drains and closes body so the underlying connection can be
+// reused.
+func discardBody(body io.ReadCloser) {
+	_, _ = io.Copy(ioutil.Discard, body)
+	_ = body.Close()
+}
@@ -0,0 +1,239 @@
+package binarylane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinBackoff: time.Millisecond,
+	MaxBackoff: 10 * time.Millisecond,
+}
+
+func TestDo_retryTransientFailure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := WithRetryPolicy(testRetryPolicy)(client); err != nil {
+		t.Fatalf("WithRetryPolicy(): %v", err)
+	}
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			http.Error(w, `{"message":"unavailable"}`, http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+	resp, err := client.Do(context.Background(), req, nil)
+	if err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+
+	if resp.Attempts != 3 {
+		t.Errorf("Response attempts = %d, expected 3", resp.Attempts)
+	}
+	if calls != 3 {
+		t.Errorf("Server calls = %d, expected 3", calls)
+	}
+}
+
+func TestDo_retryReplaysBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := WithRetryPolicy(testRetryPolicy)(client); err != nil {
+		t.Fatalf("WithRetryPolicy(): %v", err)
+	}
+
+	var bodies []string
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		bodies = append(bodies, StreamToString(r.Body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodPut, "/", map[string]string{"name": "n"})
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+
+	expected := `{"name":"n"}` + "\n"
+	if len(bodies) != 2 || bodies[0] != expected || bodies[1] != expected {
+		t.Errorf("Request bodies = %q, expected two copies of %q", bodies, expected)
+	}
+}
+
+func TestDo_retryExhausted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := WithRetryPolicy(testRetryPolicy)(client); err != nil {
+		t.Fatalf("WithRetryPolicy(): %v", err)
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"unavailable"}`, http.StatusServiceUnavailable)
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+	resp, err := client.Do(context.Background(), req, nil)
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Fatalf("Expected *ErrorResponse, got %#v", err)
+	}
+
+	if expected := testRetryPolicy.MaxRetries + 1; resp.Attempts != expected {
+		t.Errorf("Response attempts = %d, expected %d", resp.Attempts, expected)
+	}
+}
+
+func TestDo_retrySkipsNonIdempotent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := WithRetryPolicy(testRetryPolicy)(client); err != nil {
+		t.Fatalf("WithRetryPolicy(): %v", err)
+	}
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodPost, "/", nil)
+	resp, _ := client.Do(context.Background(), req, nil)
+
+	if calls != 1 || resp.Attempts != 1 {
+		t.Errorf("POST was sent %d times (attempts %d), expected 1", calls, resp.Attempts)
+	}
+}
+
+func TestDo_retrySkipsClientError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := WithRetryPolicy(testRetryPolicy)(client); err != nil {
+		t.Fatalf("WithRetryPolicy(): %v", err)
+	}
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+	_, _ = client.Do(context.Background(), req, nil)
+
+	if calls != 1 {
+		t.Errorf("Server calls = %d, expected 1", calls)
+	}
+}
+
+func TestDo_retryAfterExceedsMaxBackoff(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := WithRetryPolicy(testRetryPolicy)(client); err != nil {
+		t.Fatalf("WithRetryPolicy(): %v", err)
+	}
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set(headerRetryAfter, "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+	_, _ = client.Do(context.Background(), req, nil)
+
+	if calls != 1 {
+		t.Errorf("Server calls = %d, expected 1", calls)
+	}
+}
+
+func TestDo_retryContextCancelled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	policy := RetryPolicy{MaxRetries: 5, MinBackoff: time.Hour, MaxBackoff: time.Hour}
+	if err := WithRetryPolicy(policy)(client); err != nil {
+		t.Fatalf("WithRetryPolicy(): %v", err)
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	cctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := client.NewRequest(cctx, http.MethodGet, "/", nil)
+	start := time.Now()
+	resp, err := client.Do(cctx, req, nil)
+	if err == nil {
+		t.Fatal("Expected error to be returned.")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Do() took %v after context was cancelled", elapsed)
+	}
+	if resp.Attempts != 1 {
+		t.Errorf("Response attempts = %d, expected 1", resp.Attempts)
+	}
+}
+
+func TestRetryPolicy_serverBackoff(t *testing.T) {
+	reset := time.Now().Add(2 * time.Second).Unix()
+	cases := []struct {
+		name   string
+		status int
+		header string
+		value  string
+		ok     bool
+	}{
+		{"retry-after seconds", http.StatusServiceUnavailable, headerRetryAfter, "1", true},
+		{"rate limit reset", http.StatusTooManyRequests, headerRateReset, fmt.Sprint(reset), true},
+		{"reset ignored on 503", http.StatusServiceUnavailable, headerRateReset, fmt.Sprint(reset), false},
+		{"no headers", http.StatusTooManyRequests, "", "", false},
+	}
+
+	for _, c := range cases {
+		header := http.Header{}
+		if c.header != "" {
+			header.Set(c.header, c.value)
+		}
+		wait, ok := serverBackoff(&http.Response{StatusCode: c.status, Header: header})
+		if ok != c.ok {
+			t.Errorf("%q ok = %v, expected %v", c.name, ok, c.ok)
+		}
+		if ok && (wait <= 0 || wait > 2*time.Second) {
+			t.Errorf("%q wait = %v, expected between 0 and 2s", c.name, wait)
+		}
+	}
+}
+
+func TestWithRetryPolicy_invalid(t *testing.T) {
+	policies := []RetryPolicy{
+		{MaxRetries: -1, MinBackoff: time.Second, MaxBackoff: time.Second},
+		{MaxRetries: 1, MinBackoff: 0, MaxBackoff: time.Second},
+		{MaxRetries: 1, MinBackoff: time.Second, MaxBackoff: time.Millisecond},
+	}
+
+	for _, p := range policies {
+		if _, err := New(nil, WithRetryPolicy(p)); err == nil {
+			t.Errorf("New(WithRetryPolicy(%+v)) expected error", p)
+		}
+	}
+}
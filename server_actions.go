@@ -54,60 +54,70 @@ var _ ServerActionsService = &ServerActionsServiceOp{}
 
 // Shutdown a Server
 func (s *ServerActionsServiceOp) Shutdown(ctx context.Context, id int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.Shutdown")
 	request := &ActionRequest{"type": "shutdown"}
 	return s.doAction(ctx, id, request)
 }
 
 // ShutdownByTag shuts down Servers matched by a Tag.
 func (s *ServerActionsServiceOp) ShutdownByTag(ctx context.Context, tag string) ([]Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.ShutdownByTag")
 	request := &ActionRequest{"type": "shutdown"}
 	return s.doActionByTag(ctx, tag, request)
 }
 
 // PowerOff a Server
 func (s *ServerActionsServiceOp) PowerOff(ctx context.Context, id int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.PowerOff")
 	request := &ActionRequest{"type": "power_off"}
 	return s.doAction(ctx, id, request)
 }
 
 // PowerOffByTag powers off Servers matched by a Tag.
 func (s *ServerActionsServiceOp) PowerOffByTag(ctx context.Context, tag string) ([]Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.PowerOffByTag")
 	request := &ActionRequest{"type": "power_off"}
 	return s.doActionByTag(ctx, tag, request)
 }
 
 // PowerOn a Server
 func (s *ServerActionsServiceOp) PowerOn(ctx context.Context, id int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.PowerOn")
 	request := &ActionRequest{"type": "power_on"}
 	return s.doAction(ctx, id, request)
 }
 
 // PowerOnByTag powers on Servers matched by a Tag.
 func (s *ServerActionsServiceOp) PowerOnByTag(ctx context.Context, tag string) ([]Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.PowerOnByTag")
 	request := &ActionRequest{"type": "power_on"}
 	return s.doActionByTag(ctx, tag, request)
 }
 
 // PowerCycle a Server
 func (s *ServerActionsServiceOp) PowerCycle(ctx context.Context, id int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.PowerCycle")
 	request := &ActionRequest{"type": "power_cycle"}
 	return s.doAction(ctx, id, request)
 }
 
 // PowerCycleByTag power cycles Servers matched by a Tag.
 func (s *ServerActionsServiceOp) PowerCycleByTag(ctx context.Context, tag string) ([]Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.PowerCycleByTag")
 	request := &ActionRequest{"type": "power_cycle"}
 	return s.doActionByTag(ctx, tag, request)
 }
 
 // Reboot a Server
 func (s *ServerActionsServiceOp) Reboot(ctx context.Context, id int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.Reboot")
 	request := &ActionRequest{"type": "reboot"}
 	return s.doAction(ctx, id, request)
 }
 
 // Restore an image to a Server
 func (s *ServerActionsServiceOp) Restore(ctx context.Context, id, imageID int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.Restore")
 	requestType := "restore"
 	request := &ActionRequest{
 		"type":  requestType,
@@ -118,6 +128,7 @@ func (s *ServerActionsServiceOp) Restore(ctx context.Context, id, imageID int) (
 
 // Resize a Server
 func (s *ServerActionsServiceOp) Resize(ctx context.Context, id int, sizeSlug string, resizeDisk bool) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.Resize")
 	requestType := "resize"
 	request := &ActionRequest{
 		"type": requestType,
@@ -129,6 +140,7 @@ func (s *ServerActionsServiceOp) Resize(ctx context.Context, id int, sizeSlug st
 
 // Rename a Server
 func (s *ServerActionsServiceOp) Rename(ctx context.Context, id int, name string) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.Rename")
 	requestType := "rename"
 	request := &ActionRequest{
 		"type": requestType,
@@ -139,6 +151,7 @@ func (s *ServerActionsServiceOp) Rename(ctx context.Context, id int, name string
 
 // Snapshot a Server.
 func (s *ServerActionsServiceOp) Snapshot(ctx context.Context, id int, name string) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.Snapshot")
 	requestType := "snapshot"
 	request := &ActionRequest{
 		"type": requestType,
@@ -149,6 +162,7 @@ func (s *ServerActionsServiceOp) Snapshot(ctx context.Context, id int, name stri
 
 // SnapshotByTag snapshots Servers matched by a Tag.
 func (s *ServerActionsServiceOp) SnapshotByTag(ctx context.Context, tag string, name string) ([]Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.SnapshotByTag")
 	requestType := "snapshot"
 	request := &ActionRequest{
 		"type": requestType,
@@ -159,72 +173,84 @@ func (s *ServerActionsServiceOp) SnapshotByTag(ctx context.Context, tag string,
 
 // EnableBackups enables backups for a Server.
 func (s *ServerActionsServiceOp) EnableBackups(ctx context.Context, id int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.EnableBackups")
 	request := &ActionRequest{"type": "enable_backups"}
 	return s.doAction(ctx, id, request)
 }
 
 // EnableBackupsByTag enables backups for Servers matched by a Tag.
 func (s *ServerActionsServiceOp) EnableBackupsByTag(ctx context.Context, tag string) ([]Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.EnableBackupsByTag")
 	request := &ActionRequest{"type": "enable_backups"}
 	return s.doActionByTag(ctx, tag, request)
 }
 
 // DisableBackups disables backups for a Server.
 func (s *ServerActionsServiceOp) DisableBackups(ctx context.Context, id int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.DisableBackups")
 	request := &ActionRequest{"type": "disable_backups"}
 	return s.doAction(ctx, id, request)
 }
 
 // DisableBackupsByTag disables backups for Server matched by a Tag.
 func (s *ServerActionsServiceOp) DisableBackupsByTag(ctx context.Context, tag string) ([]Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.DisableBackupsByTag")
 	request := &ActionRequest{"type": "disable_backups"}
 	return s.doActionByTag(ctx, tag, request)
 }
 
 // PasswordReset resets the password for a Server.
 func (s *ServerActionsServiceOp) PasswordReset(ctx context.Context, id int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.PasswordReset")
 	request := &ActionRequest{"type": "password_reset"}
 	return s.doAction(ctx, id, request)
 }
 
 // RebuildByImageID rebuilds a Server from an image with a given id.
 func (s *ServerActionsServiceOp) RebuildByImageID(ctx context.Context, id, imageID int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.RebuildByImageID")
 	request := &ActionRequest{"type": "rebuild", "image": imageID}
 	return s.doAction(ctx, id, request)
 }
 
 // RebuildByImageSlug rebuilds a Server from an Image matched by a given Slug.
 func (s *ServerActionsServiceOp) RebuildByImageSlug(ctx context.Context, id int, slug string) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.RebuildByImageSlug")
 	request := &ActionRequest{"type": "rebuild", "image": slug}
 	return s.doAction(ctx, id, request)
 }
 
 // ChangeKernel changes the kernel for a Server.
 func (s *ServerActionsServiceOp) ChangeKernel(ctx context.Context, id, kernelID int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.ChangeKernel")
 	request := &ActionRequest{"type": "change_kernel", "kernel": kernelID}
 	return s.doAction(ctx, id, request)
 }
 
 // EnableIPv6 enables IPv6 for a Server.
 func (s *ServerActionsServiceOp) EnableIPv6(ctx context.Context, id int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.EnableIPv6")
 	request := &ActionRequest{"type": "enable_ipv6"}
 	return s.doAction(ctx, id, request)
 }
 
 // EnableIPv6ByTag enables IPv6 for Servers matched by a Tag.
 func (s *ServerActionsServiceOp) EnableIPv6ByTag(ctx context.Context, tag string) ([]Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.EnableIPv6ByTag")
 	request := &ActionRequest{"type": "enable_ipv6"}
 	return s.doActionByTag(ctx, tag, request)
 }
 
 // EnablePrivateNetworking enables private networking for a Server.
 func (s *ServerActionsServiceOp) EnablePrivateNetworking(ctx context.Context, id int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.EnablePrivateNetworking")
 	request := &ActionRequest{"type": "enable_private_networking"}
 	return s.doAction(ctx, id, request)
 }
 
 // EnablePrivateNetworkingByTag enables private networking for Servers matched by a Tag.
 func (s *ServerActionsServiceOp) EnablePrivateNetworkingByTag(ctx context.Context, tag string) ([]Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.EnablePrivateNetworkingByTag")
 	request := &ActionRequest{"type": "enable_private_networking"}
 	return s.doActionByTag(ctx, tag, request)
 }
@@ -281,6 +307,7 @@ func (s *ServerActionsServiceOp) doActionByTag(ctx context.Context, tag string,
 
 // Get an action for a particular Server by id.
 func (s *ServerActionsServiceOp) Get(ctx context.Context, serverID, actionID int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.Get")
 	if serverID < 1 {
 		return nil, nil, NewArgError("serverID", "cannot be less than 1")
 	}
@@ -295,6 +322,7 @@ func (s *ServerActionsServiceOp) Get(ctx context.Context, serverID, actionID int
 
 // GetByURI gets an action for a particular Server by id.
 func (s *ServerActionsServiceOp) GetByURI(ctx context.Context, rawurl string) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "ServerActions.GetByURI")
 	u, err := url.Parse(rawurl)
 	if err != nil {
 		return nil, nil, err
@@ -17,6 +17,7 @@ var errNoNetworks = errors.New("no networks have been defined")
 // See: https://api.binarylane.com.au/reference#servers
 type ServersService interface {
 	List(context.Context, *ListOptions) ([]Server, *Response, error)
+	ListAll(context.Context) ([]Server, error)
 	ListByTag(context.Context, string, *ListOptions) ([]Server, *Response, error)
 	Get(context.Context, int) (*Server, *Response, error)
 	Create(context.Context, *ServerCreateRequest) (*Server, *Response, error)
@@ -308,6 +309,7 @@ func (s *ServersServiceOp) list(ctx context.Context, path string) ([]Server, *Re
 
 // List all Servers.
 func (s *ServersServiceOp) List(ctx context.Context, opt *ListOptions) ([]Server, *Response, error) {
+	ctx = withOperation(ctx, "Servers.List")
 	path := serverBasePath
 	path, err := addOptions(path, opt)
 	if err != nil {
@@ -317,8 +319,24 @@ func (s *ServersServiceOp) List(ctx context.Context, opt *ListOptions) ([]Server
 	return s.list(ctx, path)
 }
 
+// ListAll lists all servers, fetching every page of results.
+func (s *ServersServiceOp) ListAll(ctx context.Context) ([]Server, error) {
+	var list []Server
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := s.List(ctx, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
 // ListByTag lists all Servers matched by a Tag.
 func (s *ServersServiceOp) ListByTag(ctx context.Context, tag string, opt *ListOptions) ([]Server, *Response, error) {
+	ctx = withOperation(ctx, "Servers.ListByTag")
 	path := fmt.Sprintf("%s?tag_name=%s", serverBasePath, tag)
 	path, err := addOptions(path, opt)
 	if err != nil {
@@ -330,6 +348,7 @@ func (s *ServersServiceOp) ListByTag(ctx context.Context, tag string, opt *ListO
 
 // Get individual Server.
 func (s *ServersServiceOp) Get(ctx context.Context, serverID int) (*Server, *Response, error) {
+	ctx = withOperation(ctx, "Servers.Get")
 	if serverID < 1 {
 		return nil, nil, NewArgError("serverID", "cannot be less than 1")
 	}
@@ -352,6 +371,7 @@ func (s *ServersServiceOp) Get(ctx context.Context, serverID int) (*Server, *Res
 
 // Create Server
 func (s *ServersServiceOp) Create(ctx context.Context, createRequest *ServerCreateRequest) (*Server, *Response, error) {
+	ctx = withOperation(ctx, "Servers.Create")
 	if createRequest == nil {
 		return nil, nil, NewArgError("createRequest", "cannot be nil")
 	}
@@ -377,6 +397,7 @@ func (s *ServersServiceOp) Create(ctx context.Context, createRequest *ServerCrea
 
 // CreateMultiple creates multiple Servers.
 func (s *ServersServiceOp) CreateMultiple(ctx context.Context, createRequest *ServerMultiCreateRequest) ([]Server, *Response, error) {
+	ctx = withOperation(ctx, "Servers.CreateMultiple")
 	if createRequest == nil {
 		return nil, nil, NewArgError("createRequest", "cannot be nil")
 	}
@@ -414,6 +435,7 @@ func (s *ServersServiceOp) delete(ctx context.Context, path string) (*Response,
 
 // Delete Server.
 func (s *ServersServiceOp) Delete(ctx context.Context, serverID int) (*Response, error) {
+	ctx = withOperation(ctx, "Servers.Delete")
 	if serverID < 1 {
 		return nil, NewArgError("serverID", "cannot be less than 1")
 	}
@@ -425,6 +447,7 @@ func (s *ServersServiceOp) Delete(ctx context.Context, serverID int) (*Response,
 
 // DeleteByTag deletes Servers matched by a Tag.
 func (s *ServersServiceOp) DeleteByTag(ctx context.Context, tag string) (*Response, error) {
+	ctx = withOperation(ctx, "Servers.DeleteByTag")
 	if tag == "" {
 		return nil, NewArgError("tag", "cannot be empty")
 	}
@@ -436,6 +459,7 @@ func (s *ServersServiceOp) DeleteByTag(ctx context.Context, tag string) (*Respon
 
 // Kernels lists kernels available for a Server.
 func (s *ServersServiceOp) Kernels(ctx context.Context, serverID int, opt *ListOptions) ([]Kernel, *Response, error) {
+	ctx = withOperation(ctx, "Servers.Kernels")
 	if serverID < 1 {
 		return nil, nil, NewArgError("serverID", "cannot be less than 1")
 	}
@@ -465,6 +489,7 @@ func (s *ServersServiceOp) Kernels(ctx context.Context, serverID int, opt *ListO
 
 // Actions lists the actions for a Server.
 func (s *ServersServiceOp) Actions(ctx context.Context, serverID int, opt *ListOptions) ([]Action, *Response, error) {
+	ctx = withOperation(ctx, "Servers.Actions")
 	if serverID < 1 {
 		return nil, nil, NewArgError("serverID", "cannot be less than 1")
 	}
@@ -497,6 +522,7 @@ func (s *ServersServiceOp) Actions(ctx context.Context, serverID int, opt *ListO
 
 // Backups lists the backups for a Server.
 func (s *ServersServiceOp) Backups(ctx context.Context, serverID int, opt *ListOptions) ([]Image, *Response, error) {
+	ctx = withOperation(ctx, "Servers.Backups")
 	if serverID < 1 {
 		return nil, nil, NewArgError("serverID", "cannot be less than 1")
 	}
@@ -529,6 +555,7 @@ func (s *ServersServiceOp) Backups(ctx context.Context, serverID int, opt *ListO
 
 // Snapshots lists the snapshots available for a Server.
 func (s *ServersServiceOp) Snapshots(ctx context.Context, serverID int, opt *ListOptions) ([]Image, *Response, error) {
+	ctx = withOperation(ctx, "Servers.Snapshots")
 	if serverID < 1 {
 		return nil, nil, NewArgError("serverID", "cannot be less than 1")
 	}
@@ -561,6 +588,7 @@ func (s *ServersServiceOp) Snapshots(ctx context.Context, serverID int, opt *Lis
 
 // Neighbors lists the neighbors for a Server.
 func (s *ServersServiceOp) Neighbors(ctx context.Context, serverID int) ([]Server, *Response, error) {
+	ctx = withOperation(ctx, "Servers.Neighbors")
 	if serverID < 1 {
 		return nil, nil, NewArgError("serverID", "cannot be less than 1")
 	}
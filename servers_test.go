@@ -84,6 +84,39 @@ func TestServers_ListServersByTag(t *testing.T) {
 	}
 }
 
+func TestServers_ListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/servers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		dr := serversRoot{Servers: []Server{{ID: 1}, {ID: 2}}}
+		if r.URL.Query().Get("page") == "2" {
+			dr.Servers = []Server{{ID: 3}}
+		} else {
+			dr.Links = &Links{Pages: &Pages{Next: "http://example.com/v2/servers/?page=2"}}
+		}
+
+		b, err := json.Marshal(dr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		fmt.Fprint(w, string(b))
+	})
+
+	servers, err := client.Servers.ListAll(ctx)
+	if err != nil {
+		t.Errorf("Servers.ListAll returned error: %v", err)
+	}
+
+	expected := []Server{{ID: 1}, {ID: 2}, {ID: 3}}
+	if !reflect.DeepEqual(servers, expected) {
+		t.Errorf("Servers.ListAll returned servers %+v, expected %+v", servers, expected)
+	}
+}
+
 func TestServers_ListServersMultiplePages(t *testing.T) {
 	setup()
 	defer teardown()
@@ -10,6 +10,7 @@ import (
 // See: https://api.binarylane.com.au/reference#sizes
 type SizesService interface {
 	List(context.Context, *ListOptions) ([]Size, *Response, error)
+	ListAll(context.Context) ([]Size, error)
 }
 
 // SizesServiceOp handles communication with the size related methods of the
@@ -45,6 +46,7 @@ type sizesRoot struct {
 
 // List all images
 func (s *SizesServiceOp) List(ctx context.Context, opt *ListOptions) ([]Size, *Response, error) {
+	ctx = withOperation(ctx, "Sizes.List")
 	path := "v2/sizes"
 	path, err := addOptions(path, opt)
 	if err != nil {
@@ -70,3 +72,18 @@ func (s *SizesServiceOp) List(ctx context.Context, opt *ListOptions) ([]Size, *R
 
 	return root.Sizes, resp, err
 }
+
+// ListAll lists all sizes, fetching every page of results.
+func (s *SizesServiceOp) ListAll(ctx context.Context) ([]Size, error) {
+	var list []Size
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := s.List(ctx, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -13,6 +13,7 @@ const snapshotBasePath = "v2/snapshots"
 // See: https://api.binarylane.com.au/reference#snapshots
 type SnapshotsService interface {
 	List(context.Context, *ListOptions) ([]Snapshot, *Response, error)
+	ListAll(context.Context) ([]Snapshot, error)
 	ListVolume(context.Context, *ListOptions) ([]Snapshot, *Response, error)
 	ListServer(context.Context, *ListOptions) ([]Snapshot, *Response, error)
 	Get(context.Context, string) (*Snapshot, *Response, error)
@@ -60,28 +61,48 @@ func (s Snapshot) String() string {
 
 // List lists all the snapshots available.
 func (s *SnapshotsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Snapshot, *Response, error) {
+	ctx = withOperation(ctx, "Snapshots.List")
 	return s.list(ctx, opt, nil)
 }
 
+// ListAll lists all snapshots, fetching every page of results.
+func (s *SnapshotsServiceOp) ListAll(ctx context.Context) ([]Snapshot, error) {
+	var list []Snapshot
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := s.List(ctx, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
 // ListServer lists all the Server snapshots.
 func (s *SnapshotsServiceOp) ListServer(ctx context.Context, opt *ListOptions) ([]Snapshot, *Response, error) {
+	ctx = withOperation(ctx, "Snapshots.ListServer")
 	listOpt := listSnapshotOptions{ResourceType: "server"}
 	return s.list(ctx, opt, &listOpt)
 }
 
 // ListVolume lists all the volume snapshots.
 func (s *SnapshotsServiceOp) ListVolume(ctx context.Context, opt *ListOptions) ([]Snapshot, *Response, error) {
+	ctx = withOperation(ctx, "Snapshots.ListVolume")
 	listOpt := listSnapshotOptions{ResourceType: "volume"}
 	return s.list(ctx, opt, &listOpt)
 }
 
 // Get retrieves an snapshot by id.
 func (s *SnapshotsServiceOp) Get(ctx context.Context, snapshotID string) (*Snapshot, *Response, error) {
+	ctx = withOperation(ctx, "Snapshots.Get")
 	return s.get(ctx, snapshotID)
 }
 
 // Delete an snapshot.
 func (s *SnapshotsServiceOp) Delete(ctx context.Context, snapshotID string) (*Response, error) {
+	ctx = withOperation(ctx, "Snapshots.Delete")
 	path := fmt.Sprintf("%s/%s", snapshotBasePath, snapshotID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
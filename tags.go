@@ -13,6 +13,7 @@ const tagsBasePath = "v2/tags"
 // See: https://api.binarylane.com.au/reference#tags
 type TagsService interface {
 	List(context.Context, *ListOptions) ([]Tag, *Response, error)
+	ListAll(context.Context) ([]Tag, error)
 	Get(context.Context, string) (*Tag, *Response, error)
 	Create(context.Context, *TagCreateRequest) (*Tag, *Response, error)
 	Delete(context.Context, string) (*Response, error)
@@ -122,6 +123,7 @@ type tagRoot struct {
 
 // List all tags
 func (s *TagsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Tag, *Response, error) {
+	ctx = withOperation(ctx, "Tags.List")
 	path := tagsBasePath
 	path, err := addOptions(path, opt)
 
@@ -149,8 +151,24 @@ func (s *TagsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Tag, *Res
 	return root.Tags, resp, err
 }
 
+// ListAll lists all tags, fetching every page of results.
+func (s *TagsServiceOp) ListAll(ctx context.Context) ([]Tag, error) {
+	var list []Tag
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := s.List(ctx, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
 // Get a single tag
 func (s *TagsServiceOp) Get(ctx context.Context, name string) (*Tag, *Response, error) {
+	ctx = withOperation(ctx, "Tags.Get")
 	path := fmt.Sprintf("%s/%s", tagsBasePath, name)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -169,6 +187,7 @@ func (s *TagsServiceOp) Get(ctx context.Context, name string) (*Tag, *Response,
 
 // Create a new tag
 func (s *TagsServiceOp) Create(ctx context.Context, createRequest *TagCreateRequest) (*Tag, *Response, error) {
+	ctx = withOperation(ctx, "Tags.Create")
 	if createRequest == nil {
 		return nil, nil, NewArgError("createRequest", "cannot be nil")
 	}
@@ -189,6 +208,7 @@ func (s *TagsServiceOp) Create(ctx context.Context, createRequest *TagCreateRequ
 
 // Delete an existing tag
 func (s *TagsServiceOp) Delete(ctx context.Context, name string) (*Response, error) {
+	ctx = withOperation(ctx, "Tags.Delete")
 	if name == "" {
 		return nil, NewArgError("name", "cannot be empty")
 	}
@@ -206,6 +226,7 @@ func (s *TagsServiceOp) Delete(ctx context.Context, name string) (*Response, err
 
 // TagResources associates resources with a given Tag.
 func (s *TagsServiceOp) TagResources(ctx context.Context, name string, tagRequest *TagResourcesRequest) (*Response, error) {
+	ctx = withOperation(ctx, "Tags.TagResources")
 	if name == "" {
 		return nil, NewArgError("name", "cannot be empty")
 	}
@@ -227,6 +248,7 @@ func (s *TagsServiceOp) TagResources(ctx context.Context, name string, tagReques
 
 // UntagResources dissociates resources with a given Tag.
 func (s *TagsServiceOp) UntagResources(ctx context.Context, name string, untagRequest *UntagResourcesRequest) (*Response, error) {
+	ctx = withOperation(ctx, "Tags.UntagResources")
 	if name == "" {
 		return nil, NewArgError("name", "cannot be empty")
 	}
@@ -0,0 +1,228 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+const defaultBatchParallelism = 4
+
+// ServerActionFunc starts an action on a server. Methods of
+// ServerActionsService such as PowerOff and Reboot can be used directly, and
+// SnapshotAction, ResizeAction and RebuildAction build functions for actions
+// that take arguments.
+type ServerActionFunc func(ctx context.Context, serverID int) (*binarylane.Action, *binarylane.Response, error)
+
+// SnapshotAction returns a ServerActionFunc that snapshots a server.
+func SnapshotAction(actions binarylane.ServerActionsService, name string) ServerActionFunc {
+	return func(ctx context.Context, serverID int) (*binarylane.Action, *binarylane.Response, error) {
+		return actions.Snapshot(ctx, serverID, name)
+	}
+}
+
+// ResizeAction returns a ServerActionFunc that resizes a server.
+func ResizeAction(actions binarylane.ServerActionsService, sizeSlug string, resizeDisk bool) ServerActionFunc {
+	return func(ctx context.Context, serverID int) (*binarylane.Action, *binarylane.Response, error) {
+		return actions.Resize(ctx, serverID, sizeSlug, resizeDisk)
+	}
+}
+
+// RebuildAction returns a ServerActionFunc that rebuilds a server from an
+// image.
+func RebuildAction(actions binarylane.ServerActionsService, imageSlug string) ServerActionFunc {
+	return func(ctx context.Context, serverID int) (*binarylane.Action, *binarylane.Response, error) {
+		return actions.RebuildByImageSlug(ctx, serverID, imageSlug)
+	}
+}
+
+// BatchResult is the outcome of an action on one server.
+type BatchResult struct {
+	ServerID int
+
+	// Action is the action started on the server, in its final state if
+	// the Batch waited for it.
+	Action *binarylane.Action
+
+	// Err is the error starting or waiting for the action.
+	Err error
+
+	// Skipped is true if the action was not started because an earlier one
+	// failed with StopOnError set, or the context was done.
+	Skipped bool
+}
+
+// BatchReport holds the result for every server in a batch, in the order the
+// servers were given.
+type BatchReport struct {
+	Results []BatchResult
+}
+
+// Succeeded returns the results of the actions that completed.
+func (r *BatchReport) Succeeded() []BatchResult {
+	return r.filter(func(res BatchResult) bool { return !res.Skipped && res.Err == nil })
+}
+
+// Failed returns the results of the actions that failed.
+func (r *BatchReport) Failed() []BatchResult {
+	return r.filter(func(res BatchResult) bool { return res.Err != nil && !res.Skipped })
+}
+
+// Skipped returns the results for the servers the batch did not act on.
+func (r *BatchReport) Skipped() []BatchResult {
+	return r.filter(func(res BatchResult) bool { return res.Skipped })
+}
+
+func (r *BatchReport) filter(keep func(BatchResult) bool) []BatchResult {
+	var out []BatchResult
+	for _, res := range r.Results {
+		if keep(res) {
+			out = append(out, res)
+		}
+	}
+	return out
+}
+
+// BatchError is returned by Batch when the action failed or was skipped for
+// one or more servers.
+type BatchError struct {
+	Report *BatchReport
+}
+
+func (e *BatchError) Error() string {
+	failed := e.Report.Failed()
+	msgs := make([]string, len(failed))
+	for i, res := range failed {
+		msgs[i] = fmt.Sprintf("server %d: %v", res.ServerID, res.Err)
+	}
+
+	msg := fmt.Sprintf("%d of %d server actions failed", len(failed), len(e.Report.Results))
+	if skipped := len(e.Report.Skipped()); skipped > 0 {
+		msg += fmt.Sprintf(", %d skipped", skipped)
+	}
+	if len(msgs) > 0 {
+		msg += ": " + strings.Join(msgs, "; ")
+	}
+	return msg
+}
+
+// Batch applies a server action to many servers with bounded concurrency.
+type Batch struct {
+	client *binarylane.Client
+
+	// Parallelism is the number of servers acted on at once. A value of 1
+	// acts on one server at a time, as for a rolling reboot. Defaults to 4.
+	Parallelism int
+
+	// Wait waits for each action to complete before starting the next, so
+	// that at most Parallelism actions are in progress at once.
+	Wait bool
+
+	// Waiter waits for actions when Wait is set.
+	Waiter *Waiter
+
+	// StopOnError stops starting new actions once one has failed. Actions
+	// already started are allowed to finish. By default every server is
+	// acted on whatever the outcome for the others.
+	StopOnError bool
+}
+
+// NewBatch returns a Batch using client with default settings.
+func NewBatch(client *binarylane.Client) *Batch {
+	return &Batch{
+		client:      client,
+		Parallelism: defaultBatchParallelism,
+		Waiter:      NewWaiter(client),
+	}
+}
+
+// Run applies action to each of serverIDs, returning a report with the
+// result for every server. If any action failed or was skipped, the error is
+// a *BatchError holding the same report.
+func (b *Batch) Run(ctx context.Context, serverIDs []int, action ServerActionFunc) (*BatchReport, error) {
+	report := &BatchReport{Results: make([]BatchResult, len(serverIDs))}
+	for i, id := range serverIDs {
+		report.Results[i].ServerID = id
+	}
+
+	parallelism := b.Parallelism
+	if parallelism <= 0 {
+		parallelism = defaultBatchParallelism
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		stopped bool
+		next    = make(chan int)
+	)
+
+	for w := 0; w < parallelism && w < len(serverIDs); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				res := &report.Results[i]
+
+				mu.Lock()
+				skip := stopped
+				mu.Unlock()
+				if skip || ctx.Err() != nil {
+					res.Skipped, res.Err = true, ctx.Err()
+					continue
+				}
+
+				res.Action, res.Err = b.runOne(ctx, res.ServerID, action)
+				if res.Err != nil && b.StopOnError {
+					mu.Lock()
+					stopped = true
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for i := range serverIDs {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+
+	if len(report.Failed()) > 0 || len(report.Skipped()) > 0 {
+		return report, &BatchError{Report: report}
+	}
+	return report, nil
+}
+
+// RunSelected applies action to every server for which selector returns
+// true, as described by Run.
+func (b *Batch) RunSelected(ctx context.Context, selector func(binarylane.Server) bool, action ServerActionFunc) (*BatchReport, error) {
+	servers, err := b.client.Servers.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int
+	for _, s := range servers {
+		if selector(s) {
+			ids = append(ids, s.ID)
+		}
+	}
+	return b.Run(ctx, ids, action)
+}
+
+func (b *Batch) runOne(ctx context.Context, serverID int, action ServerActionFunc) (*binarylane.Action, error) {
+	a, _, err := action(ctx, serverID)
+	if err != nil || !b.Wait {
+		return a, err
+	}
+
+	waiter := b.Waiter
+	if waiter == nil {
+		waiter = NewWaiter(b.client)
+	}
+	return waiter.Wait(ctx, a)
+}
@@ -0,0 +1,165 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+	"github.com/binarylane/go-binarylane/binarylanetest"
+)
+
+func newTestBatch(t *testing.T) (*Batch, *binarylanetest.Server, *binarylane.Client) {
+	fake, client := setup(t)
+	b := NewBatch(client)
+	b.Waiter.Interval = time.Millisecond
+	return b, fake, client
+}
+
+func createServers(t *testing.T, client *binarylane.Client, n int, tags ...string) []int {
+	var ids []int
+	for i := 0; i < n; i++ {
+		server, _, err := client.Servers.Create(context.Background(), &binarylane.ServerCreateRequest{
+			Name:   "web",
+			Region: "syd",
+			Size:   "std-min",
+			Image:  binarylane.ServerCreateImage{Slug: "ubuntu-20.04"},
+			Tags:   tags,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, server.ID)
+	}
+	return ids
+}
+
+func TestBatch_Run(t *testing.T) {
+	ctx := context.Background()
+	b, _, client := newTestBatch(t)
+	ids := createServers(t, client, 5)
+	b.Parallelism = 2
+	b.Wait = true
+
+	report, err := b.Run(ctx, ids, client.ServerActions.PowerOff)
+	if err != nil {
+		t.Fatalf("Batch.Run returned error: %v", err)
+	}
+	if len(report.Succeeded()) != len(ids) {
+		t.Errorf("Batch.Run report = %+v", report)
+	}
+	for i, res := range report.Results {
+		if res.ServerID != ids[i] || res.Action == nil || res.Action.Status != binarylane.ActionCompleted {
+			t.Errorf("Result %d = %+v", i, res)
+		}
+	}
+
+	servers, err := client.Servers.ListAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range servers {
+		if s.Status != "off" {
+			t.Errorf("Server %d status = %q, expected off", s.ID, s.Status)
+		}
+	}
+}
+
+func TestBatch_RunBestEffort(t *testing.T) {
+	b, _, client := newTestBatch(t)
+	ids := createServers(t, client, 2)
+	ids = []int{ids[0], 999, ids[1]}
+
+	report, err := b.Run(context.Background(), ids, client.ServerActions.Reboot)
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) || batchErr.Report != report {
+		t.Fatalf("Batch.Run returned %v, expected a *BatchError", err)
+	}
+
+	failed := report.Failed()
+	if len(failed) != 1 || failed[0].ServerID != 999 || !errors.Is(failed[0].Err, binarylane.ErrNotFound) {
+		t.Errorf("Batch.Run failed results = %+v", failed)
+	}
+	if len(report.Succeeded()) != 2 {
+		t.Errorf("Batch.Run succeeded results = %+v", report.Succeeded())
+	}
+}
+
+func TestBatch_RunStopOnError(t *testing.T) {
+	b, fake, client := newTestBatch(t)
+	ids := createServers(t, client, 4)
+	fake.FailActions("reboot")
+	b.Parallelism = 1
+	b.Wait = true
+	b.StopOnError = true
+
+	report, err := b.Run(context.Background(), ids, client.ServerActions.Reboot)
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+
+	var failedErr *ActionFailedError
+	failed := report.Failed()
+	if len(failed) != 1 || failed[0].ServerID != ids[0] || !errors.As(failed[0].Err, &failedErr) {
+		t.Errorf("Batch.Run failed results = %+v", failed)
+	}
+	if len(report.Skipped()) != 3 {
+		t.Errorf("Batch.Run skipped results = %+v, expected 3", report.Skipped())
+	}
+}
+
+func TestBatch_RunParallelism(t *testing.T) {
+	b, _, _ := newTestBatch(t)
+	b.Parallelism = 3
+
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	action := func(ctx context.Context, id int) (*binarylane.Action, *binarylane.Response, error) {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return &binarylane.Action{ResourceID: id}, nil, nil
+	}
+
+	ids := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	if _, err := b.Run(context.Background(), ids, action); err != nil {
+		t.Fatalf("Batch.Run returned error: %v", err)
+	}
+	if maxRunning != 3 {
+		t.Errorf("Batch.Run ran %d actions at once, expected 3", maxRunning)
+	}
+}
+
+func TestBatch_RunSelected(t *testing.T) {
+	b, _, client := newTestBatch(t)
+	createServers(t, client, 2)
+	tagged := createServers(t, client, 2, "frontend")
+
+	hasTag := func(s binarylane.Server) bool {
+		for _, tag := range s.Tags {
+			if tag == "frontend" {
+				return true
+			}
+		}
+		return false
+	}
+
+	report, err := b.RunSelected(context.Background(), hasTag, SnapshotAction(client.ServerActions, "nightly"))
+	if err != nil {
+		t.Fatalf("Batch.RunSelected returned error: %v", err)
+	}
+	if len(report.Results) != 2 || report.Results[0].ServerID != tagged[0] || report.Results[1].ServerID != tagged[1] {
+		t.Errorf("Batch.RunSelected report = %+v, expected servers %v", report, tagged)
+	}
+}
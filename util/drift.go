@@ -0,0 +1,273 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ChangeType describes how a resource differs between two inventories.
+type ChangeType string
+
+// Ways a resource can differ between two inventories.
+const (
+	ResourceAdded   ChangeType = "added"
+	ResourceRemoved ChangeType = "removed"
+	ResourceChanged ChangeType = "changed"
+)
+
+// DefaultDiffIgnore lists the fields that DiffInventories never compares,
+// as a resource kind and a JSON field path. They are derived from other
+// resources, so a change to them is reported elsewhere in the diff.
+var DefaultDiffIgnore = []string{
+	"domain.zone_file",
+	"floatingip.server",
+	"tag.resources",
+}
+
+// InventoryDiff is the difference between two inventories, as returned by
+// DiffInventories.
+type InventoryDiff struct {
+	// Resources that were added, removed or changed, in order of URN.
+	Resources []ResourceDiff `json:"resources"`
+
+	// LinksAdded and LinksRemoved are the relations between resources that
+	// were added or removed.
+	LinksAdded   []InventoryLink `json:"links_added"`
+	LinksRemoved []InventoryLink `json:"links_removed"`
+}
+
+// ResourceDiff is the difference in one resource between two inventories.
+type ResourceDiff struct {
+	URN  string     `json:"urn"`
+	Type ChangeType `json:"type"`
+
+	// Fields that changed, in order of path. Only set if Type is
+	// ResourceChanged.
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+// FieldChange is a change to one field of a resource. Path is the JSON path
+// of the field, such as "size_slug" or "health_check.port". Old and New are
+// the values decoded from JSON, and are nil if the field was absent.
+type FieldChange struct {
+	Path string      `json:"path"`
+	Old  interface{} `json:"old"`
+	New  interface{} `json:"new"`
+}
+
+// Empty reports whether the inventories were the same.
+func (d *InventoryDiff) Empty() bool {
+	return len(d.Resources) == 0 && len(d.LinksAdded) == 0 && len(d.LinksRemoved) == 0
+}
+
+// String formats the diff with one line for each resource and field, in the
+// style of a unified diff.
+func (d *InventoryDiff) String() string {
+	var b strings.Builder
+	for _, r := range d.Resources {
+		switch r.Type {
+		case ResourceAdded:
+			fmt.Fprintf(&b, "+ %s\n", r.URN)
+		case ResourceRemoved:
+			fmt.Fprintf(&b, "- %s\n", r.URN)
+		default:
+			fmt.Fprintf(&b, "~ %s\n", r.URN)
+			for _, f := range r.Fields {
+				fmt.Fprintf(&b, "    %s: %s -> %s\n", f.Path, diffValue(f.Old), diffValue(f.New))
+			}
+		}
+	}
+	for _, l := range d.LinksAdded {
+		fmt.Fprintf(&b, "+ %s %s %s\n", l.From, l.Relation, l.To)
+	}
+	for _, l := range d.LinksRemoved {
+		fmt.Fprintf(&b, "- %s %s %s\n", l.From, l.Relation, l.To)
+	}
+	return b.String()
+}
+
+func diffValue(v interface{}) string {
+	if v == nil {
+		return "<none>"
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+// DiffInventories compares two inventories of the same account, such as
+// ones taken an hour apart, and reports the resources that were added,
+// removed or changed, keyed by URN. Changed resources list each field that
+// differs.
+//
+// Fields in DefaultDiffIgnore and ignore are not compared. Each is given as
+// the resource kind from its URN and a JSON field path, such as
+// "server.status" or "loadbalancer.health_check"; a kind of "*" matches
+// every resource.
+func DiffInventories(from, to *Inventory, ignore ...string) *InventoryDiff {
+	ignored := append(append([]string(nil), DefaultDiffIgnore...), ignore...)
+	before, after := inventoryResources(from), inventoryResources(to)
+	diff := &InventoryDiff{}
+
+	for urn, a := range after {
+		b, ok := before[urn]
+		if !ok {
+			diff.Resources = append(diff.Resources, ResourceDiff{URN: urn, Type: ResourceAdded})
+			continue
+		}
+		var fields []FieldChange
+		diffFields(&fields, "", b, a, urnKind(urn), ignored)
+		if len(fields) > 0 {
+			sort.Slice(fields, func(i, j int) bool { return fields[i].Path < fields[j].Path })
+			diff.Resources = append(diff.Resources, ResourceDiff{URN: urn, Type: ResourceChanged, Fields: fields})
+		}
+	}
+	for urn := range before {
+		if _, ok := after[urn]; !ok {
+			diff.Resources = append(diff.Resources, ResourceDiff{URN: urn, Type: ResourceRemoved})
+		}
+	}
+	sort.Slice(diff.Resources, func(i, j int) bool { return diff.Resources[i].URN < diff.Resources[j].URN })
+
+	fromLinks := make(map[InventoryLink]bool, len(from.Links))
+	for _, l := range from.Links {
+		fromLinks[l] = true
+	}
+	toLinks := make(map[InventoryLink]bool, len(to.Links))
+	for _, l := range to.Links {
+		toLinks[l] = true
+		if !fromLinks[l] {
+			diff.LinksAdded = append(diff.LinksAdded, l)
+		}
+	}
+	for _, l := range from.Links {
+		if !toLinks[l] {
+			diff.LinksRemoved = append(diff.LinksRemoved, l)
+		}
+	}
+	return diff
+}
+
+// inventoryResources returns every resource in inv by URN, decoded from
+// JSON into maps so that they can be compared field by field.
+func inventoryResources(inv *Inventory) map[string]interface{} {
+	resources := make(map[string]interface{})
+	add := func(urn string, v interface{}) {
+		resources[urn] = jsonFields(v)
+	}
+
+	for _, s := range inv.Servers {
+		add(s.URN(), s)
+	}
+	for _, image := range inv.Images {
+		add(ImageURN(image), image)
+	}
+	for _, s := range inv.Snapshots {
+		add(SnapshotURN(s), s)
+	}
+	for _, key := range inv.Keys {
+		add(KeyURN(key), key)
+	}
+	for _, d := range inv.Domains {
+		add(d.Domain.URN(), d.Domain)
+		for _, r := range d.Records {
+			add(DomainRecordURN(d.Domain.Name, r), r)
+		}
+	}
+	for _, fip := range inv.FloatingIPs {
+		add(fip.URN(), fip)
+	}
+	for _, fw := range inv.Firewalls {
+		add(fw.URN(), fw)
+	}
+	for _, lb := range inv.LoadBalancers {
+		add(lb.URN(), lb)
+	}
+	for _, vpc := range inv.VPCs {
+		add(vpc.URN(), vpc)
+	}
+	for _, p := range inv.Projects {
+		add(ProjectURN(p.Project), p.Project)
+	}
+	for _, tag := range inv.Tags {
+		add(TagURN(tag), tag)
+	}
+	return resources
+}
+
+// jsonFields converts v to the generic form encoding/json decodes it into.
+func jsonFields(v interface{}) interface{} {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var fields interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+	return fields
+}
+
+// diffFields appends the differences between before and after to changes.
+// Objects are compared field by field; any other values, including arrays,
+// are compared as a whole.
+func diffFields(changes *[]FieldChange, path string, before, after interface{}, kind string, ignore []string) {
+	if path != "" && ignoredPath(kind, path, ignore) {
+		return
+	}
+
+	beforeMap, beforeIsMap := before.(map[string]interface{})
+	afterMap, afterIsMap := after.(map[string]interface{})
+	if !beforeIsMap || !afterIsMap {
+		if !reflect.DeepEqual(before, after) {
+			*changes = append(*changes, FieldChange{Path: path, Old: before, New: after})
+		}
+		return
+	}
+
+	for name, v := range afterMap {
+		diffFields(changes, joinPath(path, name), beforeMap[name], v, kind, ignore)
+	}
+	for name, v := range beforeMap {
+		if _, ok := afterMap[name]; !ok {
+			diffFields(changes, joinPath(path, name), v, nil, kind, ignore)
+		}
+	}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// ignoredPath reports whether the field at path of a resource of the given
+// kind matches one of the ignore patterns, or is inside a field that does.
+func ignoredPath(kind, path string, ignore []string) bool {
+	for _, pattern := range ignore {
+		parts := strings.SplitN(pattern, ".", 2)
+		if len(parts) != 2 || (parts[0] != "*" && parts[0] != kind) {
+			continue
+		}
+		if path == parts[1] || strings.HasPrefix(path, parts[1]+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// urnKind returns the resource kind of a URN, such as "server" for
+// "bl:server:1234".
+func urnKind(urn string) string {
+	parts := strings.SplitN(urn, ":", 3)
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
@@ -0,0 +1,115 @@
+package util
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+func driftInventory() *Inventory {
+	return &Inventory{
+		Version: InventoryVersion,
+		Servers: []binarylane.Server{
+			{ID: 1, Name: "web", SizeSlug: "std-min", Status: "active", Tags: []string{"web"}},
+			{ID: 2, Name: "db", SizeSlug: "std-1vcpu", Status: "active"},
+		},
+		Domains: []InventoryDomain{{
+			Domain:  binarylane.Domain{Name: "example.com", ZoneFile: "www IN A 192.0.2.1"},
+			Records: []binarylane.DomainRecord{{ID: 10, Type: "A", Name: "www", Data: "192.0.2.1"}},
+		}},
+		LoadBalancers: []binarylane.LoadBalancer{{
+			ID:          5,
+			Name:        "web",
+			HealthCheck: &binarylane.HealthCheck{Protocol: "http", Port: 80, Path: "/"},
+			ServerIDs:   []int{1},
+		}},
+		Tags: []binarylane.Tag{{Name: "web", Resources: &binarylane.TaggedResources{Count: 1}}},
+		Links: []InventoryLink{
+			{From: "bl:loadbalancer:5", Relation: RelationBalances, To: "bl:server:1"},
+		},
+	}
+}
+
+func TestDiffInventories(t *testing.T) {
+	before := driftInventory()
+	after := driftInventory()
+
+	after.Servers = []binarylane.Server{
+		{ID: 1, Name: "web", SizeSlug: "std-2vcpu", Status: "active", Tags: []string{"web", "canary"}},
+		{ID: 3, Name: "cache", SizeSlug: "std-min", Status: "new"},
+	}
+	after.Domains[0].Domain.ZoneFile = "www IN A 192.0.2.2"
+	after.Domains[0].Records[0].Data = "192.0.2.2"
+	after.LoadBalancers[0].HealthCheck.Path = "/health"
+	after.LoadBalancers[0].ServerIDs = []int{1, 3}
+	after.Tags[0].Resources.Count = 2
+	after.Links = append(after.Links, InventoryLink{From: "bl:loadbalancer:5", Relation: RelationBalances, To: "bl:server:3"})
+
+	diff := DiffInventories(before, after)
+
+	expected := []ResourceDiff{
+		{URN: "bl:domainrecord:example.com:10", Type: ResourceChanged, Fields: []FieldChange{
+			{Path: "data", Old: "192.0.2.1", New: "192.0.2.2"},
+		}},
+		{URN: "bl:loadbalancer:5", Type: ResourceChanged, Fields: []FieldChange{
+			{Path: "health_check.path", Old: "/", New: "/health"},
+			{Path: "server_ids", Old: []interface{}{1.0}, New: []interface{}{1.0, 3.0}},
+		}},
+		{URN: "bl:server:1", Type: ResourceChanged, Fields: []FieldChange{
+			{Path: "size_slug", Old: "std-min", New: "std-2vcpu"},
+			{Path: "tags", Old: []interface{}{"web"}, New: []interface{}{"web", "canary"}},
+		}},
+		{URN: "bl:server:2", Type: ResourceRemoved},
+		{URN: "bl:server:3", Type: ResourceAdded},
+	}
+	if !reflect.DeepEqual(diff.Resources, expected) {
+		t.Errorf("DiffInventories returned %+v, expected %+v", diff.Resources, expected)
+	}
+
+	expectedLinks := []InventoryLink{{From: "bl:loadbalancer:5", Relation: RelationBalances, To: "bl:server:3"}}
+	if !reflect.DeepEqual(diff.LinksAdded, expectedLinks) || len(diff.LinksRemoved) != 0 {
+		t.Errorf("DiffInventories returned links added %+v and removed %+v", diff.LinksAdded, diff.LinksRemoved)
+	}
+	if diff.Empty() {
+		t.Errorf("Empty returned true")
+	}
+
+	s := diff.String()
+	for _, want := range []string{
+		"+ bl:server:3\n",
+		"- bl:server:2\n",
+		"~ bl:server:1\n    size_slug: \"std-min\" -> \"std-2vcpu\"\n",
+		"+ bl:loadbalancer:5 balances bl:server:3\n",
+	} {
+		if !strings.Contains(s, want) {
+			t.Errorf("String returned %q, expected it to contain %q", s, want)
+		}
+	}
+}
+
+func TestDiffInventories_ignore(t *testing.T) {
+	before := driftInventory()
+	after := driftInventory()
+	after.Servers[0].Status = "off"
+	after.Servers[1].Status = "off"
+	after.LoadBalancers[0].HealthCheck.Port = 8080
+
+	diff := DiffInventories(before, after, "server.status", "*.health_check")
+	if !diff.Empty() {
+		t.Errorf("DiffInventories returned %+v, expected ignored fields to be skipped", diff)
+	}
+
+	diff = DiffInventories(before, after, "loadbalancer.health_check.path")
+	if len(diff.Resources) != 3 {
+		t.Errorf("DiffInventories returned %+v, expected three changed resources", diff.Resources)
+	}
+}
+
+func TestDiffInventories_same(t *testing.T) {
+	diff := DiffInventories(driftInventory(), driftInventory())
+	if !diff.Empty() || diff.String() != "" {
+		t.Errorf("DiffInventories returned %+v for identical inventories", diff)
+	}
+}
@@ -0,0 +1,453 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+const (
+	defaultCreateAttempts = 3
+	defaultCreateBackoff  = time.Second
+	defaultCreateWindow   = 5 * time.Minute
+)
+
+// IdempotentCreator creates resources so that a create that fails can be
+// retried without making the resource twice. Each create carries an
+// idempotency key, but since the API may not honour it, a create that
+// failed in a way that leaves its outcome unknown, such as the connection
+// dropping before the response arrived, is not simply sent again. The
+// creator first looks for a resource matching the request that was created
+// since the first attempt, and returns that instead if there is one. It
+// looks once more after the last attempt, which makes it less likely, but
+// cannot rule out, that a resource was created when an error is returned:
+// the API may list a resource only some time after creating it.
+//
+// Where the request does not identify a resource uniquely, the resources
+// that already exist are listed before the first attempt and are never
+// taken as the result of the create.
+//
+// The Response returned by each method is nil when the resource was found
+// by a lookup rather than returned by a create.
+type IdempotentCreator struct {
+	client *binarylane.Client
+
+	// MaxAttempts is the number of times a create is attempted. Defaults
+	// to 3.
+	MaxAttempts int
+
+	// Backoff is the delay before the first retry. It doubles for each
+	// later retry. Defaults to 1 second.
+	Backoff time.Duration
+
+	// Window is how long before the first attempt a matching resource may
+	// have been created and still be taken as the result of the create. It
+	// allows for the clocks of the client and the API differing. Defaults to
+	// 5 minutes. Resources that do not record when they were created, such
+	// as domains and SSH keys, match whenever they are the same as the
+	// request. A resource whose creation time is missing or cannot be parsed
+	// does not match.
+	Window time.Duration
+}
+
+// NewIdempotentCreator returns an IdempotentCreator using client with
+// default settings.
+func NewIdempotentCreator(client *binarylane.Client) *IdempotentCreator {
+	return &IdempotentCreator{
+		client:      client,
+		MaxAttempts: defaultCreateAttempts,
+		Backoff:     defaultCreateBackoff,
+		Window:      defaultCreateWindow,
+	}
+}
+
+// CreateServer creates a server, or finds the server with the requested
+// name and tags that an earlier attempt created. The servers in the account
+// are listed before the first attempt, so that a server of the same name
+// created shortly before is not taken for one created by the attempt.
+func (c *IdempotentCreator) CreateServer(ctx context.Context, req *binarylane.ServerCreateRequest) (*binarylane.Server, *binarylane.Response, error) {
+	existing, err := c.client.Servers.ListAll(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	before := make(map[int]bool, len(existing))
+	for _, s := range existing {
+		before[s.ID] = true
+	}
+
+	var (
+		server *binarylane.Server
+		resp   *binarylane.Response
+	)
+	err = c.create(ctx,
+		func(ctx context.Context) (err error) {
+			server, resp, err = c.client.Servers.Create(ctx, req)
+			return err
+		},
+		func(ctx context.Context, since time.Time) (bool, error) {
+			servers, err := c.client.Servers.ListAll(ctx)
+			if err != nil {
+				return false, err
+			}
+			for i, s := range servers {
+				if !before[s.ID] && s.Name == req.Name && containsAll(s.Tags, req.Tags) && createdSince(s.Created, since) {
+					server, resp = &servers[i], nil
+					return true, nil
+				}
+			}
+			return false, nil
+		})
+	return server, resp, err
+}
+
+// CreateDomain creates a domain, or finds the domain if an earlier attempt
+// created it.
+func (c *IdempotentCreator) CreateDomain(ctx context.Context, req *binarylane.DomainCreateRequest) (*binarylane.Domain, *binarylane.Response, error) {
+	var (
+		domain *binarylane.Domain
+		resp   *binarylane.Response
+	)
+	err := c.create(ctx,
+		func(ctx context.Context) (err error) {
+			domain, resp, err = c.client.Domains.Create(ctx, req)
+			return err
+		},
+		func(ctx context.Context, since time.Time) (bool, error) {
+			d, _, err := c.client.Domains.Get(ctx, req.Name)
+			if errors.Is(err, binarylane.ErrNotFound) {
+				return false, nil
+			}
+			if err != nil {
+				return false, err
+			}
+			domain, resp = d, nil
+			return true, nil
+		})
+	return domain, resp, err
+}
+
+// CreateDomainRecord creates a record in a domain, or finds the record with
+// the requested type, name and data that an earlier attempt created.
+func (c *IdempotentCreator) CreateDomainRecord(ctx context.Context, domain string, req *binarylane.DomainRecordEditRequest) (*binarylane.DomainRecord, *binarylane.Response, error) {
+	existing, err := c.client.Domains.RecordsAll(ctx, domain)
+	if err != nil {
+		return nil, nil, err
+	}
+	before := make(map[int]bool, len(existing))
+	for _, r := range existing {
+		before[r.ID] = true
+	}
+
+	var (
+		record *binarylane.DomainRecord
+		resp   *binarylane.Response
+	)
+	err = c.create(ctx,
+		func(ctx context.Context) (err error) {
+			record, resp, err = c.client.Domains.CreateRecord(ctx, domain, req)
+			return err
+		},
+		func(ctx context.Context, since time.Time) (bool, error) {
+			records, err := c.client.Domains.RecordsAll(ctx, domain)
+			if err != nil {
+				return false, err
+			}
+			for i, r := range records {
+				if !before[r.ID] && r.Type == req.Type && r.Name == req.Name && r.Data == req.Data &&
+					r.Priority == req.Priority && r.Port == req.Port && r.Weight == req.Weight {
+					record, resp = &records[i], nil
+					return true, nil
+				}
+			}
+			return false, nil
+		})
+	return record, resp, err
+}
+
+// CreateFloatingIP creates a floating IP, or finds the floating IP in the
+// requested region or on the requested server that an earlier attempt
+// created. The floating IPs in the account are listed before the first
+// attempt, so that one created by the attempt can be told apart.
+func (c *IdempotentCreator) CreateFloatingIP(ctx context.Context, req *binarylane.FloatingIPCreateRequest) (*binarylane.FloatingIP, *binarylane.Response, error) {
+	existing, err := c.client.FloatingIPs.ListAll(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	before := make(map[string]bool, len(existing))
+	for _, fip := range existing {
+		before[fip.IP] = true
+	}
+
+	var (
+		fip  *binarylane.FloatingIP
+		resp *binarylane.Response
+	)
+	err = c.create(ctx,
+		func(ctx context.Context) (err error) {
+			fip, resp, err = c.client.FloatingIPs.Create(ctx, req)
+			return err
+		},
+		func(ctx context.Context, since time.Time) (bool, error) {
+			fips, err := c.client.FloatingIPs.ListAll(ctx)
+			if err != nil {
+				return false, err
+			}
+			for i, f := range fips {
+				if before[f.IP] {
+					continue
+				}
+				if req.Region != "" && (f.Region == nil || f.Region.Slug != req.Region) {
+					continue
+				}
+				if req.ServerID != 0 && (f.Server == nil || f.Server.ID != req.ServerID) {
+					continue
+				}
+				fip, resp = &fips[i], nil
+				return true, nil
+			}
+			return false, nil
+		})
+	return fip, resp, err
+}
+
+// CreateKey adds an SSH key, or finds the key with the requested public key
+// that an earlier attempt added.
+func (c *IdempotentCreator) CreateKey(ctx context.Context, req *binarylane.KeyCreateRequest) (*binarylane.Key, *binarylane.Response, error) {
+	var (
+		key  *binarylane.Key
+		resp *binarylane.Response
+	)
+	err := c.create(ctx,
+		func(ctx context.Context) (err error) {
+			key, resp, err = c.client.Keys.Create(ctx, req)
+			return err
+		},
+		func(ctx context.Context, since time.Time) (bool, error) {
+			keys, err := c.client.Keys.ListAll(ctx)
+			if err != nil {
+				return false, err
+			}
+			for i, k := range keys {
+				if k.PublicKey == req.PublicKey {
+					key, resp = &keys[i], nil
+					return true, nil
+				}
+			}
+			return false, nil
+		})
+	return key, resp, err
+}
+
+// CreateVPC creates a VPC, or finds the VPC with the requested name and
+// region that an earlier attempt created.
+func (c *IdempotentCreator) CreateVPC(ctx context.Context, req *binarylane.VPCCreateRequest) (*binarylane.VPC, *binarylane.Response, error) {
+	existing, err := c.client.VPCs.ListAll(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	before := make(map[int]bool, len(existing))
+	for _, v := range existing {
+		before[v.ID] = true
+	}
+
+	var (
+		vpc  *binarylane.VPC
+		resp *binarylane.Response
+	)
+	err = c.create(ctx,
+		func(ctx context.Context) (err error) {
+			vpc, resp, err = c.client.VPCs.Create(ctx, req)
+			return err
+		},
+		func(ctx context.Context, since time.Time) (bool, error) {
+			vpcs, err := c.client.VPCs.ListAll(ctx)
+			if err != nil {
+				return false, err
+			}
+			for _, v := range vpcs {
+				if !before[v.ID] && v.Name == req.Name && v.RegionSlug == req.RegionSlug && createdAtSince(v.CreatedAt, since) {
+					vpc, resp = v, nil
+					return true, nil
+				}
+			}
+			return false, nil
+		})
+	return vpc, resp, err
+}
+
+// CreateFirewall creates a firewall, or finds the firewall with the
+// requested name that an earlier attempt created.
+func (c *IdempotentCreator) CreateFirewall(ctx context.Context, req *binarylane.FirewallRequest) (*binarylane.Firewall, *binarylane.Response, error) {
+	existing, err := c.client.Firewalls.ListAll(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	before := make(map[string]bool, len(existing))
+	for _, f := range existing {
+		before[f.ID] = true
+	}
+
+	var (
+		fw   *binarylane.Firewall
+		resp *binarylane.Response
+	)
+	err = c.create(ctx,
+		func(ctx context.Context) (err error) {
+			fw, resp, err = c.client.Firewalls.Create(ctx, req)
+			return err
+		},
+		func(ctx context.Context, since time.Time) (bool, error) {
+			fws, err := c.client.Firewalls.ListAll(ctx)
+			if err != nil {
+				return false, err
+			}
+			for i, f := range fws {
+				if !before[f.ID] && f.Name == req.Name && createdSince(f.Created, since) {
+					fw, resp = &fws[i], nil
+					return true, nil
+				}
+			}
+			return false, nil
+		})
+	return fw, resp, err
+}
+
+// CreateLoadBalancer creates a load balancer, or finds the load balancer
+// with the requested name and region that an earlier attempt created.
+func (c *IdempotentCreator) CreateLoadBalancer(ctx context.Context, req *binarylane.LoadBalancerRequest) (*binarylane.LoadBalancer, *binarylane.Response, error) {
+	existing, err := c.client.LoadBalancers.ListAll(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	before := make(map[int]bool, len(existing))
+	for _, l := range existing {
+		before[l.ID] = true
+	}
+
+	var (
+		lb   *binarylane.LoadBalancer
+		resp *binarylane.Response
+	)
+	err = c.create(ctx,
+		func(ctx context.Context) (err error) {
+			lb, resp, err = c.client.LoadBalancers.Create(ctx, req)
+			return err
+		},
+		func(ctx context.Context, since time.Time) (bool, error) {
+			lbs, err := c.client.LoadBalancers.ListAll(ctx)
+			if err != nil {
+				return false, err
+			}
+			for i, l := range lbs {
+				if !before[l.ID] && l.Name == req.Name && (req.Region == "" || regionSlug(l.Region) == req.Region) && createdSince(l.Created, since) {
+					lb, resp = &lbs[i], nil
+					return true, nil
+				}
+			}
+			return false, nil
+		})
+	return lb, resp, err
+}
+
+// create calls create until it succeeds, fails with an error that is not
+// retryable, or has been attempted MaxAttempts times. After each attempt
+// whose outcome is unknown, including the last, lookup is called following
+// the backoff delay to find a resource created by an earlier attempt; if it
+// finds one, create is not retried. If lookup fails, the create is not
+// retried and its error is returned.
+func (c *IdempotentCreator) create(ctx context.Context, create func(ctx context.Context) error, lookup func(ctx context.Context, since time.Time) (bool, error)) error {
+	maxAttempts := c.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultCreateAttempts
+	}
+	backoff := c.Backoff
+	if backoff <= 0 {
+		backoff = defaultCreateBackoff
+	}
+
+	since := time.Now().Add(-c.Window)
+	keyed := binarylane.ContextWithIdempotencyKey(ctx, binarylane.NewIdempotencyKey())
+	for attempt := 1; ; attempt++ {
+		err := create(keyed)
+		if err == nil || !binarylane.IsRetryable(err) {
+			return err
+		}
+		last := attempt >= maxAttempts
+		if last && !outcomeUnknown(err) {
+			return err
+		}
+
+		timer := time.NewTimer(backoff << uint(attempt-1))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		if outcomeUnknown(err) {
+			found, lookupErr := lookup(ctx, since)
+			if lookupErr != nil {
+				return err
+			}
+			if found {
+				return nil
+			}
+		}
+		if last {
+			return err
+		}
+	}
+}
+
+// outcomeUnknown reports whether a create that failed with the retryable
+// error err may nonetheless have been carried out. A rate limited request
+// was refused before it was handled.
+func outcomeUnknown(err error) bool {
+	if errors.Is(err, binarylane.ErrRateLimited) {
+		return false
+	}
+	var errResp *binarylane.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		return errResp.Response.StatusCode != http.StatusTooManyRequests
+	}
+	return true
+}
+
+// createdSince reports whether the created_at timestamp created is not
+// before since. A timestamp that cannot be parsed does not match.
+func createdSince(created string, since time.Time) bool {
+	t, err := time.Parse(time.RFC3339, created)
+	return err == nil && createdAtSince(t, since)
+}
+
+// createdAtSince reports whether created is set and not before since.
+func createdAtSince(created, since time.Time) bool {
+	return !created.IsZero() && !created.Before(since)
+}
+
+func containsAll(list, want []string) bool {
+	for _, w := range want {
+		found := false
+		for _, v := range list {
+			if v == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func regionSlug(r *binarylane.Region) string {
+	if r == nil {
+		return ""
+	}
+	return r.Slug
+}
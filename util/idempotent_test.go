@@ -0,0 +1,271 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+	"github.com/binarylane/go-binarylane/binarylanetest"
+)
+
+// droppingTransport sends requests to the fake, but for the first drops
+// POST requests to a path it discards the response and returns an error, as
+// if the connection failed after the API had handled the request.
+type droppingTransport struct {
+	mu    sync.Mutex
+	path  string
+	drops int
+	keys  []string
+}
+
+func (d *droppingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil || req.Method != http.MethodPost || !strings.HasSuffix(req.URL.Path, d.path) {
+		return resp, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.keys = append(d.keys, req.Header.Get(binarylane.IdempotencyKeyHeader))
+	if d.drops == 0 {
+		return resp, nil
+	}
+	d.drops--
+	resp.Body.Close()
+	return nil, errors.New("connection reset by peer")
+}
+
+func newTestIdempotentCreator(t *testing.T, path string, drops int) (*IdempotentCreator, *binarylanetest.Server, *droppingTransport) {
+	fake, _ := setup(t)
+	transport := &droppingTransport{path: path, drops: drops}
+	client, err := binarylane.New(&http.Client{Transport: transport}, binarylane.SetBaseURL(fake.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewIdempotentCreator(client)
+	c.Backoff = time.Millisecond
+	return c, fake, transport
+}
+
+func TestIdempotentCreator_CreateServerFindsExisting(t *testing.T) {
+	ctx := context.Background()
+	c, _, transport := newTestIdempotentCreator(t, "/v2/servers", 1)
+
+	server, resp, err := c.CreateServer(ctx, provisionServer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server == nil || server.Name != provisionServer.Name {
+		t.Fatalf("CreateServer returned %+v", server)
+	}
+	if resp != nil {
+		t.Errorf("CreateServer returned a response for a server found by lookup")
+	}
+	if len(transport.keys) != 1 || transport.keys[0] == "" {
+		t.Errorf("CreateServer sent %q, expected one create with an idempotency key", transport.keys)
+	}
+
+	servers, err := c.client.Servers.ListAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(servers) != 1 {
+		t.Errorf("CreateServer left %d servers, expected 1", len(servers))
+	}
+}
+
+func TestIdempotentCreator_CreateServerIgnoresEarlierServer(t *testing.T) {
+	ctx := context.Background()
+	c, _, transport := newTestIdempotentCreator(t, "/v2/servers", 0)
+	earlier, _, err := c.client.Servers.Create(ctx, provisionServer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport.drops, transport.keys = 1, nil
+
+	server, _, err := c.CreateServer(ctx, provisionServer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server == nil || server.ID == earlier.ID {
+		t.Errorf("CreateServer returned %+v, expected the server created by the attempt", server)
+	}
+	if len(transport.keys) != 1 {
+		t.Errorf("CreateServer sent %d creates, expected 1", len(transport.keys))
+	}
+}
+
+func TestIdempotentCreator_looksUpAfterLastAttempt(t *testing.T) {
+	ctx := context.Background()
+	c, _, transport := newTestIdempotentCreator(t, "/v2/servers", 1)
+	c.MaxAttempts = 1
+
+	server, resp, err := c.CreateServer(ctx, provisionServer)
+	if err != nil {
+		t.Fatalf("CreateServer returned %v, expected the server created by the dropped attempt", err)
+	}
+	if server == nil || resp != nil {
+		t.Errorf("CreateServer returned %+v, %v", server, resp)
+	}
+	if len(transport.keys) != 1 {
+		t.Errorf("CreateServer sent %d creates, expected 1", len(transport.keys))
+	}
+}
+
+func TestIdempotentCreator_CreateRetriesWhenNotFound(t *testing.T) {
+	ctx := context.Background()
+	c, fake, transport := newTestIdempotentCreator(t, "/v2/domains", 0)
+	fake.InjectFault(binarylanetest.Fault{Method: http.MethodPost, Path: "/v2/domains", Status: http.StatusServiceUnavailable, Count: 1})
+
+	domain, resp, err := c.CreateDomain(ctx, &binarylane.DomainCreateRequest{Name: "example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if domain == nil || domain.Name != "example.com" || resp == nil {
+		t.Errorf("CreateDomain returned %+v, %v", domain, resp)
+	}
+	if len(transport.keys) != 2 || transport.keys[0] != transport.keys[1] {
+		t.Errorf("CreateDomain sent %q, expected two creates with the same idempotency key", transport.keys)
+	}
+}
+
+func TestIdempotentCreator_CreateDomainRecordFindsExisting(t *testing.T) {
+	ctx := context.Background()
+	c, _, transport := newTestIdempotentCreator(t, "/records", 1)
+	if _, _, err := c.client.Domains.Create(ctx, &binarylane.DomainCreateRequest{Name: "example.com"}); err != nil {
+		t.Fatal(err)
+	}
+
+	req := &binarylane.DomainRecordEditRequest{Type: "A", Name: "www", Data: "192.0.2.1"}
+	record, _, err := c.CreateDomainRecord(ctx, "example.com", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record == nil || record.Data != req.Data {
+		t.Fatalf("CreateDomainRecord returned %+v", record)
+	}
+	if len(transport.keys) != 1 {
+		t.Errorf("CreateDomainRecord sent %d creates, expected 1", len(transport.keys))
+	}
+}
+
+func TestIdempotentCreator_CreateDomainRecordIgnoresExisting(t *testing.T) {
+	ctx := context.Background()
+	c, _, transport := newTestIdempotentCreator(t, "/records", 0)
+	if _, _, err := c.client.Domains.Create(ctx, &binarylane.DomainCreateRequest{Name: "example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	req := &binarylane.DomainRecordEditRequest{Type: "A", Name: "www", Data: "192.0.2.1"}
+	existing, _, err := c.client.Domains.CreateRecord(ctx, "example.com", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport.drops = 1
+
+	record, _, err := c.CreateDomainRecord(ctx, "example.com", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record == nil || record.ID == existing.ID {
+		t.Errorf("CreateDomainRecord returned %+v, expected the record created by the attempt", record)
+	}
+}
+
+func TestIdempotentCreator_CreateFirewallIgnoresEarlier(t *testing.T) {
+	ctx := context.Background()
+	c, _, transport := newTestIdempotentCreator(t, "/v2/firewalls", 0)
+	req := &binarylane.FirewallRequest{Name: "web"}
+	earlier, _, err := c.client.Firewalls.Create(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport.drops = 1
+
+	fw, _, err := c.CreateFirewall(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fw == nil || fw.ID == earlier.ID {
+		t.Errorf("CreateFirewall returned %+v, expected the firewall created by the attempt", fw)
+	}
+}
+
+func TestIdempotentCreator_CreateFloatingIPFindsNew(t *testing.T) {
+	ctx := context.Background()
+	c, _, transport := newTestIdempotentCreator(t, "/v2/floating_ips", 0)
+	existing, _, err := c.client.FloatingIPs.Create(ctx, &binarylane.FloatingIPCreateRequest{Region: "syd"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport.drops = 1
+
+	fip, _, err := c.CreateFloatingIP(ctx, &binarylane.FloatingIPCreateRequest{Region: "syd"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fip == nil || fip.IP == existing.IP {
+		t.Errorf("CreateFloatingIP returned %+v, expected the new floating IP", fip)
+	}
+
+	fips, err := c.client.FloatingIPs.ListAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fips) != 2 {
+		t.Errorf("CreateFloatingIP left %d floating IPs, expected 2", len(fips))
+	}
+}
+
+func TestIdempotentCreator_nonRetryableError(t *testing.T) {
+	ctx := context.Background()
+	c, fake, transport := newTestIdempotentCreator(t, "/v2/vpcs", 0)
+	fake.InjectFault(binarylanetest.Fault{Method: http.MethodPost, Path: "/v2/vpcs", Status: http.StatusUnprocessableEntity})
+
+	if _, _, err := c.CreateVPC(ctx, &binarylane.VPCCreateRequest{Name: "office", RegionSlug: "syd"}); err == nil {
+		t.Fatal("CreateVPC: expected an error")
+	}
+	if len(transport.keys) != 1 {
+		t.Errorf("CreateVPC sent %d creates, expected 1", len(transport.keys))
+	}
+}
+
+func TestIdempotentCreator_givesUp(t *testing.T) {
+	ctx := context.Background()
+	c, fake, transport := newTestIdempotentCreator(t, "/v2/vpcs", 0)
+	c.MaxAttempts = 2
+	fake.InjectFault(binarylanetest.Fault{Method: http.MethodPost, Path: "/v2/vpcs", Status: http.StatusServiceUnavailable})
+
+	_, _, err := c.CreateVPC(ctx, &binarylane.VPCCreateRequest{Name: "office", RegionSlug: "syd"})
+	if !binarylane.IsRetryable(err) {
+		t.Fatalf("CreateVPC returned %v, expected the last retryable error", err)
+	}
+	if len(transport.keys) != 2 {
+		t.Errorf("CreateVPC sent %d creates, expected 2", len(transport.keys))
+	}
+}
+
+func TestCreatedSince(t *testing.T) {
+	since := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		created  string
+		expected bool
+	}{
+		{"2024-01-01T12:00:00Z", true},
+		{"2024-01-01T13:00:00+01:00", true},
+		{"2024-01-01T11:59:59Z", false},
+		{"", false},
+		{"yesterday", false},
+	} {
+		if got := createdSince(tc.created, since); got != tc.expected {
+			t.Errorf("createdSince(%q) = %v, expected %v", tc.created, got, tc.expected)
+		}
+	}
+	if createdAtSince(time.Time{}, time.Time{}) {
+		t.Error("createdAtSince matched a zero creation time")
+	}
+}
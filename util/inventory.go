@@ -0,0 +1,386 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+// InventoryVersion is the version of the Inventory format produced by
+// TakeInventory. It is increased whenever a change to the format would stop
+// an older reader from understanding a newer inventory.
+const InventoryVersion = 1
+
+const defaultInventoryParallelism = 8
+
+// Relations between resources recorded as InventoryLinks.
+const (
+	// RelationProtects links a firewall to a server it applies to, either
+	// directly or through one of its tags.
+	RelationProtects = "protects"
+
+	// RelationBalances links a load balancer to a server behind it, either
+	// directly or through its tag.
+	RelationBalances = "balances"
+
+	// RelationAssignedTo links a floating IP to the server it is assigned to.
+	RelationAssignedTo = "assigned_to"
+
+	// RelationMemberOf links a server or load balancer to its VPC.
+	RelationMemberOf = "member_of"
+
+	// RelationContains links a project to a resource assigned to it.
+	RelationContains = "contains"
+
+	// RelationTags links a tag to a resource it is applied to.
+	RelationTags = "tags"
+
+	// RelationSnapshotOf links a snapshot to the server or volume it was
+	// taken of.
+	RelationSnapshotOf = "snapshot_of"
+)
+
+// Inventory is a snapshot of every resource in an account, as returned by
+// TakeInventory. It can be serialised to JSON to be kept for audits or
+// compared with a later inventory.
+type Inventory struct {
+	Version int       `json:"version"`
+	TakenAt time.Time `json:"taken_at"`
+
+	Servers       []binarylane.Server       `json:"servers"`
+	Images        []binarylane.Image        `json:"images"`
+	Snapshots     []binarylane.Snapshot     `json:"snapshots"`
+	Keys          []binarylane.Key          `json:"keys"`
+	Domains       []InventoryDomain         `json:"domains"`
+	FloatingIPs   []binarylane.FloatingIP   `json:"floating_ips"`
+	Firewalls     []binarylane.Firewall     `json:"firewalls"`
+	LoadBalancers []binarylane.LoadBalancer `json:"load_balancers"`
+	VPCs          []binarylane.VPC          `json:"vpcs"`
+	Projects      []InventoryProject        `json:"projects"`
+	Tags          []binarylane.Tag          `json:"tags"`
+
+	// Links records the relations between resources, by URN.
+	Links []InventoryLink `json:"links"`
+}
+
+// InventoryDomain is a domain and its records.
+type InventoryDomain struct {
+	Domain  binarylane.Domain         `json:"domain"`
+	Records []binarylane.DomainRecord `json:"records"`
+}
+
+// InventoryProject is a project and the resources assigned to it.
+type InventoryProject struct {
+	Project   binarylane.Project           `json:"project"`
+	Resources []binarylane.ProjectResource `json:"resources"`
+}
+
+// InventoryLink is a relation from one resource to another, such as a
+// firewall that protects a server.
+type InventoryLink struct {
+	From     string `json:"from"`
+	Relation string `json:"relation"`
+	To       string `json:"to"`
+}
+
+// LinksFrom returns the links from the resource with the given URN.
+func (inv *Inventory) LinksFrom(urn string) []InventoryLink {
+	var links []InventoryLink
+	for _, l := range inv.Links {
+		if l.From == urn {
+			links = append(links, l)
+		}
+	}
+	return links
+}
+
+// LinksTo returns the links to the resource with the given URN.
+func (inv *Inventory) LinksTo(urn string) []InventoryLink {
+	var links []InventoryLink
+	for _, l := range inv.Links {
+		if l.To == urn {
+			links = append(links, l)
+		}
+	}
+	return links
+}
+
+// ImageURN returns the URN of an image, which the API does not define.
+func ImageURN(image binarylane.Image) string {
+	return binarylane.ToURN("Image", image.ID)
+}
+
+// SnapshotURN returns the URN of a snapshot, which the API does not define.
+func SnapshotURN(snapshot binarylane.Snapshot) string {
+	return binarylane.ToURN("Snapshot", snapshot.ID)
+}
+
+// KeyURN returns the URN of an SSH key, which the API does not define.
+func KeyURN(key binarylane.Key) string {
+	return binarylane.ToURN("Key", key.ID)
+}
+
+// ProjectURN returns the URN of a project, which the API does not define.
+func ProjectURN(project binarylane.Project) string {
+	return binarylane.ToURN("Project", project.ID)
+}
+
+// TagURN returns the URN of a tag, which the API does not define.
+func TagURN(tag binarylane.Tag) string {
+	return binarylane.ToURN("Tag", tag.Name)
+}
+
+// DomainRecordURN returns the URN of a record in a domain, which the API
+// does not define.
+func DomainRecordURN(domain string, record binarylane.DomainRecord) string {
+	return binarylane.ToURN("DomainRecord", fmt.Sprintf("%s:%d", domain, record.ID))
+}
+
+// TakeInventory fetches every resource in the account and returns them as an
+// Inventory. Lists are fetched concurrently and followed through every page.
+// Only private images are included; public distribution and application
+// images are not part of the account.
+//
+// If any request fails, the remaining requests are cancelled and the first
+// error is returned.
+func TakeInventory(ctx context.Context, client *binarylane.Client) (*Inventory, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	inv := &Inventory{Version: InventoryVersion, TakenAt: time.Now().UTC()}
+	f := &inventoryFetcher{
+		ctx:    ctx,
+		cancel: cancel,
+		sem:    make(chan struct{}, defaultInventoryParallelism),
+	}
+
+	f.fetch("servers", func(ctx context.Context) (err error) {
+		inv.Servers, err = client.Servers.ListAll(ctx)
+		return err
+	})
+	f.fetch("images", func(ctx context.Context) (err error) {
+		inv.Images, err = client.Images.ListUserAll(ctx)
+		return err
+	})
+	f.fetch("snapshots", func(ctx context.Context) (err error) {
+		inv.Snapshots, err = client.Snapshots.ListAll(ctx)
+		return err
+	})
+	f.fetch("keys", func(ctx context.Context) (err error) {
+		inv.Keys, err = client.Keys.ListAll(ctx)
+		return err
+	})
+	f.fetch("domains", func(ctx context.Context) error {
+		domains, err := client.Domains.ListAll(ctx)
+		if err != nil {
+			return err
+		}
+		inv.Domains = make([]InventoryDomain, len(domains))
+		for i := range domains {
+			d := &inv.Domains[i]
+			d.Domain = domains[i]
+			f.fetch("records of "+d.Domain.Name, func(ctx context.Context) (err error) {
+				d.Records, err = client.Domains.RecordsAll(ctx, d.Domain.Name)
+				return err
+			})
+		}
+		return nil
+	})
+	f.fetch("floating IPs", func(ctx context.Context) (err error) {
+		inv.FloatingIPs, err = client.FloatingIPs.ListAll(ctx)
+		return err
+	})
+	f.fetch("firewalls", func(ctx context.Context) (err error) {
+		inv.Firewalls, err = client.Firewalls.ListAll(ctx)
+		return err
+	})
+	f.fetch("load balancers", func(ctx context.Context) (err error) {
+		inv.LoadBalancers, err = client.LoadBalancers.ListAll(ctx)
+		return err
+	})
+	f.fetch("VPCs", func(ctx context.Context) error {
+		vpcs, err := client.VPCs.ListAll(ctx)
+		for _, vpc := range vpcs {
+			inv.VPCs = append(inv.VPCs, *vpc)
+		}
+		return err
+	})
+	f.fetch("projects", func(ctx context.Context) error {
+		projects, err := client.Projects.ListAll(ctx)
+		if err != nil {
+			return err
+		}
+		inv.Projects = make([]InventoryProject, len(projects))
+		for i := range projects {
+			p := &inv.Projects[i]
+			p.Project = projects[i]
+			f.fetch("resources of project "+p.Project.Name, func(ctx context.Context) (err error) {
+				p.Resources, err = client.Projects.ListResourcesAll(ctx, p.Project.ID)
+				return err
+			})
+		}
+		return nil
+	})
+	f.fetch("tags", func(ctx context.Context) (err error) {
+		inv.Tags, err = client.Tags.ListAll(ctx)
+		return err
+	})
+
+	if err := f.wait(); err != nil {
+		return nil, err
+	}
+	inv.Links = inventoryLinks(inv)
+	return inv, nil
+}
+
+// inventoryFetcher runs the requests of TakeInventory concurrently, keeping
+// the first error and cancelling the rest once one fails.
+type inventoryFetcher struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	sem    chan struct{}
+	wg     sync.WaitGroup
+
+	mu  sync.Mutex
+	err error
+}
+
+// fetch runs fn in a new goroutine once one of the fetcher's slots is free.
+// fn may call fetch to start further requests.
+func (f *inventoryFetcher) fetch(what string, fn func(ctx context.Context) error) {
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+		select {
+		case f.sem <- struct{}{}:
+		case <-f.ctx.Done():
+			f.fail(f.ctx.Err())
+			return
+		}
+		err := fn(f.ctx)
+		<-f.sem
+		if err != nil {
+			f.fail(fmt.Errorf("listing %s: %w", what, err))
+		}
+	}()
+}
+
+func (f *inventoryFetcher) fail(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err == nil {
+		f.err = err
+		f.cancel()
+	}
+}
+
+func (f *inventoryFetcher) wait() error {
+	f.wg.Wait()
+	return f.err
+}
+
+// inventoryLinks works out the relations between the resources in inv.
+func inventoryLinks(inv *Inventory) []InventoryLink {
+	servers := make(map[int]binarylane.Server, len(inv.Servers))
+	tagged := make(map[string][]binarylane.Server)
+	for _, s := range inv.Servers {
+		servers[s.ID] = s
+		for _, tag := range s.Tags {
+			tagged[tag] = append(tagged[tag], s)
+		}
+	}
+
+	seen := make(map[InventoryLink]bool)
+	var links []InventoryLink
+	link := func(from, relation, to string) {
+		l := InventoryLink{From: from, Relation: relation, To: to}
+		if !seen[l] {
+			seen[l] = true
+			links = append(links, l)
+		}
+	}
+	serverURN := func(id int) string {
+		return binarylane.ToURN("Server", id)
+	}
+
+	for _, s := range inv.Servers {
+		if s.VPCID != 0 {
+			link(s.URN(), RelationMemberOf, binarylane.ToURN("VPC", s.VPCID))
+		}
+	}
+	for _, fw := range inv.Firewalls {
+		for _, id := range fw.ServerIDs {
+			link(fw.URN(), RelationProtects, serverURN(id))
+		}
+		for _, tag := range fw.Tags {
+			for _, s := range tagged[tag] {
+				link(fw.URN(), RelationProtects, s.URN())
+			}
+		}
+	}
+	for _, lb := range inv.LoadBalancers {
+		for _, id := range lb.ServerIDs {
+			link(lb.URN(), RelationBalances, serverURN(id))
+		}
+		if lb.Tag != "" {
+			for _, s := range tagged[lb.Tag] {
+				link(lb.URN(), RelationBalances, s.URN())
+			}
+		}
+		if lb.VPCID != 0 {
+			link(lb.URN(), RelationMemberOf, binarylane.ToURN("VPC", lb.VPCID))
+		}
+	}
+	for _, fip := range inv.FloatingIPs {
+		if fip.Server != nil {
+			link(fip.URN(), RelationAssignedTo, fip.Server.URN())
+		}
+	}
+	for _, p := range inv.Projects {
+		for _, r := range p.Resources {
+			link(ProjectURN(p.Project), RelationContains, r.URN)
+		}
+	}
+	for _, s := range inv.Snapshots {
+		if s.ResourceID == "" {
+			continue
+		}
+		resourceType := "Server"
+		if s.ResourceType == "volume" {
+			resourceType = "Volume"
+		}
+		link(SnapshotURN(s), RelationSnapshotOf, binarylane.ToURN(resourceType, s.ResourceID))
+	}
+
+	for tag, list := range tagged {
+		for _, s := range list {
+			link(binarylane.ToURN("Tag", tag), RelationTags, s.URN())
+		}
+	}
+	for _, image := range inv.Images {
+		for _, tag := range image.Tags {
+			link(binarylane.ToURN("Tag", tag), RelationTags, ImageURN(image))
+		}
+	}
+	for _, lb := range inv.LoadBalancers {
+		for _, tag := range lb.Tags {
+			link(binarylane.ToURN("Tag", tag), RelationTags, lb.URN())
+		}
+	}
+
+	sort.Slice(links, func(i, j int) bool {
+		a, b := links[i], links[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.Relation != b.Relation {
+			return a.Relation < b.Relation
+		}
+		return a.To < b.To
+	})
+	return links
+}
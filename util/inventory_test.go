@@ -0,0 +1,183 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+	"github.com/binarylane/go-binarylane/binarylanetest"
+)
+
+func TestTakeInventory(t *testing.T) {
+	ctx := context.Background()
+	_, client := setup(t, binarylanetest.WithPerPage(1))
+
+	vpc, _, err := client.VPCs.Create(ctx, &binarylane.VPCCreateRequest{Name: "office", RegionSlug: "syd"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	web, _, err := client.Servers.Create(ctx, &binarylane.ServerCreateRequest{
+		Name:   "web",
+		Region: "syd",
+		Size:   "std-min",
+		Image:  binarylane.ServerCreateImage{Slug: "ubuntu-20.04"},
+		Tags:   []string{"web"},
+		VPCID:  vpc.ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := createServers(t, client, 1)[0]
+
+	fw, _, err := client.Firewalls.Create(ctx, &binarylane.FirewallRequest{Name: "web", Tags: []string{"web"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lb, _, err := client.LoadBalancers.Create(ctx, &binarylane.LoadBalancerRequest{
+		Name:            "web",
+		Region:          "syd",
+		ForwardingRules: []binarylane.ForwardingRule{{EntryProtocol: "http", EntryPort: 80, TargetProtocol: "http", TargetPort: 80}},
+		ServerIDs:       []int{web.ID},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fip, _, err := client.FloatingIPs.Create(ctx, &binarylane.FloatingIPCreateRequest{ServerID: db})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Domains.Create(ctx, &binarylane.DomainCreateRequest{Name: "example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"www", "api"} {
+		req := &binarylane.DomainRecordEditRequest{Type: "A", Name: name, Data: "192.0.2.1"}
+		if _, _, err := client.Domains.CreateRecord(ctx, "example.com", req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := client.Keys.Create(ctx, &binarylane.KeyCreateRequest{Name: "ops", PublicKey: "ssh-ed25519 AAAA ops"}); err != nil {
+		t.Fatal(err)
+	}
+	project, _, err := client.Projects.Create(ctx, &binarylane.CreateProjectRequest{Name: "shop", Purpose: "Web Application"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Projects.AssignResources(ctx, project.ID, web.URN()); err != nil {
+		t.Fatal(err)
+	}
+	action, _, err := client.ServerActions.Snapshot(ctx, web.ID, "web backup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	waiter := NewWaiter(client)
+	waiter.Interval = time.Millisecond
+	if _, err := waiter.WaitForID(ctx, action.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	inv, err := TakeInventory(ctx, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if inv.Version != InventoryVersion {
+		t.Errorf("TakeInventory returned version %d", inv.Version)
+	}
+	counts := map[string]int{
+		"servers":        len(inv.Servers),
+		"images":         len(inv.Images),
+		"snapshots":      len(inv.Snapshots),
+		"keys":           len(inv.Keys),
+		"domains":        len(inv.Domains),
+		"floating IPs":   len(inv.FloatingIPs),
+		"firewalls":      len(inv.Firewalls),
+		"load balancers": len(inv.LoadBalancers),
+		"VPCs":           len(inv.VPCs),
+		"projects":       len(inv.Projects),
+		"tags":           len(inv.Tags),
+	}
+	expectedCounts := map[string]int{
+		"servers":        2,
+		"images":         1,
+		"snapshots":      1,
+		"keys":           1,
+		"domains":        1,
+		"floating IPs":   1,
+		"firewalls":      1,
+		"load balancers": 1,
+		"VPCs":           1,
+		"projects":       2,
+		"tags":           1,
+	}
+	if !reflect.DeepEqual(counts, expectedCounts) {
+		t.Errorf("TakeInventory returned %v resources, expected %v", counts, expectedCounts)
+	}
+	if len(inv.Domains) == 1 && len(inv.Domains[0].Records) != 2 {
+		t.Errorf("TakeInventory returned records %+v, expected every page", inv.Domains[0].Records)
+	}
+
+	webURN := web.URN()
+	expectedLinks := []InventoryLink{
+		{From: fw.URN(), Relation: RelationProtects, To: webURN},
+		{From: lb.URN(), Relation: RelationBalances, To: webURN},
+		{From: ProjectURN(*project), Relation: RelationContains, To: webURN},
+		{From: SnapshotURN(inv.Snapshots[0]), Relation: RelationSnapshotOf, To: webURN},
+		{From: "bl:tag:web", Relation: RelationTags, To: webURN},
+	}
+	for _, l := range expectedLinks {
+		if !containsLink(inv.LinksTo(webURN), l) {
+			t.Errorf("TakeInventory did not link %+v", l)
+		}
+	}
+	if links := inv.LinksFrom(webURN); len(links) != 1 || links[0].To != vpc.URN() || links[0].Relation != RelationMemberOf {
+		t.Errorf("LinksFrom(%q) returned %+v", webURN, links)
+	}
+	dbURN := binarylane.ToURN("Server", db)
+	if links := inv.LinksTo(dbURN); !containsLink(links, InventoryLink{From: fip.URN(), Relation: RelationAssignedTo, To: dbURN}) {
+		t.Errorf("LinksTo(%q) returned %+v, expected the floating IP", dbURN, links)
+	}
+
+	data, err := json.Marshal(inv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Inventory
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Links, inv.Links) || len(decoded.Servers) != len(inv.Servers) {
+		t.Errorf("Inventory did not survive a JSON round trip")
+	}
+}
+
+func TestTakeInventory_error(t *testing.T) {
+	fake, client := setup(t)
+	fake.InjectFault(binarylanetest.Fault{Path: "/v2/firewalls", Status: http.StatusForbidden})
+
+	inv, err := TakeInventory(context.Background(), client)
+	if inv != nil {
+		t.Errorf("TakeInventory returned an inventory despite an error")
+	}
+	var errResp *binarylane.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusForbidden {
+		t.Fatalf("TakeInventory returned %v, expected the 403 response", err)
+	}
+	if !strings.HasPrefix(err.Error(), "listing firewalls: ") {
+		t.Errorf("TakeInventory returned %q, expected it to name the failed list", err)
+	}
+}
+
+func containsLink(links []InventoryLink, l InventoryLink) bool {
+	for _, x := range links {
+		if x == l {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,369 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+const defaultRollbackTimeout = 5 * time.Minute
+
+// ProvisionSpec describes a server to create and the resources to attach to
+// it once it is active.
+type ProvisionSpec struct {
+	// Server is the request used to create the server.
+	Server *binarylane.ServerCreateRequest
+
+	// FloatingIP is an existing floating IP to assign to the server.
+	FloatingIP string
+
+	// CreateFloatingIP creates a new floating IP in the server's region and
+	// assigns it to the server. It cannot be used with FloatingIP.
+	CreateFloatingIP bool
+
+	// FirewallIDs are the firewalls to add the server to.
+	FirewallIDs []string
+
+	// LoadBalancerIDs are the load balancers to add the server to.
+	LoadBalancerIDs []int
+
+	// Tags are applied to the server, creating any that do not exist.
+	Tags []string
+
+	// ProjectID is the project to assign the server to.
+	ProjectID string
+}
+
+// ProvisionStep is the outcome of one step of provisioning or rolling back.
+type ProvisionStep struct {
+	Name string
+	Err  error
+}
+
+// ProvisionError is returned by Provision when a step fails. Steps lists
+// every step that was attempted in order, ending with the one that failed,
+// and Rollback lists the steps taken to undo them.
+type ProvisionError struct {
+	Steps    []ProvisionStep
+	Rollback []ProvisionStep
+}
+
+func (e *ProvisionError) Error() string {
+	failed := e.Steps[len(e.Steps)-1]
+	msg := fmt.Sprintf("provisioning failed at %s: %v", failed.Name, failed.Err)
+
+	var rollbackErrs []string
+	for _, step := range e.Rollback {
+		if step.Err != nil {
+			rollbackErrs = append(rollbackErrs, fmt.Sprintf("%s: %v", step.Name, step.Err))
+		}
+	}
+	switch {
+	case len(e.Rollback) == 0:
+	case len(rollbackErrs) == 0:
+		msg += fmt.Sprintf(" (rolled back %d steps)", len(e.Rollback))
+	default:
+		msg += fmt.Sprintf(" (rollback failed: %s)", strings.Join(rollbackErrs, "; "))
+	}
+	return msg
+}
+
+// Unwrap returns the error of the step that failed.
+func (e *ProvisionError) Unwrap() error {
+	return e.Steps[len(e.Steps)-1].Err
+}
+
+// Provisioner creates servers and attaches resources to them, undoing its
+// work if any step fails.
+type Provisioner struct {
+	client *binarylane.Client
+
+	// Waiter waits for the actions started by each step.
+	Waiter *Waiter
+
+	// RollbackTimeout limits how long rolling back may take. Rollback does
+	// not use the context passed to Provision, so that it still runs when
+	// that context is cancelled. Defaults to 5 minutes.
+	RollbackTimeout time.Duration
+
+	// DisableRollback leaves the resources created so far in place when a
+	// step fails, for example to debug the failure.
+	DisableRollback bool
+}
+
+// NewProvisioner returns a Provisioner using client with default settings.
+func NewProvisioner(client *binarylane.Client) *Provisioner {
+	return &Provisioner{
+		client:          client,
+		Waiter:          NewWaiter(client),
+		RollbackTimeout: defaultRollbackTimeout,
+	}
+}
+
+// Provision creates a server with the default Provisioner. See
+// Provisioner.Provision.
+func Provision(ctx context.Context, client *binarylane.Client, spec *ProvisionSpec) (*binarylane.Server, error) {
+	return NewProvisioner(client).Provision(ctx, spec)
+}
+
+// provisioning tracks the progress of a single call to Provision.
+type provisioning struct {
+	steps []ProvisionStep
+	undo  []undoStep
+}
+
+type undoStep struct {
+	name string
+	fn   func(context.Context) error
+}
+
+// run runs a step, recording its outcome.
+func (p *provisioning) run(name string, fn func() error) error {
+	err := fn()
+	p.steps = append(p.steps, ProvisionStep{Name: name, Err: err})
+	return err
+}
+
+// onRollback records how to undo a completed step.
+func (p *provisioning) onRollback(name string, fn func(context.Context) error) {
+	p.undo = append(p.undo, undoStep{name: name, fn: fn})
+}
+
+// Provision creates the server described by spec, waits for it to become
+// active and then attaches the floating IP, firewalls, load balancers, tags
+// and project, waiting for any actions they start. It returns the server as
+// it is once every step has completed.
+//
+// If a step fails, the steps already completed are undone in reverse order,
+// deleting the server and any floating IP or tags that were created, and a
+// *ProvisionError describing each step is returned.
+func (p *Provisioner) Provision(ctx context.Context, spec *ProvisionSpec) (*binarylane.Server, error) {
+	if spec == nil || spec.Server == nil {
+		return nil, binarylane.NewArgError("spec.Server", "cannot be nil")
+	}
+	if spec.FloatingIP != "" && spec.CreateFloatingIP {
+		return nil, binarylane.NewArgError("spec.CreateFloatingIP", "cannot be used with FloatingIP")
+	}
+
+	prov := &provisioning{}
+	server, err := p.provision(ctx, prov, spec)
+	if err == nil {
+		return server, nil
+	}
+
+	provErr := &ProvisionError{Steps: prov.steps}
+	if !p.DisableRollback {
+		provErr.Rollback = p.rollback(prov)
+	}
+	return nil, provErr
+}
+
+func (p *Provisioner) provision(ctx context.Context, prov *provisioning, spec *ProvisionSpec) (*binarylane.Server, error) {
+	c := p.client
+	var server *binarylane.Server
+
+	err := prov.run("create server", func() error {
+		var resp *binarylane.Response
+		var err error
+		server, resp, err = c.Servers.Create(ctx, spec.Server)
+		if err != nil {
+			return err
+		}
+
+		id := server.ID
+		prov.onRollback("delete server", func(ctx context.Context) error {
+			_, err := c.Servers.Delete(ctx, id)
+			return err
+		})
+		return p.waitForLinks(ctx, resp)
+	})
+	if err != nil {
+		return nil, err
+	}
+	serverID := server.ID
+
+	if spec.FloatingIP != "" {
+		err := prov.run("assign floating IP "+spec.FloatingIP, func() error {
+			action, _, err := c.FloatingIPActions.Assign(ctx, spec.FloatingIP, serverID)
+			if err != nil {
+				return err
+			}
+
+			prov.onRollback("unassign floating IP "+spec.FloatingIP, func(ctx context.Context) error {
+				_, _, err := c.FloatingIPActions.Unassign(ctx, spec.FloatingIP)
+				return err
+			})
+			_, err = p.Waiter.Wait(ctx, action)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if spec.CreateFloatingIP {
+		err := prov.run("create floating IP", func() error {
+			fip, resp, err := c.FloatingIPs.Create(ctx, &binarylane.FloatingIPCreateRequest{ServerID: serverID})
+			if err != nil {
+				return err
+			}
+
+			ip := fip.IP
+			prov.onRollback("delete floating IP "+ip, func(ctx context.Context) error {
+				_, err := c.FloatingIPs.Delete(ctx, ip)
+				return err
+			})
+			return p.waitForLinks(ctx, resp)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, fwID := range spec.FirewallIDs {
+		fwID := fwID
+		err := prov.run("add to firewall "+fwID, func() error {
+			if _, err := c.Firewalls.AddServers(ctx, fwID, serverID); err != nil {
+				return err
+			}
+
+			prov.onRollback("remove from firewall "+fwID, func(ctx context.Context) error {
+				_, err := c.Firewalls.RemoveServers(ctx, fwID, serverID)
+				return err
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, lbID := range spec.LoadBalancerIDs {
+		lbID := lbID
+		name := "load balancer " + strconv.Itoa(lbID)
+		err := prov.run("add to "+name, func() error {
+			if _, err := c.LoadBalancers.AddServers(ctx, lbID, serverID); err != nil {
+				return err
+			}
+
+			prov.onRollback("remove from "+name, func(ctx context.Context) error {
+				_, err := c.LoadBalancers.RemoveServers(ctx, lbID, serverID)
+				return err
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resource := binarylane.Resource{ID: strconv.Itoa(serverID), Type: binarylane.ServerResourceType}
+	for _, tag := range spec.Tags {
+		tag := tag
+		err := prov.run("tag "+tag, func() error {
+			if err := p.ensureTag(ctx, prov, tag); err != nil {
+				return err
+			}
+
+			req := &binarylane.TagResourcesRequest{Resources: []binarylane.Resource{resource}}
+			if _, err := c.Tags.TagResources(ctx, tag, req); err != nil {
+				return err
+			}
+
+			prov.onRollback("untag "+tag, func(ctx context.Context) error {
+				req := &binarylane.UntagResourcesRequest{Resources: []binarylane.Resource{resource}}
+				_, err := c.Tags.UntagResources(ctx, tag, req)
+				return err
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if spec.ProjectID != "" {
+		// Deleting the server removes it from the project, so this step has
+		// nothing to undo.
+		err := prov.run("assign to project "+spec.ProjectID, func() error {
+			_, _, err := c.Projects.AssignResources(ctx, spec.ProjectID, server)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = prov.run("get server", func() error {
+		var err error
+		server, _, err = c.Servers.Get(ctx, serverID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return server, nil
+}
+
+// ensureTag creates tag if it does not exist, arranging for it to be deleted
+// on rollback.
+func (p *Provisioner) ensureTag(ctx context.Context, prov *provisioning, tag string) error {
+	_, _, err := p.client.Tags.Get(ctx, tag)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, binarylane.ErrNotFound) {
+		return err
+	}
+
+	if _, _, err := p.client.Tags.Create(ctx, &binarylane.TagCreateRequest{Name: tag}); err != nil {
+		return err
+	}
+	prov.onRollback("delete tag "+tag, func(ctx context.Context) error {
+		_, err := p.client.Tags.Delete(ctx, tag)
+		return err
+	})
+	return nil
+}
+
+// waitForLinks waits for the actions linked from a response to complete.
+func (p *Provisioner) waitForLinks(ctx context.Context, resp *binarylane.Response) error {
+	if resp.Links == nil {
+		return nil
+	}
+	for _, la := range resp.Links.Actions {
+		if _, err := p.Waiter.WaitForLinkAction(ctx, la); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rollback undoes the completed steps of prov in reverse order, continuing
+// past failures so that as much as possible is cleaned up.
+func (p *Provisioner) rollback(prov *provisioning) []ProvisionStep {
+	timeout := p.RollbackTimeout
+	if timeout <= 0 {
+		timeout = defaultRollbackTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var steps []ProvisionStep
+	for i := len(prov.undo) - 1; i >= 0; i-- {
+		undo := prov.undo[i]
+		err := undo.fn(ctx)
+		// Resources removed along with the server are already gone.
+		if errors.Is(err, binarylane.ErrNotFound) {
+			err = nil
+		}
+		steps = append(steps, ProvisionStep{Name: undo.name, Err: err})
+	}
+	return steps
+}
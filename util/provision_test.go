@@ -0,0 +1,180 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+	"github.com/binarylane/go-binarylane/binarylanetest"
+)
+
+// setup starts a fake API, which is closed when the test ends, and returns
+// it along with a client for it.
+func setup(t *testing.T, opts ...binarylanetest.Option) (*binarylanetest.Server, *binarylane.Client) {
+	fake := binarylanetest.NewServer(opts...)
+	t.Cleanup(fake.Close)
+
+	client, err := fake.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fake, client
+}
+
+func newTestProvisioner(t *testing.T) (*Provisioner, *binarylane.Client) {
+	_, client := setup(t)
+	p := NewProvisioner(client)
+	p.Waiter.Interval = time.Millisecond
+	return p, client
+}
+
+var provisionServer = &binarylane.ServerCreateRequest{
+	Name:   "web",
+	Region: "syd",
+	Size:   "std-min",
+	Image:  binarylane.ServerCreateImage{Slug: "ubuntu-20.04"},
+}
+
+func TestProvision(t *testing.T) {
+	ctx := context.Background()
+	p, client := newTestProvisioner(t)
+
+	fw, _, err := client.Firewalls.Create(ctx, &binarylane.FirewallRequest{Name: "web"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lb, _, err := client.LoadBalancers.Create(ctx, &binarylane.LoadBalancerRequest{
+		Name:            "lb",
+		Region:          "syd",
+		ForwardingRules: []binarylane.ForwardingRule{{EntryProtocol: "http", EntryPort: 80, TargetProtocol: "http", TargetPort: 80}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server, err := p.Provision(ctx, &ProvisionSpec{
+		Server:           provisionServer,
+		CreateFloatingIP: true,
+		FirewallIDs:      []string{fw.ID},
+		LoadBalancerIDs:  []int{lb.ID},
+		Tags:             []string{"frontend"},
+	})
+	if err != nil {
+		t.Fatalf("Provision returned error: %v", err)
+	}
+	if server.Status != "active" || len(server.Tags) != 1 || server.Tags[0] != "frontend" {
+		t.Errorf("Provision returned %+v", server)
+	}
+
+	fips, err := client.FloatingIPs.ListAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fips) != 1 || fips[0].Server == nil || fips[0].Server.ID != server.ID {
+		t.Errorf("Floating IPs = %+v, expected one assigned to server %d", fips, server.ID)
+	}
+	fw, _, err = client.Firewalls.Get(ctx, fw.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fw.ServerIDs) != 1 || fw.ServerIDs[0] != server.ID {
+		t.Errorf("Firewall servers = %v, expected [%d]", fw.ServerIDs, server.ID)
+	}
+	lb, _, err = client.LoadBalancers.Get(ctx, lb.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lb.ServerIDs) != 1 || lb.ServerIDs[0] != server.ID {
+		t.Errorf("Load balancer servers = %v, expected [%d]", lb.ServerIDs, server.ID)
+	}
+}
+
+func TestProvision_rollback(t *testing.T) {
+	ctx := context.Background()
+	p, client := newTestProvisioner(t)
+
+	_, err := p.Provision(ctx, &ProvisionSpec{
+		Server:           provisionServer,
+		CreateFloatingIP: true,
+		Tags:             []string{"frontend"},
+		FirewallIDs:      []string{"missing"},
+	})
+
+	var provErr *ProvisionError
+	if !errors.As(err, &provErr) {
+		t.Fatalf("Provision returned %v, expected a *ProvisionError", err)
+	}
+	if !errors.Is(err, binarylane.ErrNotFound) {
+		t.Errorf("Provision error %v does not unwrap to the failed step's error", err)
+	}
+
+	var steps []string
+	for _, step := range provErr.Steps {
+		steps = append(steps, step.Name)
+	}
+	expected := []string{"create server", "create floating IP", "add to firewall missing"}
+	if !reflect.DeepEqual(steps, expected) {
+		t.Errorf("Provision steps = %q, expected %q", steps, expected)
+	}
+	for _, step := range provErr.Rollback {
+		if step.Err != nil {
+			t.Errorf("Rollback step %s failed: %v", step.Name, step.Err)
+		}
+	}
+
+	servers, err := client.Servers.ListAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fips, err := client.FloatingIPs.ListAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(servers) != 0 || len(fips) != 0 {
+		t.Errorf("Rollback left servers %+v and floating IPs %+v", servers, fips)
+	}
+}
+
+func TestProvision_rollbackTags(t *testing.T) {
+	ctx := context.Background()
+	p, client := newTestProvisioner(t)
+
+	_, err := p.Provision(ctx, &ProvisionSpec{
+		Server:    provisionServer,
+		Tags:      []string{"frontend"},
+		ProjectID: "missing",
+	})
+	if err == nil {
+		t.Fatal("Expected error assigning to a missing project")
+	}
+
+	tags, err := client.Tags.ListAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 0 {
+		t.Errorf("Rollback left tags %+v", tags)
+	}
+}
+
+func TestProvision_disableRollback(t *testing.T) {
+	ctx := context.Background()
+	p, client := newTestProvisioner(t)
+	p.DisableRollback = true
+
+	_, err := p.Provision(ctx, &ProvisionSpec{Server: provisionServer, FirewallIDs: []string{"missing"}})
+	if err == nil {
+		t.Fatal("Expected error adding to a missing firewall")
+	}
+
+	servers, err := client.Servers.ListAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(servers) != 1 {
+		t.Errorf("Servers = %+v, expected the server to be left in place", servers)
+	}
+}
@@ -0,0 +1,305 @@
+package util
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+const (
+	defaultHealthTimeout   = 5 * time.Minute
+	defaultHealthInterval  = 10 * time.Second
+	defaultProbeTimeout    = 5 * time.Second
+	defaultHealthThreshold = 3
+)
+
+// HealthProbe checks once whether a server is healthy according to a load
+// balancer health check, returning an error if it is not.
+type HealthProbe func(ctx context.Context, server *binarylane.Server, check *binarylane.HealthCheck) error
+
+// RollingUpdate applies an action to each server behind a load balancer in
+// turn. Each server is taken out of the load balancer, updated, checked for
+// health and then put back, so that the load balancer keeps serving traffic
+// throughout.
+type RollingUpdate struct {
+	client *binarylane.Client
+
+	// Waiter waits for the action run on each server.
+	Waiter *Waiter
+
+	// MaxUnavailable is the number of servers out of the load balancer at
+	// once. Defaults to 1.
+	MaxUnavailable int
+
+	// Pause is how long to wait after a server is back in the load balancer
+	// before starting on the next.
+	Pause time.Duration
+
+	// AbortOnFailure stops updating servers once one has failed, leaving the
+	// failed server out of the load balancer. Otherwise a server that fails
+	// is put back in the load balancer, whose own health check decides
+	// whether it receives traffic, and the update moves on to the next.
+	AbortOnFailure bool
+
+	// HealthTimeout is how long to wait for an updated server to become
+	// healthy. Defaults to 5 minutes.
+	HealthTimeout time.Duration
+
+	// HealthInterval is the delay between health probes. Defaults to the load
+	// balancer's check interval, or 10 seconds.
+	HealthInterval time.Duration
+
+	// Probe checks the health of a server. Defaults to an HTTP or TCP check
+	// made from the client against the port and path in the load balancer's
+	// health check.
+	Probe HealthProbe
+
+	// ProbePrivateIP probes the server's private address rather than its
+	// public one, for load balancers that reach servers over a VPC.
+	ProbePrivateIP bool
+
+	// ProbeTLSConfig configures the default Probe for HTTPS health checks.
+	// Servers are probed by IP address, so by default, like the load
+	// balancer's own check, the certificate is not verified. To verify it,
+	// set ServerName to the name it was issued for.
+	ProbeTLSConfig *tls.Config
+}
+
+// NewRollingUpdate returns a RollingUpdate using client that updates one
+// server at a time and aborts on the first failure.
+func NewRollingUpdate(client *binarylane.Client) *RollingUpdate {
+	return &RollingUpdate{
+		client:         client,
+		Waiter:         NewWaiter(client),
+		MaxUnavailable: 1,
+		AbortOnFailure: true,
+		HealthTimeout:  defaultHealthTimeout,
+	}
+}
+
+// Run updates each server behind the load balancer lbID with action, such as
+// RebuildAction or ResizeAction. It returns a report with the result for
+// every server; if any server failed or was skipped, the error is a
+// *BatchError holding the same report.
+func (u *RollingUpdate) Run(ctx context.Context, lbID int, action ServerActionFunc) (*BatchReport, error) {
+	lb, _, err := u.client.LoadBalancers.Get(ctx, lbID)
+	if err != nil {
+		return nil, err
+	}
+
+	check := healthCheckFor(lb)
+	batch := &Batch{
+		client:      u.client,
+		Parallelism: u.MaxUnavailable,
+		StopOnError: u.AbortOnFailure,
+	}
+	if batch.Parallelism <= 0 {
+		batch.Parallelism = 1
+	}
+
+	return batch.Run(ctx, lb.ServerIDs, func(ctx context.Context, serverID int) (*binarylane.Action, *binarylane.Response, error) {
+		a, err := u.updateServer(ctx, lbID, serverID, check, action)
+		return a, nil, err
+	})
+}
+
+// updateServer takes one server out of the load balancer, updates it and
+// puts it back once it is healthy.
+func (u *RollingUpdate) updateServer(ctx context.Context, lbID, serverID int, check *binarylane.HealthCheck, action ServerActionFunc) (*binarylane.Action, error) {
+	if _, err := u.client.LoadBalancers.RemoveServers(ctx, lbID, serverID); err != nil {
+		return nil, fmt.Errorf("removing from load balancer: %w", err)
+	}
+
+	a, err := u.applyAction(ctx, serverID, check, action)
+	if err != nil && u.AbortOnFailure {
+		return a, err
+	}
+
+	if _, addErr := u.client.LoadBalancers.AddServers(ctx, lbID, serverID); addErr != nil {
+		if err != nil {
+			return a, fmt.Errorf("%w; adding back to load balancer: %v", err, addErr)
+		}
+		return a, fmt.Errorf("adding to load balancer: %w", addErr)
+	}
+	if err != nil {
+		return a, err
+	}
+
+	if u.Pause > 0 {
+		timer := time.NewTimer(u.Pause)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+		case <-timer.C:
+		}
+	}
+	return a, nil
+}
+
+// applyAction runs action on the server, waits for it to complete and then
+// for the server to become healthy.
+func (u *RollingUpdate) applyAction(ctx context.Context, serverID int, check *binarylane.HealthCheck, action ServerActionFunc) (*binarylane.Action, error) {
+	a, _, err := action(ctx, serverID)
+	if err != nil {
+		return nil, err
+	}
+	waiter := u.Waiter
+	if waiter == nil {
+		waiter = NewWaiter(u.client)
+	}
+	if a, err = waiter.Wait(ctx, a); err != nil {
+		return a, err
+	}
+
+	return a, u.waitHealthy(ctx, serverID, check)
+}
+
+// waitHealthy probes the server until it passes the health check the number
+// of times in a row given by the check's healthy threshold.
+func (u *RollingUpdate) waitHealthy(ctx context.Context, serverID int, check *binarylane.HealthCheck) error {
+	timeout := u.HealthTimeout
+	if timeout <= 0 {
+		timeout = defaultHealthTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	interval := u.HealthInterval
+	if interval <= 0 {
+		interval = time.Duration(check.CheckIntervalSeconds) * time.Second
+	}
+	threshold := check.HealthyThreshold
+	if threshold <= 0 {
+		threshold = defaultHealthThreshold
+	}
+	probe := u.Probe
+	if probe == nil {
+		client := newProbeClient(u.ProbeTLSConfig)
+		probe = func(ctx context.Context, server *binarylane.Server, check *binarylane.HealthCheck) error {
+			return u.probe(ctx, client, server, check)
+		}
+	}
+
+	server, _, err := u.client.Servers.Get(ctx, serverID)
+	if err != nil {
+		return err
+	}
+
+	passed := 0
+	for {
+		err := probe(ctx, server, check)
+		if err == nil {
+			passed++
+			if passed >= threshold {
+				return nil
+			}
+		} else {
+			passed = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			if err == nil {
+				err = ctx.Err()
+			}
+			return fmt.Errorf("server did not become healthy: %w", err)
+		case <-time.After(interval):
+		}
+	}
+}
+
+// probe is the default HealthProbe, making HTTP checks with client.
+func (u *RollingUpdate) probe(ctx context.Context, client *http.Client, server *binarylane.Server, check *binarylane.HealthCheck) error {
+	ip, err := server.PublicIPv4()
+	if u.ProbePrivateIP {
+		ip, err = server.PrivateIPv4()
+	}
+	if err != nil {
+		return err
+	}
+	if ip == "" {
+		return fmt.Errorf("server %d has no address to probe", server.ID)
+	}
+
+	return probeAddress(ctx, client, net.JoinHostPort(ip, strconv.Itoa(check.Port)), check)
+}
+
+// newProbeClient returns the HTTP client used by the default HealthProbe.
+// If tlsConfig is nil, certificates are not verified.
+func newProbeClient(tlsConfig *tls.Config) *http.Client {
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig:   tlsConfig,
+			DisableKeepAlives: true,
+		},
+	}
+}
+
+// probeAddress checks addr with an HTTP GET of the check's path using client,
+// succeeding on a 2xx or 3xx status, or for TCP checks by opening a
+// connection.
+func probeAddress(ctx context.Context, client *http.Client, addr string, check *binarylane.HealthCheck) error {
+	timeout := time.Duration(check.ResponseTimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = defaultProbeTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	protocol := strings.ToLower(check.Protocol)
+	switch protocol {
+	case "http", "https":
+	default:
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+
+	path := check.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	req, err := http.NewRequest(http.MethodGet, protocol+"://"+addr+path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 399 {
+		return fmt.Errorf("health check %s returned %s", req.URL, resp.Status)
+	}
+	return nil
+}
+
+// healthCheckFor returns the load balancer's health check, falling back to
+// a TCP check, and to the first forwarding rule's target port.
+func healthCheckFor(lb *binarylane.LoadBalancer) *binarylane.HealthCheck {
+	check := binarylane.HealthCheck{Protocol: "tcp"}
+	if lb.HealthCheck != nil {
+		check = *lb.HealthCheck
+	}
+	if check.Port == 0 && len(lb.ForwardingRules) > 0 {
+		check.Port = lb.ForwardingRules[0].TargetPort
+	}
+	if check.CheckIntervalSeconds <= 0 {
+		check.CheckIntervalSeconds = int(defaultHealthInterval / time.Second)
+	}
+	return &check
+}
@@ -0,0 +1,206 @@
+package util
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+func newTestRollingUpdate(t *testing.T, servers int) (*RollingUpdate, *binarylane.Client, *binarylane.LoadBalancer) {
+	b, _, client := newTestBatch(t)
+	ids := createServers(t, client, servers)
+
+	lb, _, err := client.LoadBalancers.Create(context.Background(), &binarylane.LoadBalancerRequest{
+		Name:            "lb",
+		Region:          "syd",
+		ForwardingRules: []binarylane.ForwardingRule{{EntryProtocol: "http", EntryPort: 80, TargetProtocol: "http", TargetPort: 8080}},
+		HealthCheck:     &binarylane.HealthCheck{Protocol: "http", Port: 8080, Path: "/health", HealthyThreshold: 2},
+		ServerIDs:       ids,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u := NewRollingUpdate(client)
+	u.Waiter = b.Waiter
+	u.HealthInterval = time.Millisecond
+	return u, client, lb
+}
+
+func TestRollingUpdate_Run(t *testing.T) {
+	ctx := context.Background()
+	u, client, lb := newTestRollingUpdate(t, 3)
+
+	var mu sync.Mutex
+	probes := make(map[int]int)
+	u.Probe = func(ctx context.Context, server *binarylane.Server, check *binarylane.HealthCheck) error {
+		if check.Path != "/health" || check.Port != 8080 {
+			t.Errorf("Probe called with health check %+v", check)
+		}
+		current, _, err := client.LoadBalancers.Get(ctx, lb.ID)
+		if err != nil {
+			return err
+		}
+		for _, id := range current.ServerIDs {
+			if id == server.ID {
+				t.Errorf("Server %d was probed while still in the load balancer", server.ID)
+			}
+		}
+		mu.Lock()
+		probes[server.ID]++
+		mu.Unlock()
+		return nil
+	}
+
+	report, err := u.Run(ctx, lb.ID, RebuildAction(client.ServerActions, "ubuntu-22.04"))
+	if err != nil {
+		t.Fatalf("RollingUpdate.Run returned error: %v", err)
+	}
+	if len(report.Succeeded()) != 3 {
+		t.Errorf("RollingUpdate.Run report = %+v", report)
+	}
+	for _, id := range lb.ServerIDs {
+		if probes[id] != 2 {
+			t.Errorf("Server %d was probed %d times, expected the healthy threshold of 2", id, probes[id])
+		}
+	}
+
+	after, _, err := client.LoadBalancers.Get(ctx, lb.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(after.ServerIDs) != 3 {
+		t.Errorf("Load balancer servers = %v after update, expected all 3", after.ServerIDs)
+	}
+}
+
+func TestRollingUpdate_RunAbortOnFailure(t *testing.T) {
+	ctx := context.Background()
+	u, client, lb := newTestRollingUpdate(t, 3)
+	u.HealthTimeout = 20 * time.Millisecond
+	unhealthy := errors.New("connection refused")
+	u.Probe = func(ctx context.Context, server *binarylane.Server, check *binarylane.HealthCheck) error {
+		return unhealthy
+	}
+
+	report, err := u.Run(ctx, lb.ID, client.ServerActions.Reboot)
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+
+	failed := report.Failed()
+	if len(failed) != 1 || failed[0].ServerID != lb.ServerIDs[0] || !errors.Is(failed[0].Err, unhealthy) {
+		t.Errorf("RollingUpdate.Run failed results = %+v", failed)
+	}
+	if len(report.Skipped()) != 2 {
+		t.Errorf("RollingUpdate.Run skipped results = %+v, expected 2", report.Skipped())
+	}
+
+	after, _, err := client.LoadBalancers.Get(ctx, lb.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(after.ServerIDs) != 2 {
+		t.Errorf("Load balancer servers = %v, expected the failed server to be left out", after.ServerIDs)
+	}
+}
+
+func TestRollingUpdate_RunContinueOnFailure(t *testing.T) {
+	ctx := context.Background()
+	u, client, lb := newTestRollingUpdate(t, 3)
+	u.AbortOnFailure = false
+	u.HealthTimeout = 20 * time.Millisecond
+	unhealthy := errors.New("connection refused")
+	u.Probe = func(ctx context.Context, server *binarylane.Server, check *binarylane.HealthCheck) error {
+		if server.ID == lb.ServerIDs[1] {
+			return unhealthy
+		}
+		return nil
+	}
+
+	report, err := u.Run(ctx, lb.ID, client.ServerActions.Reboot)
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+
+	failed := report.Failed()
+	if len(failed) != 1 || failed[0].ServerID != lb.ServerIDs[1] || !errors.Is(failed[0].Err, unhealthy) {
+		t.Errorf("RollingUpdate.Run failed results = %+v", failed)
+	}
+	if len(report.Succeeded()) != 2 {
+		t.Errorf("RollingUpdate.Run succeeded results = %+v, expected 2", report.Succeeded())
+	}
+
+	after, _, err := client.LoadBalancers.Get(ctx, lb.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(after.ServerIDs) != 3 {
+		t.Errorf("Load balancer servers = %v, expected the failed server to be put back", after.ServerIDs)
+	}
+}
+
+func TestProbeAddress(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer ts.Close()
+	addr := strings.TrimPrefix(ts.URL, "http://")
+	ctx := context.Background()
+	client := newProbeClient(nil)
+
+	if err := probeAddress(ctx, client, addr, &binarylane.HealthCheck{Protocol: "http", Path: "/health"}); err != nil {
+		t.Errorf("HTTP probe of a healthy server returned %v", err)
+	}
+	if err := probeAddress(ctx, client, addr, &binarylane.HealthCheck{Protocol: "http", Path: "/"}); err == nil {
+		t.Error("HTTP probe of an unhealthy server succeeded")
+	}
+	if err := probeAddress(ctx, client, addr, &binarylane.HealthCheck{Protocol: "tcp"}); err != nil {
+		t.Errorf("TCP probe of a listening port returned %v", err)
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed := l.Addr().String()
+	l.Close()
+	if err := probeAddress(ctx, client, closed, &binarylane.HealthCheck{Protocol: "tcp"}); err == nil {
+		t.Error("TCP probe of a closed port succeeded")
+	}
+}
+
+func TestProbeAddress_https(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+	addr := strings.TrimPrefix(ts.URL, "https://")
+	ctx := context.Background()
+	check := &binarylane.HealthCheck{Protocol: "https", Path: "/health"}
+
+	if err := probeAddress(ctx, newProbeClient(nil), addr, check); err != nil {
+		t.Errorf("HTTPS probe with the default configuration returned %v", err)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ts.Certificate())
+	verified := newProbeClient(&tls.Config{RootCAs: roots, ServerName: "example.com"})
+	if err := probeAddress(ctx, verified, addr, check); err != nil {
+		t.Errorf("HTTPS probe verifying the certificate for example.com returned %v", err)
+	}
+	mismatched := newProbeClient(&tls.Config{RootCAs: roots, ServerName: "other.example"})
+	if err := probeAddress(ctx, mismatched, addr, check); err == nil {
+		t.Error("HTTPS probe verifying the certificate for the wrong name succeeded")
+	}
+}
@@ -3,55 +3,18 @@ package util
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/binarylane/go-binarylane"
 )
 
-const (
-	// activeFailure is the amount of times we can fail before deciding
-	// the check for active is a total failure. This can help account
-	// for servers randomly not answering.
-	activeFailure = 3
-)
-
 // WaitForActive waits for a server to become active
+//
+// Deprecated: Use Waiter.WaitForURI, which also returns the final action.
 func WaitForActive(ctx context.Context, client *binarylane.Client, monitorURI string) error {
 	if len(monitorURI) == 0 {
 		return fmt.Errorf("create had no monitor uri")
 	}
 
-	completed := false
-	failCount := 0
-	for !completed {
-		action, _, err := client.ServerActions.GetByURI(ctx, monitorURI)
-
-		if err != nil {
-			select {
-			case <-ctx.Done():
-				return err
-			default:
-			}
-			if failCount <= activeFailure {
-				failCount++
-				continue
-			}
-			return err
-		}
-
-		switch action.Status {
-		case binarylane.ActionInProgress:
-			select {
-			case <-time.After(5 * time.Second):
-			case <-ctx.Done():
-				return err
-			}
-		case binarylane.ActionCompleted:
-			completed = true
-		default:
-			return fmt.Errorf("unknown status: [%s]", action.Status)
-		}
-	}
-
-	return nil
+	_, err := NewWaiter(client).WaitForURI(ctx, monitorURI)
+	return err
 }
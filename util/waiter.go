@@ -0,0 +1,212 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+const (
+	defaultWaitInterval    = 5 * time.Second
+	defaultWaitMaxFailures = 3
+)
+
+// ActionFailedError is returned when an action finishes with the errored
+// status.
+type ActionFailedError struct {
+	Action *binarylane.Action
+}
+
+func (e *ActionFailedError) Error() string {
+	return fmt.Sprintf("action %d (%s) on %s %d errored",
+		e.Action.ID, e.Action.Type, e.Action.ResourceType, e.Action.ResourceID)
+}
+
+// WaitAllError is returned by WaitAll when one or more actions did not
+// complete. Errors holds one entry per action passed to WaitAll, which is nil
+// for actions that completed.
+type WaitAllError struct {
+	Errors []error
+}
+
+func (e *WaitAllError) Error() string {
+	var msgs []string
+	for _, err := range e.Errors {
+		if err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+	return fmt.Sprintf("%d of %d actions failed: %s", len(msgs), len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// Waiter polls actions until they complete.
+type Waiter struct {
+	client *binarylane.Client
+
+	// Interval is the delay between polls. Defaults to 5 seconds.
+	Interval time.Duration
+
+	// Backoff multiplies the delay after each poll, up to MaxInterval.
+	// Values of 1 or less poll at a fixed Interval.
+	Backoff float64
+
+	// MaxInterval caps the delay between polls when Backoff is set.
+	MaxInterval time.Duration
+
+	// Timeout limits how long to wait for each action. Zero means wait
+	// until the context is done.
+	Timeout time.Duration
+
+	// MaxFailures is the number of consecutive failed polls tolerated before
+	// giving up. Defaults to 3.
+	MaxFailures int
+
+	// Progress, if set, is called with the latest state of an action after
+	// every poll. WaitAll may call it from several goroutines at once.
+	Progress func(*binarylane.Action)
+}
+
+// NewWaiter returns a Waiter using client with default settings.
+func NewWaiter(client *binarylane.Client) *Waiter {
+	return &Waiter{
+		client:      client,
+		Interval:    defaultWaitInterval,
+		MaxFailures: defaultWaitMaxFailures,
+	}
+}
+
+type pollFunc func(context.Context) (*binarylane.Action, *binarylane.Response, error)
+
+// Wait waits for action to complete, returning its final state.
+func (w *Waiter) Wait(ctx context.Context, action *binarylane.Action) (*binarylane.Action, error) {
+	if action == nil {
+		return nil, binarylane.NewArgError("action", "cannot be nil")
+	}
+
+	switch action.Status {
+	case binarylane.ActionCompleted:
+		return action, nil
+	case binarylane.ActionErrored:
+		return action, &ActionFailedError{Action: action}
+	}
+
+	return w.WaitForID(ctx, action.ID)
+}
+
+// WaitForID waits for the action with the given id to complete.
+func (w *Waiter) WaitForID(ctx context.Context, id int) (*binarylane.Action, error) {
+	return w.wait(ctx, func(ctx context.Context) (*binarylane.Action, *binarylane.Response, error) {
+		return w.client.Actions.Get(ctx, id)
+	})
+}
+
+// WaitForURI waits for the action at a monitor URI, such as
+// Response.Monitor, to complete.
+func (w *Waiter) WaitForURI(ctx context.Context, uri string) (*binarylane.Action, error) {
+	if len(uri) == 0 {
+		return nil, binarylane.NewArgError("uri", "cannot be empty")
+	}
+
+	return w.wait(ctx, func(ctx context.Context) (*binarylane.Action, *binarylane.Response, error) {
+		return w.client.ServerActions.GetByURI(ctx, uri)
+	})
+}
+
+// WaitForLinkAction waits for an action linked from a response to complete.
+func (w *Waiter) WaitForLinkAction(ctx context.Context, la binarylane.LinkAction) (*binarylane.Action, error) {
+	return w.wait(ctx, func(ctx context.Context) (*binarylane.Action, *binarylane.Response, error) {
+		return la.Get(ctx, w.client)
+	})
+}
+
+// WaitAll waits concurrently for every action to complete, such as those
+// returned by ServerActions.PowerOffByTag. The returned slice holds the final
+// state of each action in the same order. If any action fails, the error is
+// a *WaitAllError.
+func (w *Waiter) WaitAll(ctx context.Context, actions []binarylane.Action) ([]*binarylane.Action, error) {
+	var (
+		wg      sync.WaitGroup
+		results = make([]*binarylane.Action, len(actions))
+		errs    = make([]error, len(actions))
+		failed  bool
+	)
+
+	for i := range actions {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = w.Wait(ctx, &actions[i])
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			failed = true
+		}
+	}
+	if failed {
+		return results, &WaitAllError{Errors: errs}
+	}
+
+	return results, nil
+}
+
+func (w *Waiter) wait(ctx context.Context, poll pollFunc) (*binarylane.Action, error) {
+	if w.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.Timeout)
+		defer cancel()
+	}
+
+	interval := w.Interval
+	if interval <= 0 {
+		interval = defaultWaitInterval
+	}
+
+	failures := 0
+	for {
+		action, _, err := poll(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if failures >= w.MaxFailures {
+				return nil, err
+			}
+			failures++
+		} else {
+			failures = 0
+			if w.Progress != nil {
+				w.Progress(action)
+			}
+
+			switch action.Status {
+			case binarylane.ActionCompleted:
+				return action, nil
+			case binarylane.ActionErrored:
+				return action, &ActionFailedError{Action: action}
+			case binarylane.ActionInProgress:
+			default:
+				return action, fmt.Errorf("unknown status: [%s]", action.Status)
+			}
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return action, ctx.Err()
+		}
+
+		if w.Backoff > 1 {
+			interval = time.Duration(float64(interval) * w.Backoff)
+			if w.MaxInterval > 0 && interval > w.MaxInterval {
+				interval = w.MaxInterval
+			}
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/binarylane/go-binarylane"
+)
+
+// actionServer serves /v2/actions/{id}, reporting each action as in progress
+// until it has been polled the given number of times, then as final.
+type actionServer struct {
+	mu     sync.Mutex
+	polls  map[int]int
+	ready  map[int]int
+	final  map[int]string
+	server *httptest.Server
+}
+
+func newActionServer(t *testing.T) (*actionServer, *binarylane.Client) {
+	s := &actionServer{polls: map[int]int{}, ready: map[int]int{}, final: map[int]string{}}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var id int
+		if _, err := fmt.Sscanf(r.URL.Path, "/v2/actions/%d", &id); err != nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.polls[id]++
+		status := binarylane.ActionInProgress
+		if s.polls[id] >= s.ready[id] {
+			status = s.final[id]
+		}
+		fmt.Fprintf(w, `{"action":{"id":%d,"status":%q,"type":"reboot","resource_id":1,"resource_type":"server"}}`, id, status)
+	}))
+
+	client, err := binarylane.New(nil, binarylane.SetBaseURL(s.server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, client
+}
+
+func (s *actionServer) add(id, ready int, final string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ready[id] = ready
+	s.final[id] = final
+}
+
+func testWaiter(client *binarylane.Client) *Waiter {
+	w := NewWaiter(client)
+	w.Interval = time.Millisecond
+	return w
+}
+
+func TestWaiter_Wait(t *testing.T) {
+	s, client := newActionServer(t)
+	defer s.server.Close()
+	s.add(1, 3, binarylane.ActionCompleted)
+
+	var progress []string
+	w := testWaiter(client)
+	w.Progress = func(a *binarylane.Action) {
+		progress = append(progress, a.Status)
+	}
+
+	action, err := w.Wait(context.Background(), &binarylane.Action{ID: 1, Status: binarylane.ActionInProgress})
+	if err != nil {
+		t.Fatalf("Wait returned error: %v", err)
+	}
+	if action.Status != binarylane.ActionCompleted {
+		t.Errorf("Wait returned status %q, expected %q", action.Status, binarylane.ActionCompleted)
+	}
+
+	expected := []string{binarylane.ActionInProgress, binarylane.ActionInProgress, binarylane.ActionCompleted}
+	if strings.Join(progress, ",") != strings.Join(expected, ",") {
+		t.Errorf("Progress reported %v, expected %v", progress, expected)
+	}
+}
+
+func TestWaiter_WaitAlreadyCompleted(t *testing.T) {
+	w := testWaiter(nil)
+	action := &binarylane.Action{ID: 1, Status: binarylane.ActionCompleted}
+
+	got, err := w.Wait(context.Background(), action)
+	if err != nil || got != action {
+		t.Errorf("Wait returned %v, %v; expected the completed action", got, err)
+	}
+}
+
+func TestWaiter_WaitErrored(t *testing.T) {
+	s, client := newActionServer(t)
+	defer s.server.Close()
+	s.add(1, 2, binarylane.ActionErrored)
+
+	action, err := testWaiter(client).WaitForID(context.Background(), 1)
+
+	var failed *ActionFailedError
+	if !errors.As(err, &failed) {
+		t.Fatalf("Expected *ActionFailedError, got %#v", err)
+	}
+	if failed.Action.ID != 1 || action.Status != binarylane.ActionErrored {
+		t.Errorf("Unexpected failed action %+v", failed.Action)
+	}
+}
+
+func TestWaiter_WaitForURI(t *testing.T) {
+	s, client := newActionServer(t)
+	defer s.server.Close()
+	s.add(7, 1, binarylane.ActionCompleted)
+
+	action, err := testWaiter(client).WaitForURI(context.Background(), s.server.URL+"/v2/actions/7")
+	if err != nil {
+		t.Fatalf("WaitForURI returned error: %v", err)
+	}
+	if action.ID != 7 {
+		t.Errorf("WaitForURI returned action %d, expected 7", action.ID)
+	}
+}
+
+func TestWaiter_WaitForURIEmpty(t *testing.T) {
+	if _, err := testWaiter(nil).WaitForURI(context.Background(), ""); err == nil {
+		t.Error("Expected error for empty monitor URI")
+	}
+}
+
+func TestWaiter_WaitForLinkAction(t *testing.T) {
+	s, client := newActionServer(t)
+	defer s.server.Close()
+	s.add(3, 1, binarylane.ActionCompleted)
+
+	action, err := testWaiter(client).WaitForLinkAction(context.Background(), binarylane.LinkAction{ID: 3})
+	if err != nil {
+		t.Fatalf("WaitForLinkAction returned error: %v", err)
+	}
+	if action.ID != 3 {
+		t.Errorf("WaitForLinkAction returned action %d, expected 3", action.ID)
+	}
+}
+
+func TestWaiter_Timeout(t *testing.T) {
+	s, client := newActionServer(t)
+	defer s.server.Close()
+	s.add(1, 1<<30, binarylane.ActionCompleted)
+
+	w := testWaiter(client)
+	w.Timeout = 20 * time.Millisecond
+
+	if _, err := w.WaitForID(context.Background(), 1); err != context.DeadlineExceeded {
+		t.Errorf("WaitForID returned %v, expected %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestWaiter_Backoff(t *testing.T) {
+	s, client := newActionServer(t)
+	defer s.server.Close()
+	s.add(1, 4, binarylane.ActionCompleted)
+
+	w := testWaiter(client)
+	w.Interval = 10 * time.Millisecond
+	w.Backoff = 2
+	w.MaxInterval = 25 * time.Millisecond
+
+	start := time.Now()
+	if _, err := w.WaitForID(context.Background(), 1); err != nil {
+		t.Fatalf("WaitForID returned error: %v", err)
+	}
+
+	// Delays of 10ms, 20ms and 25ms (capped) between the four polls.
+	if elapsed := time.Since(start); elapsed < 55*time.Millisecond {
+		t.Errorf("WaitForID took %v, expected at least 55ms", elapsed)
+	}
+}
+
+func TestWaiter_WaitAll(t *testing.T) {
+	s, client := newActionServer(t)
+	defer s.server.Close()
+	s.add(1, 2, binarylane.ActionCompleted)
+	s.add(2, 3, binarylane.ActionErrored)
+	s.add(3, 1, binarylane.ActionCompleted)
+
+	actions := []binarylane.Action{{ID: 1}, {ID: 2}, {ID: 3}}
+	results, err := testWaiter(client).WaitAll(context.Background(), actions)
+
+	var waitErr *WaitAllError
+	if !errors.As(err, &waitErr) {
+		t.Fatalf("Expected *WaitAllError, got %#v", err)
+	}
+	if waitErr.Errors[0] != nil || waitErr.Errors[1] == nil || waitErr.Errors[2] != nil {
+		t.Errorf("Unexpected errors %v", waitErr.Errors)
+	}
+	for i, a := range results {
+		if a == nil || a.ID != actions[i].ID {
+			t.Errorf("Result %d = %+v, expected action %d", i, a, actions[i].ID)
+		}
+	}
+}
+
+func ExampleWaiter_WaitAll() {
+	client := binarylane.NewFromToken("mytoken")
+	ctx := context.TODO()
+
+	// power off every server tagged "web"
+	actions, _, err := client.ServerActions.PowerOffByTag(ctx, "web")
+	if err != nil {
+		panic(err)
+	}
+
+	// block until every server has powered off
+	w := NewWaiter(client)
+	w.Timeout = 10 * time.Minute
+	if _, err := w.WaitAll(ctx, actions); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,137 @@
+package binarylane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// VolumeActionsService is an interface for interfacing with the volume
+// actions endpoints of the BinaryLane API.
+// See: https://api.binarylane.com.au/reference#volume-actions
+type VolumeActionsService interface {
+	Attach(ctx context.Context, volumeID string, serverID int) (*Action, *Response, error)
+	Detach(ctx context.Context, volumeID string, serverID int) (*Action, *Response, error)
+	Resize(ctx context.Context, volumeID string, sizeGigabytes int, regionSlug string) (*Action, *Response, error)
+	Get(ctx context.Context, volumeID string, actionID int) (*Action, *Response, error)
+	List(ctx context.Context, volumeID string, opt *ListOptions) ([]Action, *Response, error)
+}
+
+// VolumeActionsServiceOp handles communication with the volume action
+// related methods of the BinaryLane API.
+type VolumeActionsServiceOp struct {
+	client *Client
+}
+
+var _ VolumeActionsService = &VolumeActionsServiceOp{}
+
+// Attach a volume to a server.
+func (s *VolumeActionsServiceOp) Attach(ctx context.Context, volumeID string, serverID int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "VolumeActions.Attach")
+	request := &ActionRequest{
+		"type":      "attach",
+		"server_id": serverID,
+	}
+	return s.doAction(ctx, volumeID, request)
+}
+
+// Detach a volume from a server.
+func (s *VolumeActionsServiceOp) Detach(ctx context.Context, volumeID string, serverID int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "VolumeActions.Detach")
+	request := &ActionRequest{
+		"type":      "detach",
+		"server_id": serverID,
+	}
+	return s.doAction(ctx, volumeID, request)
+}
+
+// Resize a volume.
+func (s *VolumeActionsServiceOp) Resize(ctx context.Context, volumeID string, sizeGigabytes int, regionSlug string) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "VolumeActions.Resize")
+	request := &ActionRequest{
+		"type":           "resize",
+		"size_gigabytes": sizeGigabytes,
+		"region":         regionSlug,
+	}
+	return s.doAction(ctx, volumeID, request)
+}
+
+// Get an action for a particular volume by id.
+func (s *VolumeActionsServiceOp) Get(ctx context.Context, volumeID string, actionID int) (*Action, *Response, error) {
+	ctx = withOperation(ctx, "VolumeActions.Get")
+	if actionID < 1 {
+		return nil, nil, NewArgError("actionID", "cannot be less than 1")
+	}
+
+	path := fmt.Sprintf("%s/%d", volumeActionPath(volumeID), actionID)
+	return s.get(ctx, path)
+}
+
+// List the actions for a particular volume.
+func (s *VolumeActionsServiceOp) List(ctx context.Context, volumeID string, opt *ListOptions) ([]Action, *Response, error) {
+	ctx = withOperation(ctx, "VolumeActions.List")
+	path := volumeActionPath(volumeID)
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.list(ctx, path)
+}
+
+func (s *VolumeActionsServiceOp) doAction(ctx context.Context, volumeID string, request *ActionRequest) (*Action, *Response, error) {
+	path := volumeActionPath(volumeID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(actionRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Event, resp, err
+}
+
+func (s *VolumeActionsServiceOp) get(ctx context.Context, path string) (*Action, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(actionRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Event, resp, err
+}
+
+func (s *VolumeActionsServiceOp) list(ctx context.Context, path string) ([]Action, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(actionsRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+	if m := root.Meta; m != nil {
+		resp.Meta = m
+	}
+
+	return root.Actions, resp, err
+}
+
+func volumeActionPath(volumeID string) string {
+	return fmt.Sprintf("%s/%s/actions", volumesBasePath, volumeID)
+}
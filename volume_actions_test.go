@@ -0,0 +1,196 @@
+package binarylane
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestVolumeActions_Attach(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attachRequest := &ActionRequest{
+		"server_id": float64(12345), // encoding/json decodes numbers as floats
+		"type":      "attach",
+	}
+
+	mux.HandleFunc("/v2/volumes/v1/actions", func(w http.ResponseWriter, r *http.Request) {
+		v := new(ActionRequest)
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("decode json: %v", err)
+		}
+
+		testMethod(t, r, http.MethodPost)
+		if !reflect.DeepEqual(v, attachRequest) {
+			t.Errorf("Request body = %#v, expected %#v", v, attachRequest)
+		}
+
+		fmt.Fprintf(w, `{"action":{"status":"in-progress"}}`)
+	})
+
+	action, _, err := client.VolumeActions.Attach(ctx, "v1", 12345)
+	if err != nil {
+		t.Errorf("VolumeActions.Attach returned error: %v", err)
+	}
+
+	expected := &Action{Status: "in-progress"}
+	if !reflect.DeepEqual(action, expected) {
+		t.Errorf("VolumeActions.Attach returned %+v, expected %+v", action, expected)
+	}
+}
+
+func TestVolumeActions_Detach(t *testing.T) {
+	setup()
+	defer teardown()
+
+	detachRequest := &ActionRequest{
+		"server_id": float64(12345),
+		"type":      "detach",
+	}
+
+	mux.HandleFunc("/v2/volumes/v1/actions", func(w http.ResponseWriter, r *http.Request) {
+		v := new(ActionRequest)
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("decode json: %v", err)
+		}
+
+		testMethod(t, r, http.MethodPost)
+		if !reflect.DeepEqual(v, detachRequest) {
+			t.Errorf("Request body = %#v, expected %#v", v, detachRequest)
+		}
+
+		fmt.Fprintf(w, `{"action":{"status":"in-progress"}}`)
+	})
+
+	action, _, err := client.VolumeActions.Detach(ctx, "v1", 12345)
+	if err != nil {
+		t.Errorf("VolumeActions.Detach returned error: %v", err)
+	}
+
+	expected := &Action{Status: "in-progress"}
+	if !reflect.DeepEqual(action, expected) {
+		t.Errorf("VolumeActions.Detach returned %+v, expected %+v", action, expected)
+	}
+}
+
+func TestVolumeActions_Resize(t *testing.T) {
+	setup()
+	defer teardown()
+
+	resizeRequest := &ActionRequest{
+		"size_gigabytes": float64(100),
+		"region":         "syd",
+		"type":           "resize",
+	}
+
+	mux.HandleFunc("/v2/volumes/v1/actions", func(w http.ResponseWriter, r *http.Request) {
+		v := new(ActionRequest)
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatalf("decode json: %v", err)
+		}
+
+		testMethod(t, r, http.MethodPost)
+		if !reflect.DeepEqual(v, resizeRequest) {
+			t.Errorf("Request body = %#v, expected %#v", v, resizeRequest)
+		}
+
+		fmt.Fprintf(w, `{"action":{"status":"in-progress"}}`)
+	})
+
+	action, _, err := client.VolumeActions.Resize(ctx, "v1", 100, "syd")
+	if err != nil {
+		t.Errorf("VolumeActions.Resize returned error: %v", err)
+	}
+
+	expected := &Action{Status: "in-progress"}
+	if !reflect.DeepEqual(action, expected) {
+		t.Errorf("VolumeActions.Resize returned %+v, expected %+v", action, expected)
+	}
+}
+
+func TestVolumeActions_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes/v1/actions/456", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprintf(w, `{"action":{"status":"in-progress"}}`)
+	})
+
+	action, _, err := client.VolumeActions.Get(ctx, "v1", 456)
+	if err != nil {
+		t.Errorf("VolumeActions.Get returned error: %v", err)
+	}
+
+	expected := &Action{Status: "in-progress"}
+	if !reflect.DeepEqual(action, expected) {
+		t.Errorf("VolumeActions.Get returned %+v, expected %+v", action, expected)
+	}
+}
+
+func TestVolumeActions_GetInvalidID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, _, err := client.VolumeActions.Get(ctx, "v1", 0)
+	if _, ok := err.(*ArgError); !ok {
+		t.Errorf("Expected *ArgError, got %#v", err)
+	}
+}
+
+func TestVolumeActions_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes/v1/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprintf(w, `{"actions":[{"status":"in-progress"}]}`)
+	})
+
+	actions, _, err := client.VolumeActions.List(ctx, "v1", nil)
+	if err != nil {
+		t.Errorf("VolumeActions.List returned error: %v", err)
+	}
+
+	expected := []Action{{Status: "in-progress"}}
+	if !reflect.DeepEqual(actions, expected) {
+		t.Errorf("VolumeActions.List returned %+v, expected %+v", actions, expected)
+	}
+}
+
+func TestVolumeActions_ListPageByNumber(t *testing.T) {
+	setup()
+	defer teardown()
+
+	jBlob := `
+	{
+		"actions":[{"status":"in-progress"}],
+		"links":{
+			"pages":{
+				"next":"http://example.com/v2/volumes/v1/actions?page=3",
+				"prev":"http://example.com/v2/volumes/v1/actions?page=1",
+				"last":"http://example.com/v2/volumes/v1/actions?page=3",
+				"first":"http://example.com/v2/volumes/v1/actions?page=1"
+			}
+		}
+	}`
+
+	mux.HandleFunc("/v2/volumes/v1/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, jBlob)
+	})
+
+	opt := &ListOptions{Page: 2}
+	_, resp, err := client.VolumeActions.List(ctx, "v1", opt)
+	if err != nil {
+		t.Errorf("VolumeActions.List returned error: %v", err)
+	}
+
+	checkCurrentPage(t, resp, 2)
+}
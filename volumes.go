@@ -0,0 +1,259 @@
+package binarylane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const volumesBasePath = "v2/volumes"
+
+// VolumesService is an interface for interfacing with the block storage
+// volume endpoints of the BinaryLane API.
+// See: https://api.binarylane.com.au/reference#volumes
+type VolumesService interface {
+	List(context.Context, *ListVolumeParams) ([]Volume, *Response, error)
+	ListAll(context.Context) ([]Volume, error)
+	Get(context.Context, string) (*Volume, *Response, error)
+	Create(context.Context, *VolumeCreateRequest) (*Volume, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+	ListSnapshots(context.Context, string, *ListOptions) ([]Snapshot, *Response, error)
+	CreateSnapshot(context.Context, *SnapshotCreateRequest) (*Snapshot, *Response, error)
+}
+
+// VolumesServiceOp handles communication with the volume related methods of
+// the BinaryLane API.
+type VolumesServiceOp struct {
+	client *Client
+}
+
+var _ VolumesService = &VolumesServiceOp{}
+
+// Volume represents a BinaryLane block storage volume.
+type Volume struct {
+	ID              string   `json:"id"`
+	Region          *Region  `json:"region"`
+	Name            string   `json:"name"`
+	SizeGigaBytes   int      `json:"size_gigabytes"`
+	Description     string   `json:"description"`
+	ServerIDs       []int    `json:"server_ids"`
+	Created         string   `json:"created_at,omitempty"`
+	FilesystemType  string   `json:"filesystem_type"`
+	FilesystemLabel string   `json:"filesystem_label"`
+	Tags            []string `json:"tags"`
+}
+
+func (v Volume) String() string {
+	return Stringify(v)
+}
+
+// URN returns the volume in a valid BL API URN form.
+func (v Volume) URN() string {
+	return ToURN("Volume", v.ID)
+}
+
+type volumesRoot struct {
+	Volumes []Volume `json:"volumes"`
+	Links   *Links   `json:"links"`
+	Meta    *Meta    `json:"meta"`
+}
+
+type volumeRoot struct {
+	Volume *Volume `json:"volume"`
+	Links  *Links  `json:"links,omitempty"`
+}
+
+// ListVolumeParams filters and paginates the volumes returned by
+// VolumesService.List.
+type ListVolumeParams struct {
+	Region      string
+	Name        string
+	ListOptions *ListOptions
+}
+
+type listVolumeOptions struct {
+	Region string `url:"region,omitempty"`
+	Name   string `url:"name,omitempty"`
+}
+
+// VolumeCreateRequest represents a request to create a block store
+// volume. If SnapshotID is set, the volume is created from that snapshot.
+type VolumeCreateRequest struct {
+	Region          string   `json:"region"`
+	Name            string   `json:"name"`
+	Description     string   `json:"description"`
+	SizeGigaBytes   int      `json:"size_gigabytes"`
+	SnapshotID      string   `json:"snapshot_id,omitempty"`
+	FilesystemType  string   `json:"filesystem_type,omitempty"`
+	FilesystemLabel string   `json:"filesystem_label,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+}
+
+// SnapshotCreateRequest represents a request to create a volume snapshot.
+type SnapshotCreateRequest struct {
+	VolumeID    string   `json:"volume_id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// List all volumes, optionally filtered by region or name.
+func (v *VolumesServiceOp) List(ctx context.Context, params *ListVolumeParams) ([]Volume, *Response, error) {
+	ctx = withOperation(ctx, "Volumes.List")
+	path := volumesBasePath
+	if params != nil {
+		var err error
+		path, err = addOptions(path, &listVolumeOptions{Region: params.Region, Name: params.Name})
+		if err != nil {
+			return nil, nil, err
+		}
+		path, err = addOptions(path, params.ListOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(volumesRoot)
+	resp, err := v.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+	if m := root.Meta; m != nil {
+		resp.Meta = m
+	}
+
+	return root.Volumes, resp, err
+}
+
+// ListAll lists all volumes, fetching every page of results.
+func (v *VolumesServiceOp) ListAll(ctx context.Context) ([]Volume, error) {
+	var list []Volume
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := v.List(ctx, &ListVolumeParams{ListOptions: opt})
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// Get an individual volume.
+func (v *VolumesServiceOp) Get(ctx context.Context, id string) (*Volume, *Response, error) {
+	ctx = withOperation(ctx, "Volumes.Get")
+	path := fmt.Sprintf("%s/%s", volumesBasePath, id)
+
+	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(volumeRoot)
+	resp, err := v.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Volume, resp, err
+}
+
+// Create a volume, either empty or from an existing snapshot.
+func (v *VolumesServiceOp) Create(ctx context.Context, createRequest *VolumeCreateRequest) (*Volume, *Response, error) {
+	ctx = withOperation(ctx, "Volumes.Create")
+	if createRequest == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	path := volumesBasePath
+
+	req, err := v.client.NewRequest(ctx, http.MethodPost, path, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(volumeRoot)
+	resp, err := v.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.Volume, resp, err
+}
+
+// Delete a volume.
+func (v *VolumesServiceOp) Delete(ctx context.Context, id string) (*Response, error) {
+	ctx = withOperation(ctx, "Volumes.Delete")
+	path := fmt.Sprintf("%s/%s", volumesBasePath, id)
+
+	req, err := v.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.client.Do(ctx, req, nil)
+}
+
+// ListSnapshots lists all snapshots of a volume.
+func (v *VolumesServiceOp) ListSnapshots(ctx context.Context, volumeID string, opt *ListOptions) ([]Snapshot, *Response, error) {
+	ctx = withOperation(ctx, "Volumes.ListSnapshots")
+	path := fmt.Sprintf("%s/%s/snapshots", volumesBasePath, volumeID)
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(snapshotsRoot)
+	resp, err := v.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+	if m := root.Meta; m != nil {
+		resp.Meta = m
+	}
+
+	return root.Snapshots, resp, err
+}
+
+// CreateSnapshot creates a snapshot of a volume.
+func (v *VolumesServiceOp) CreateSnapshot(ctx context.Context, createRequest *SnapshotCreateRequest) (*Snapshot, *Response, error) {
+	ctx = withOperation(ctx, "Volumes.CreateSnapshot")
+	if createRequest == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	path := fmt.Sprintf("%s/%s/snapshots", volumesBasePath, createRequest.VolumeID)
+
+	req, err := v.client.NewRequest(ctx, http.MethodPost, path, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(snapshotRoot)
+	resp, err := v.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Snapshot, resp, err
+}
@@ -0,0 +1,287 @@
+package binarylane
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestVolumes_ListVolumes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"volumes":[{"id":"v1","region":{"slug":"syd"},"name":"data","size_gigabytes":10,"server_ids":[1]},{"id":"v2","region":{"slug":"syd"},"name":"logs","size_gigabytes":20}],"meta":{"total":2}}`)
+	})
+
+	volumes, resp, err := client.Volumes.List(ctx, nil)
+	if err != nil {
+		t.Errorf("Volumes.List returned error: %v", err)
+	}
+
+	expectedVolumes := []Volume{
+		{ID: "v1", Region: &Region{Slug: "syd"}, Name: "data", SizeGigaBytes: 10, ServerIDs: []int{1}},
+		{ID: "v2", Region: &Region{Slug: "syd"}, Name: "logs", SizeGigaBytes: 20},
+	}
+	if !reflect.DeepEqual(volumes, expectedVolumes) {
+		t.Errorf("Volumes.List returned volumes %+v, expected %+v", volumes, expectedVolumes)
+	}
+
+	expectedMeta := &Meta{Total: 2}
+	if !reflect.DeepEqual(resp.Meta, expectedMeta) {
+		t.Errorf("Volumes.List returned meta %+v, expected %+v", resp.Meta, expectedMeta)
+	}
+}
+
+func TestVolumes_ListVolumesByRegionAndName(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"region": "syd", "name": "my data", "page": "2"})
+		fmt.Fprint(w, `{"volumes":[{"id":"v1","name":"my data"}]}`)
+	})
+
+	params := &ListVolumeParams{Region: "syd", Name: "my data", ListOptions: &ListOptions{Page: 2}}
+	volumes, _, err := client.Volumes.List(ctx, params)
+	if err != nil {
+		t.Errorf("Volumes.List returned error: %v", err)
+	}
+
+	expected := []Volume{{ID: "v1", Name: "my data"}}
+	if !reflect.DeepEqual(volumes, expected) {
+		t.Errorf("Volumes.List returned volumes %+v, expected %+v", volumes, expected)
+	}
+}
+
+func TestVolumes_ListVolumesMultiplePages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"volumes":[{"id":"v1"},{"id":"v2"}], "links":{"pages":{"next":"http://example.com/v2/volumes/?page=2"}}}`)
+	})
+
+	_, resp, err := client.Volumes.List(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkCurrentPage(t, resp, 1)
+}
+
+func TestVolumes_ListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"volumes":[{"id":"v2"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"volumes":[{"id":"v1"}], "links":{"pages":{"next":"http://example.com/v2/volumes/?page=2"}}}`)
+	})
+
+	volumes, err := client.Volumes.ListAll(ctx)
+	if err != nil {
+		t.Errorf("Volumes.ListAll returned error: %v", err)
+	}
+
+	expected := []Volume{{ID: "v1"}, {ID: "v2"}}
+	if !reflect.DeepEqual(volumes, expected) {
+		t.Errorf("Volumes.ListAll returned %+v, expected %+v", volumes, expected)
+	}
+}
+
+func TestVolumes_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes/v1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"volume":{"id":"v1","region":{"slug":"syd"},"name":"data","size_gigabytes":10,"filesystem_type":"ext4","tags":["db"]}}`)
+	})
+
+	volume, _, err := client.Volumes.Get(ctx, "v1")
+	if err != nil {
+		t.Errorf("Volumes.Get returned error: %v", err)
+	}
+
+	expected := &Volume{ID: "v1", Region: &Region{Slug: "syd"}, Name: "data", SizeGigaBytes: 10, FilesystemType: "ext4", Tags: []string{"db"}}
+	if !reflect.DeepEqual(volume, expected) {
+		t.Errorf("Volumes.Get returned %+v, expected %+v", volume, expected)
+	}
+}
+
+func TestVolumes_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	createRequest := &VolumeCreateRequest{
+		Region:        "syd",
+		Name:          "data",
+		Description:   "database volume",
+		SizeGigaBytes: 10,
+		Tags:          []string{"db"},
+	}
+
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		v := new(VolumeCreateRequest)
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testMethod(t, r, http.MethodPost)
+		if !reflect.DeepEqual(v, createRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, createRequest)
+		}
+
+		fmt.Fprint(w, `{"volume":{"id":"v1","region":{"slug":"syd"},"name":"data","description":"database volume","size_gigabytes":10,"tags":["db"]}}`)
+	})
+
+	volume, _, err := client.Volumes.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("Volumes.Create returned error: %v", err)
+	}
+
+	expected := &Volume{ID: "v1", Region: &Region{Slug: "syd"}, Name: "data", Description: "database volume", SizeGigaBytes: 10, Tags: []string{"db"}}
+	if !reflect.DeepEqual(volume, expected) {
+		t.Errorf("Volumes.Create returned %+v, expected %+v", volume, expected)
+	}
+}
+
+func TestVolumes_CreateFromSnapshot(t *testing.T) {
+	setup()
+	defer teardown()
+
+	createRequest := &VolumeCreateRequest{
+		Region:        "syd",
+		Name:          "restored",
+		SizeGigaBytes: 10,
+		SnapshotID:    "s1",
+	}
+
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		v := make(map[string]interface{})
+		err := json.NewDecoder(r.Body).Decode(&v)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testMethod(t, r, http.MethodPost)
+		if v["snapshot_id"] != "s1" {
+			t.Errorf("Request snapshot_id = %v, expected %v", v["snapshot_id"], "s1")
+		}
+
+		fmt.Fprint(w, `{"volume":{"id":"v2","name":"restored","size_gigabytes":10}}`)
+	})
+
+	volume, _, err := client.Volumes.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("Volumes.Create returned error: %v", err)
+	}
+
+	expected := &Volume{ID: "v2", Name: "restored", SizeGigaBytes: 10}
+	if !reflect.DeepEqual(volume, expected) {
+		t.Errorf("Volumes.Create returned %+v, expected %+v", volume, expected)
+	}
+}
+
+func TestVolumes_CreateNilRequest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, _, err := client.Volumes.Create(ctx, nil)
+	if _, ok := err.(*ArgError); !ok {
+		t.Errorf("Expected *ArgError, got %#v", err)
+	}
+}
+
+func TestVolumes_Destroy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes/v1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+	})
+
+	_, err := client.Volumes.Delete(ctx, "v1")
+	if err != nil {
+		t.Errorf("Volumes.Delete returned error: %v", err)
+	}
+}
+
+func TestVolumes_ListSnapshots(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes/v1/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"snapshots":[{"id":"s1","name":"nightly","resource_id":"v1","resource_type":"volume"}],"meta":{"total":1}}`)
+	})
+
+	snapshots, resp, err := client.Volumes.ListSnapshots(ctx, "v1", nil)
+	if err != nil {
+		t.Errorf("Volumes.ListSnapshots returned error: %v", err)
+	}
+
+	expected := []Snapshot{{ID: "s1", Name: "nightly", ResourceID: "v1", ResourceType: "volume"}}
+	if !reflect.DeepEqual(snapshots, expected) {
+		t.Errorf("Volumes.ListSnapshots returned %+v, expected %+v", snapshots, expected)
+	}
+
+	expectedMeta := &Meta{Total: 1}
+	if !reflect.DeepEqual(resp.Meta, expectedMeta) {
+		t.Errorf("Volumes.ListSnapshots returned meta %+v, expected %+v", resp.Meta, expectedMeta)
+	}
+}
+
+func TestVolumes_CreateSnapshot(t *testing.T) {
+	setup()
+	defer teardown()
+
+	createRequest := &SnapshotCreateRequest{
+		VolumeID:    "v1",
+		Name:        "nightly",
+		Description: "nightly backup",
+	}
+
+	mux.HandleFunc("/v2/volumes/v1/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		v := new(SnapshotCreateRequest)
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testMethod(t, r, http.MethodPost)
+		if !reflect.DeepEqual(v, createRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, createRequest)
+		}
+
+		fmt.Fprint(w, `{"snapshot":{"id":"s1","name":"nightly","resource_id":"v1","resource_type":"volume"}}`)
+	})
+
+	snapshot, _, err := client.Volumes.CreateSnapshot(ctx, createRequest)
+	if err != nil {
+		t.Errorf("Volumes.CreateSnapshot returned error: %v", err)
+	}
+
+	expected := &Snapshot{ID: "s1", Name: "nightly", ResourceID: "v1", ResourceType: "volume"}
+	if !reflect.DeepEqual(snapshot, expected) {
+		t.Errorf("Volumes.CreateSnapshot returned %+v, expected %+v", snapshot, expected)
+	}
+}
+
+func TestVolume_URN(t *testing.T) {
+	v := Volume{ID: "v1"}
+	if urn := v.URN(); urn != "bl:volume:v1" {
+		t.Errorf("Volume.URN() = %q, expected %q", urn, "bl:volume:v1")
+	}
+}
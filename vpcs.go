@@ -16,6 +16,7 @@ type VPCsService interface {
 	Create(context.Context, *VPCCreateRequest) (*VPC, *Response, error)
 	Get(context.Context, int) (*VPC, *Response, error)
 	List(context.Context, *ListOptions) ([]*VPC, *Response, error)
+	ListAll(context.Context) ([]*VPC, error)
 	Update(context.Context, int, *VPCUpdateRequest) (*VPC, *Response, error)
 	Set(context.Context, int, ...VPCSetField) (*VPC, *Response, error)
 	Delete(context.Context, int) (*Response, error)
@@ -93,6 +94,7 @@ func (v VPC) URN() string {
 
 // Get returns the details of a Virtual Private Cloud.
 func (v *VPCsServiceOp) Get(ctx context.Context, id int) (*VPC, *Response, error) {
+	ctx = withOperation(ctx, "VPCs.Get")
 	path := fmt.Sprintf("%s/%d", vpcsBasePath, id)
 	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -110,6 +112,7 @@ func (v *VPCsServiceOp) Get(ctx context.Context, id int) (*VPC, *Response, error
 
 // Create creates a new Virtual Private Cloud.
 func (v *VPCsServiceOp) Create(ctx context.Context, create *VPCCreateRequest) (*VPC, *Response, error) {
+	ctx = withOperation(ctx, "VPCs.Create")
 	path := vpcsBasePath
 	req, err := v.client.NewRequest(ctx, http.MethodPost, path, create)
 	if err != nil {
@@ -127,6 +130,7 @@ func (v *VPCsServiceOp) Create(ctx context.Context, create *VPCCreateRequest) (*
 
 // List returns a list of the caller's VPCs, with optional pagination.
 func (v *VPCsServiceOp) List(ctx context.Context, opt *ListOptions) ([]*VPC, *Response, error) {
+	ctx = withOperation(ctx, "VPCs.List")
 	path, err := addOptions(vpcsBasePath, opt)
 	if err != nil {
 		return nil, nil, err
@@ -151,8 +155,24 @@ func (v *VPCsServiceOp) List(ctx context.Context, opt *ListOptions) ([]*VPC, *Re
 	return root.VPCs, resp, nil
 }
 
+// ListAll lists all VPCs, fetching every page of results.
+func (v *VPCsServiceOp) ListAll(ctx context.Context) ([]*VPC, error) {
+	var list []*VPC
+	err := Paginate(ctx, nil, func(ctx context.Context, opt *ListOptions) (*Response, error) {
+		page, resp, err := v.List(ctx, opt)
+		list = append(list, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
 // Update updates a Virtual Private Cloud's properties.
 func (v *VPCsServiceOp) Update(ctx context.Context, id int, update *VPCUpdateRequest) (*VPC, *Response, error) {
+	ctx = withOperation(ctx, "VPCs.Update")
 	path := fmt.Sprintf("%s/%d", vpcsBasePath, id)
 	req, err := v.client.NewRequest(ctx, http.MethodPut, path, update)
 	if err != nil {
@@ -182,6 +202,7 @@ func (*vpcSetDefault) vpcSetField(in map[string]interface{}) {
 
 // Set updates specific properties of a Virtual Private Cloud.
 func (v *VPCsServiceOp) Set(ctx context.Context, id int, fields ...VPCSetField) (*VPC, *Response, error) {
+	ctx = withOperation(ctx, "VPCs.Set")
 	path := fmt.Sprintf("%s/%d", vpcsBasePath, id)
 	update := make(map[string]interface{}, len(fields))
 	for _, field := range fields {
@@ -205,6 +226,7 @@ func (v *VPCsServiceOp) Set(ctx context.Context, id int, fields ...VPCSetField)
 // Delete deletes a Virtual Private Cloud. There is no way to recover a VPC once it has been
 // destroyed.
 func (v *VPCsServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	ctx = withOperation(ctx, "VPCs.Delete")
 	path := fmt.Sprintf("%s/%d", vpcsBasePath, id)
 	req, err := v.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {